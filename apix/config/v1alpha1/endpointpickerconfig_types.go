@@ -65,17 +65,24 @@ type EndpointPickerConfig struct {
 	// Parser specifies the parsing logic used by the EPP to process protocol messages.
 	// If unspecified, default parsing behavior will be applied.
 	Parser *ParserConfig `json:"parser,omitempty"`
+
+	// +optional
+	// PrepareData configures the execution of the PrepareData plugins.
+	// This configuration is only respected if the "prepareDataPlugins" FeatureGate is enabled.
+	// If not present, default values are used.
+	PrepareData *PrepareDataConfig `json:"prepareData,omitempty"`
 }
 
 func (cfg EndpointPickerConfig) String() string {
 	return fmt.Sprintf(
-		"{FeatureGates: %v, Plugins: %v, SchedulingProfiles: %v, Data: %v, SaturationDetector: %v, FlowControl: %v}",
+		"{FeatureGates: %v, Plugins: %v, SchedulingProfiles: %v, Data: %v, SaturationDetector: %v, FlowControl: %v, PrepareData: %v}",
 		cfg.FeatureGates,
 		cfg.Plugins,
 		cfg.SchedulingProfiles,
 		cfg.Data,
 		cfg.SaturationDetector,
 		cfg.FlowControl,
+		cfg.PrepareData,
 	)
 }
 
@@ -269,6 +276,78 @@ type ParserConfig struct {
 	PluginRef string `json:"pluginRef"`
 }
 
+// PrepareDataConfig configures the execution of the PrepareData plugins.
+type PrepareDataConfig struct {
+	// +optional
+	// Timeout bounds the PrepareData phase of each request. The plugins that have not completed
+	// when it expires are handled according to their failure policy.
+	// If omitted, it defaults to 400ms.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// +optional
+	// Plugins configures the timeout and the failure policy of individual PrepareData plugins.
+	// The plugins that are not listed are bounded by the Timeout of the phase only and use the
+	// Skip failure policy.
+	Plugins []PrepareDataPluginConfig `json:"plugins,omitempty"`
+}
+
+func (pdc *PrepareDataConfig) String() string {
+	if pdc == nil {
+		return "{}"
+	}
+	return fmt.Sprintf("{Timeout: %v, Plugins: %v}", pdc.Timeout, pdc.Plugins)
+}
+
+// PrepareDataPluginConfig configures the execution of a PrepareData plugin.
+type PrepareDataPluginConfig struct {
+	// +required
+	// +kubebuilder:validation:Required
+	// PluginRef specifies a particular Plugin instance to be configured.
+	// The reference is to the name of an entry of the Plugins defined in the
+	// configuration's Plugins section. The plugin must be a PrepareData plugin.
+	PluginRef string `json:"pluginRef"`
+
+	// +optional
+	// Timeout bounds the execution of the plugin. It is further bounded by the
+	// Timeout of the PrepareData phase.
+	// If omitted, the plugin is bounded by the Timeout of the PrepareData phase only.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// +optional
+	// FailurePolicy specifies how a failure of the plugin is handled. A plugin fails
+	// when it returns an error, when it times out, or when a plugin producing data it
+	// consumes fails.
+	// If omitted, it defaults to Skip.
+	FailurePolicy PrepareDataFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+func (pdpc PrepareDataPluginConfig) String() string {
+	result := "{PluginRef: " + pdpc.PluginRef
+	if pdpc.Timeout != nil {
+		result += fmt.Sprintf(", Timeout: %s", pdpc.Timeout.Duration)
+	}
+	if pdpc.FailurePolicy != "" {
+		result += ", FailurePolicy: " + string(pdpc.FailurePolicy)
+	}
+	return result + "}"
+}
+
+// PrepareDataFailurePolicy specifies how a failure of a PrepareData plugin is handled.
+// +kubebuilder:validation:Enum=Skip;FailRequest;MarkMissing
+type PrepareDataFailurePolicy string
+
+const (
+	// PrepareDataFailurePolicySkip logs the failure and continues with whatever data the
+	// plugin produced before returning its error.
+	PrepareDataFailurePolicySkip PrepareDataFailurePolicy = "Skip"
+	// PrepareDataFailurePolicyFailRequest fails the request.
+	PrepareDataFailurePolicyFailRequest PrepareDataFailurePolicy = "FailRequest"
+	// PrepareDataFailurePolicyMarkMissing removes the data produced by the plugin from the
+	// endpoints, so that the consumers of the data see it as missing and fall back to their
+	// behavior without it.
+	PrepareDataFailurePolicyMarkMissing PrepareDataFailurePolicy = "MarkMissing"
+)
+
 // FlowControlConfig configures the Flow Control layer.
 type FlowControlConfig struct {
 	// +optional
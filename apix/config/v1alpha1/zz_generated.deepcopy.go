@@ -126,6 +126,11 @@ func (in *EndpointPickerConfig) DeepCopyInto(out *EndpointPickerConfig) {
 		*out = new(ParserConfig)
 		**out = **in
 	}
+	if in.PrepareData != nil {
+		in, out := &in.PrepareData, &out.PrepareData
+		*out = new(PrepareDataConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointPickerConfig.
@@ -237,6 +242,53 @@ func (in *PluginSpec) DeepCopy() *PluginSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrepareDataConfig) DeepCopyInto(out *PrepareDataConfig) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]PrepareDataPluginConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrepareDataConfig.
+func (in *PrepareDataConfig) DeepCopy() *PrepareDataConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrepareDataConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrepareDataPluginConfig) DeepCopyInto(out *PrepareDataPluginConfig) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrepareDataPluginConfig.
+func (in *PrepareDataPluginConfig) DeepCopy() *PrepareDataPluginConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrepareDataPluginConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PriorityBandConfig) DeepCopyInto(out *PriorityBandConfig) {
 	*out = *in
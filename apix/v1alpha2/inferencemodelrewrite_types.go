@@ -58,8 +58,12 @@ type InferenceModelRewriteSpec struct {
 	// InferencePool, the controller will merge them based on precedence.
 	//
 	// Across all rules specified on applicable rewrites, precedence MUST be
-	// given to the match having an "Exact" model match over a generic match
-	// (a rule with an empty `matches` array).
+	// given to the match having an "Exact" model match over a match having a
+	// "Prefix" model match, over a match having a "RegularExpression" model
+	// match, over a match without a model match or a generic match (a rule
+	// with an empty `matches` array). Among "Prefix" model matches, the
+	// longest prefix wins. Within each of these groups, a match with header
+	// matches takes precedence over a match without header matches.
 	//
 	// If ties still exist across multiple InferenceModelRewrite resources (e.g.
 	// two rewrites both have an exact match for the same model), matching
@@ -93,6 +97,60 @@ type InferenceModelRewriteRule struct {
 	// +kubebuilder:validation:MinItems=1
 	//
 	Targets []TargetModel `json:"targets,omitempty"`
+
+	// Fallbacks is the ordered list of destinations that matching requests
+	// spill over to when the InferencePool is saturated, instead of being
+	// queued or rejected. Only sheddable requests, and requests with a priority
+	// the Endpoint Picker is configured to spill over, are eligible. The first
+	// fallback whose InferencePool is not saturated is used. If no fallback is
+	// usable, the request is handled as if no fallbacks were specified.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	Fallbacks []FallbackTarget `json:"fallbacks,omitempty"`
+
+	// StickySplit makes the split of traffic across Targets deterministic, so
+	// that requests sharing a header value, e.g. the same user or tenant, are
+	// always rewritten to the same target model. If unset, or if a request
+	// does not carry the header, the target model is selected at random
+	// according to the weights.
+	//
+	// +optional
+	StickySplit *StickySplit `json:"stickySplit,omitempty"`
+}
+
+// StickySplit configures the deterministic split of traffic across the
+// targets of a rule.
+type StickySplit struct {
+	// HeaderName is the name of the request header whose value is hashed to
+	// select the target model. The header name is case-insensitive.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	HeaderName string `json:"headerName"`
+}
+
+// FallbackTarget defines a destination for requests spilled over from a
+// saturated InferencePool.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.modelRewrite) || has(self.poolRef)",message="at least one of modelRewrite or poolRef must be set"
+type FallbackTarget struct {
+	// ModelRewrite is the model name the spilled over request is rewritten to.
+	// If empty, the model selected by Targets is kept.
+	//
+	// +optional
+	ModelRewrite string `json:"modelRewrite,omitempty"`
+
+	// PoolRef is a reference to the InferencePool, in the namespace of the
+	// InferenceModelRewrite, that serves the spilled over requests. The
+	// Endpoint Picker must serve that pool as well, otherwise the fallback
+	// is ignored and reported by the ResolvedRefs condition. If unset, the
+	// request stays in the saturated pool with the fallback model, and
+	// still goes through admission control.
+	//
+	// +optional
+	PoolRef *PoolObjectReference `json:"poolRef,omitempty"`
 }
 
 // TargetModel defines a weighted model destination for traffic distribution.
@@ -122,18 +180,30 @@ type TargetModel struct {
 	ModelRewrite string `json:"modelRewrite"`
 }
 
-// Match defines the criteria for matching the LLM requests.
+// Match defines the criteria for matching the LLM requests. A request
+// matches if it satisfies the model match and ALL of the header matches.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.model) || has(self.headers)",message="at least one of model or headers must be set"
 type Match struct {
 	// Model specifies the criteria for matching the 'model' field
-	// within the JSON request body.
-	// +required
+	// within the JSON request body. If unset, any model matches.
+	// +optional
 	Model *ModelMatch `json:"model,omitempty"`
+
+	// Headers specifies the criteria for matching the request headers.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Headers []HeaderMatch `json:"headers,omitempty"`
 }
 
 // ModelMatch defines how to match against the model name in the request body.
 type ModelMatch struct {
 	// Type specifies the kind of string matching to use.
-	// Supported value is "Exact". Defaults to "Exact".
+	// Supported values are "Exact", "Prefix" and "RegularExpression".
+	// Defaults to "Exact". Regular expressions use the RE2 syntax and must
+	// match the whole model name.
 	// +optional
 	// +kubebuilder:default=Exact
 	Type *MatchValidationType `json:"type,omitempty"`
@@ -144,13 +214,43 @@ type ModelMatch struct {
 	Value string `json:"value"`
 }
 
+// HeaderMatch defines how to match against a request header.
+type HeaderMatch struct {
+	// Type specifies the kind of string matching to use.
+	// Supported values are "Exact", "Prefix" and "RegularExpression".
+	// Defaults to "Exact". Regular expressions use the RE2 syntax and must
+	// match the whole header value.
+	// +optional
+	// +kubebuilder:default=Exact
+	Type *MatchValidationType `json:"type,omitempty"`
+
+	// Name is the name of the header to match against. The header name is
+	// case-insensitive. A request without the header does not match.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// Value is the header value string to match against.
+	// +required
+	// +kubebuilder:validation:MaxLength=4096
+	Value string `json:"value"`
+}
+
 // MatchValidationType specifies the type of string matching to use.
-// +kubebuilder:validation:Enum=Exact
+// +kubebuilder:validation:Enum=Exact;Prefix;RegularExpression
 type MatchValidationType string
 
 const (
-	// MatchExact indicates that the model name must match exactly.
+	// MatchExact indicates that the value must match exactly.
 	MatchExact MatchValidationType = "Exact"
+
+	// MatchPrefix indicates that the value must start with the given prefix.
+	MatchPrefix MatchValidationType = "Prefix"
+
+	// MatchRegularExpression indicates that the value must match the given
+	// RE2 regular expression.
+	MatchRegularExpression MatchValidationType = "RegularExpression"
 )
 
 // InferenceModelRewriteStatus defines the observed state of InferenceModelRewrite.
@@ -160,6 +260,7 @@ type InferenceModelRewriteStatus struct {
 	// Known condition types are:
 	//
 	// * "Accepted"
+	// * "ResolvedRefs"
 	//
 	// +optional
 	// +listType=map
@@ -183,6 +284,10 @@ const (
 	//
 	// * "Accepted"
 	//
+	// Possible reasons for this condition to be False are:
+	//
+	// * "InvalidMatch"
+	//
 	// Possible reasons for this condition to be Unknown are:
 	//
 	// * "Pending"
@@ -193,7 +298,36 @@ const (
 	// and has been successfully applied to the inference pool.
 	RewriteReasonAccepted InferenceModelRewriteConditionReason = "Accepted"
 
+	// RewriteReasonInvalidMatch indicates that a match of the rewrite is
+	// invalid, such as a regular expression that is not valid RE2 syntax. The
+	// last accepted version of the rewrite, if any, keeps applying until the
+	// match is fixed.
+	RewriteReasonInvalidMatch InferenceModelRewriteConditionReason = "InvalidMatch"
+
 	// RewriteReasonPending is the initial state, and indicates that the
 	// controller has not yet reconciled the InferenceModelRewrite.
 	RewriteReasonPending InferenceModelRewriteConditionReason = "Pending"
+
+	// RewriteConditionResolvedRefs indicates whether the InferencePools
+	// referenced by the fallbacks of the rewrite are served by the Endpoint
+	// Picker.
+	//
+	// Possible reasons for this condition to be True are:
+	//
+	// * "ResolvedRefs"
+	//
+	// Possible reasons for this condition to be False are:
+	//
+	// * "FallbackPoolNotServed"
+	//
+	RewriteConditionResolvedRefs InferenceModelRewriteConditionType = "ResolvedRefs"
+
+	// RewriteReasonResolvedRefs indicates that all the fallback pools are
+	// served by the Endpoint Picker.
+	RewriteReasonResolvedRefs InferenceModelRewriteConditionReason = "ResolvedRefs"
+
+	// RewriteReasonFallbackPoolNotServed indicates that some fallbacks
+	// reference an InferencePool that the Endpoint Picker does not serve.
+	// These fallbacks are ignored, while the rest of the rewrite applies.
+	RewriteReasonFallbackPoolNotServed InferenceModelRewriteConditionReason = "FallbackPoolNotServed"
 )
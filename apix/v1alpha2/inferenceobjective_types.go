@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha2
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -27,6 +28,10 @@ import (
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Inference Pool",type=string,JSONPath=`.spec.poolRef.name`
 // +kubebuilder:printcolumn:name="Priority",type=string,JSONPath=`.spec.priority`
+// +kubebuilder:printcolumn:name="Rate",type=string,JSONPath=`.status.stats.requestRate`
+// +kubebuilder:printcolumn:name="TTFT P99",type=string,JSONPath=`.status.stats.ttft.p99`
+// +kubebuilder:printcolumn:name="TPOT P99",type=string,JSONPath=`.status.stats.tpot.p99`,priority=1
+// +kubebuilder:printcolumn:name="SLO Attainment",type=integer,JSONPath=`.status.stats.sloAttainmentPercent`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +genclient
 type InferenceObjective struct {
@@ -76,6 +81,41 @@ type InferenceObjectiveSpec struct {
 	//
 	// +kubebuilder:validation:Required
 	PoolRef PoolObjectReference `json:"poolRef"`
+
+	// LatencyTargets are the latency targets of the requests served for this
+	// objective. They are used by latency-aware scheduling and queueing, and
+	// apply to every request of the objective. A request may tighten a target
+	// with the corresponding "x-slo-*-ms" header, but may not loosen it.
+	//
+	// +optional
+	LatencyTargets *LatencyTargets `json:"latencyTargets,omitempty"`
+}
+
+// LatencyTargets are the latency targets of a request.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.ttft) || has(self.tpot) || has(self.e2e)",message="at least one of ttft, tpot or e2e must be set"
+type LatencyTargets struct {
+	// TTFT is the time to first token target, which can be tightened with
+	// the "x-slo-ttft-ms" request header.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="ttft must be positive"
+	TTFT *metav1.Duration `json:"ttft,omitempty"`
+
+	// TPOT is the average time per output token target, which can be
+	// tightened with the "x-slo-tpot-ms" request header.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="tpot must be positive"
+	TPOT *metav1.Duration `json:"tpot,omitempty"`
+
+	// E2E is the end-to-end latency target, from the time the request is
+	// received to the time its response completes, which can be tightened
+	// with the "x-slo-e2e-ms" request header.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="e2e must be positive"
+	E2E *metav1.Duration `json:"e2e,omitempty"`
 }
 
 // InferenceObjectiveStatus defines the observed state of InferenceObjective
@@ -92,6 +132,79 @@ type InferenceObjectiveStatus struct {
 	// +kubebuilder:validation:MaxItems=8
 	// +kubebuilder:default={{type: "Ready", status: "Unknown", reason:"Pending", message:"Waiting for controller", lastTransitionTime: "1970-01-01T00:00:00Z"}}
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Stats are the request statistics observed by the Endpoint Picker for
+	// this objective over the last reporting window. They are only reported
+	// when the Endpoint Picker is configured to do so.
+	//
+	// +optional
+	Stats *InferenceObjectiveStats `json:"stats,omitempty"`
+}
+
+// InferenceObjectiveStats are the request statistics observed for an
+// InferenceObjective over a reporting window.
+type InferenceObjectiveStats struct {
+	// LastUpdateTime is the end of the reporting window.
+	//
+	// +required
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+
+	// Window is the length of the reporting window.
+	//
+	// +required
+	Window metav1.Duration `json:"window"`
+
+	// RequestRate is the number of requests per second received over the
+	// window.
+	//
+	// +optional
+	RequestRate *resource.Quantity `json:"requestRate,omitempty"`
+
+	// TTFT are the time to first token percentiles of the streamed responses
+	// completed over the window.
+	//
+	// +optional
+	TTFT *LatencyPercentiles `json:"ttft,omitempty"`
+
+	// TPOT are the time per output token percentiles of the streamed
+	// responses completed over the window.
+	//
+	// +optional
+	TPOT *LatencyPercentiles `json:"tpot,omitempty"`
+
+	// Shed is the number of requests rejected over the window because the
+	// InferencePool was saturated or the queue was full.
+	//
+	// +optional
+	Shed int64 `json:"shed,omitempty"`
+
+	// QueueEvicted is the number of requests evicted from the queue over the
+	// window, because they timed out or the client disconnected.
+	//
+	// +optional
+	QueueEvicted int64 `json:"queueEvicted,omitempty"`
+
+	// SLOAttainmentPercent is the percentage, rounded down, of the responses
+	// with latency targets completed over the window that met all of their
+	// targets. It is unset if no such response completed.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	SLOAttainmentPercent *int32 `json:"sloAttainmentPercent,omitempty"`
+}
+
+// LatencyPercentiles are percentiles of a latency distribution.
+type LatencyPercentiles struct {
+	// P50 is the median latency.
+	//
+	// +required
+	P50 metav1.Duration `json:"p50"`
+
+	// P99 is the 99th percentile latency.
+	//
+	// +required
+	P99 metav1.Duration `json:"p99"`
 }
 
 // InferenceObjectiveConditionType is a type of condition for the InferenceObjective.
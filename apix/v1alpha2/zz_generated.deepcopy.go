@@ -60,6 +60,46 @@ func (in *Extension) DeepCopy() *Extension {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FallbackTarget) DeepCopyInto(out *FallbackTarget) {
+	*out = *in
+	if in.PoolRef != nil {
+		in, out := &in.PoolRef, &out.PoolRef
+		*out = new(PoolObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FallbackTarget.
+func (in *FallbackTarget) DeepCopy() *FallbackTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(FallbackTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderMatch) DeepCopyInto(out *HeaderMatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(MatchValidationType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderMatch.
+func (in *HeaderMatch) DeepCopy() *HeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InferenceModelRewrite) DeepCopyInto(out *InferenceModelRewrite) {
 	*out = *in
@@ -134,6 +174,18 @@ func (in *InferenceModelRewriteRule) DeepCopyInto(out *InferenceModelRewriteRule
 		*out = make([]TargetModel, len(*in))
 		copy(*out, *in)
 	}
+	if in.Fallbacks != nil {
+		in, out := &in.Fallbacks, &out.Fallbacks
+		*out = make([]FallbackTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StickySplit != nil {
+		in, out := &in.StickySplit, &out.StickySplit
+		*out = new(StickySplit)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceModelRewriteRule.
@@ -263,6 +315,11 @@ func (in *InferenceObjectiveSpec) DeepCopyInto(out *InferenceObjectiveSpec) {
 		**out = **in
 	}
 	out.PoolRef = in.PoolRef
+	if in.LatencyTargets != nil {
+		in, out := &in.LatencyTargets, &out.LatencyTargets
+		*out = new(LatencyTargets)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceObjectiveSpec.
@@ -275,6 +332,43 @@ func (in *InferenceObjectiveSpec) DeepCopy() *InferenceObjectiveSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InferenceObjectiveStats) DeepCopyInto(out *InferenceObjectiveStats) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	out.Window = in.Window
+	if in.RequestRate != nil {
+		in, out := &in.RequestRate, &out.RequestRate
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TTFT != nil {
+		in, out := &in.TTFT, &out.TTFT
+		*out = new(LatencyPercentiles)
+		**out = **in
+	}
+	if in.TPOT != nil {
+		in, out := &in.TPOT, &out.TPOT
+		*out = new(LatencyPercentiles)
+		**out = **in
+	}
+	if in.SLOAttainmentPercent != nil {
+		in, out := &in.SLOAttainmentPercent, &out.SLOAttainmentPercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceObjectiveStats.
+func (in *InferenceObjectiveStats) DeepCopy() *InferenceObjectiveStats {
+	if in == nil {
+		return nil
+	}
+	out := new(InferenceObjectiveStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InferenceObjectiveStatus) DeepCopyInto(out *InferenceObjectiveStatus) {
 	*out = *in
@@ -285,6 +379,11 @@ func (in *InferenceObjectiveStatus) DeepCopyInto(out *InferenceObjectiveStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Stats != nil {
+		in, out := &in.Stats, &out.Stats
+		*out = new(InferenceObjectiveStats)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InferenceObjectiveStatus.
@@ -401,6 +500,53 @@ func (in *InferencePoolStatus) DeepCopy() *InferencePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LatencyPercentiles) DeepCopyInto(out *LatencyPercentiles) {
+	*out = *in
+	out.P50 = in.P50
+	out.P99 = in.P99
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatencyPercentiles.
+func (in *LatencyPercentiles) DeepCopy() *LatencyPercentiles {
+	if in == nil {
+		return nil
+	}
+	out := new(LatencyPercentiles)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LatencyTargets) DeepCopyInto(out *LatencyTargets) {
+	*out = *in
+	if in.TTFT != nil {
+		in, out := &in.TTFT, &out.TTFT
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TPOT != nil {
+		in, out := &in.TPOT, &out.TPOT
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.E2E != nil {
+		in, out := &in.E2E, &out.E2E
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatencyTargets.
+func (in *LatencyTargets) DeepCopy() *LatencyTargets {
+	if in == nil {
+		return nil
+	}
+	out := new(LatencyTargets)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Match) DeepCopyInto(out *Match) {
 	*out = *in
@@ -409,6 +555,13 @@ func (in *Match) DeepCopyInto(out *Match) {
 		*out = new(ModelMatch)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Match.
@@ -509,6 +662,21 @@ func (in *PoolStatus) DeepCopy() *PoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StickySplit) DeepCopyInto(out *StickySplit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StickySplit.
+func (in *StickySplit) DeepCopy() *StickySplit {
+	if in == nil {
+		return nil
+	}
+	out := new(StickySplit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetModel) DeepCopyInto(out *TargetModel) {
 	*out = *in
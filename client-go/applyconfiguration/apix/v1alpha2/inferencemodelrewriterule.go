@@ -32,6 +32,19 @@ type InferenceModelRewriteRuleApplyConfiguration struct {
 	// weighted model targets. This is used for traffic splitting, A/B tests,
 	// or canary rollouts.
 	Targets []TargetModelApplyConfiguration `json:"targets,omitempty"`
+	// Fallbacks is the ordered list of destinations that matching requests
+	// spill over to when the InferencePool is saturated, instead of being
+	// queued or rejected. Only sheddable requests, and requests with a priority
+	// the Endpoint Picker is configured to spill over, are eligible. The first
+	// fallback whose InferencePool is not saturated is used. If no fallback is
+	// usable, the request is handled as if no fallbacks were specified.
+	Fallbacks []FallbackTargetApplyConfiguration `json:"fallbacks,omitempty"`
+	// StickySplit makes the split of traffic across Targets deterministic, so
+	// that requests sharing a header value, e.g. the same user or tenant, are
+	// always rewritten to the same target model. If unset, or if a request
+	// does not carry the header, the target model is selected at random
+	// according to the weights.
+	StickySplit *StickySplitApplyConfiguration `json:"stickySplit,omitempty"`
 }
 
 // InferenceModelRewriteRuleApplyConfiguration constructs a declarative configuration of the InferenceModelRewriteRule type for use with
@@ -65,3 +78,24 @@ func (b *InferenceModelRewriteRuleApplyConfiguration) WithTargets(values ...*Tar
 	}
 	return b
 }
+
+// WithFallbacks adds the given value to the Fallbacks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Fallbacks field.
+func (b *InferenceModelRewriteRuleApplyConfiguration) WithFallbacks(values ...*FallbackTargetApplyConfiguration) *InferenceModelRewriteRuleApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFallbacks")
+		}
+		b.Fallbacks = append(b.Fallbacks, *values[i])
+	}
+	return b
+}
+
+// WithStickySplit sets the StickySplit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StickySplit field is set to the value of the last call.
+func (b *InferenceModelRewriteRuleApplyConfiguration) WithStickySplit(value *StickySplitApplyConfiguration) *InferenceModelRewriteRuleApplyConfiguration {
+	b.StickySplit = value
+	return b
+}
@@ -46,6 +46,11 @@ type InferenceObjectiveSpecApplyConfiguration struct {
 	Priority *int `json:"priority,omitempty"`
 	// PoolRef is a reference to the inference pool, the pool must exist in the same namespace.
 	PoolRef *PoolObjectReferenceApplyConfiguration `json:"poolRef,omitempty"`
+	// LatencyTargets are the latency targets of the requests served for this
+	// objective. They are used by latency-aware scheduling and queueing, and
+	// apply to every request of the objective. A request may tighten a target
+	// with the corresponding "x-slo-*-ms" header, but may not loosen it.
+	LatencyTargets *LatencyTargetsApplyConfiguration `json:"latencyTargets,omitempty"`
 }
 
 // InferenceObjectiveSpecApplyConfiguration constructs a declarative configuration of the InferenceObjectiveSpec type for use with
@@ -69,3 +74,11 @@ func (b *InferenceObjectiveSpecApplyConfiguration) WithPoolRef(value *PoolObject
 	b.PoolRef = value
 	return b
 }
+
+// WithLatencyTargets sets the LatencyTargets field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LatencyTargets field is set to the value of the last call.
+func (b *InferenceObjectiveSpecApplyConfiguration) WithLatencyTargets(value *LatencyTargetsApplyConfiguration) *InferenceObjectiveSpecApplyConfiguration {
+	b.LatencyTargets = value
+	return b
+}
@@ -33,6 +33,10 @@ type InferenceObjectiveStatusApplyConfiguration struct {
 	//
 	// * "Accepted"
 	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// Stats are the request statistics observed by the Endpoint Picker for
+	// this objective over the last reporting window. They are only reported
+	// when the Endpoint Picker is configured to do so.
+	Stats *InferenceObjectiveStatsApplyConfiguration `json:"stats,omitempty"`
 }
 
 // InferenceObjectiveStatusApplyConfiguration constructs a declarative configuration of the InferenceObjectiveStatus type for use with
@@ -53,3 +57,11 @@ func (b *InferenceObjectiveStatusApplyConfiguration) WithConditions(values ...*v
 	}
 	return b
 }
+
+// WithStats sets the Stats field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Stats field is set to the value of the last call.
+func (b *InferenceObjectiveStatusApplyConfiguration) WithStats(value *InferenceObjectiveStatsApplyConfiguration) *InferenceObjectiveStatusApplyConfiguration {
+	b.Stats = value
+	return b
+}
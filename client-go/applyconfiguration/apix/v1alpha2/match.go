@@ -21,11 +21,14 @@ package v1alpha2
 // MatchApplyConfiguration represents a declarative configuration of the Match type for use
 // with apply.
 //
-// Match defines the criteria for matching the LLM requests.
+// Match defines the criteria for matching the LLM requests. A request
+// matches if it satisfies the model match and ALL of the header matches.
 type MatchApplyConfiguration struct {
 	// Model specifies the criteria for matching the 'model' field
-	// within the JSON request body.
+	// within the JSON request body. If unset, any model matches.
 	Model *ModelMatchApplyConfiguration `json:"model,omitempty"`
+	// Headers specifies the criteria for matching the request headers.
+	Headers []HeaderMatchApplyConfiguration `json:"headers,omitempty"`
 }
 
 // MatchApplyConfiguration constructs a declarative configuration of the Match type for use with
@@ -41,3 +44,16 @@ func (b *MatchApplyConfiguration) WithModel(value *ModelMatchApplyConfiguration)
 	b.Model = value
 	return b
 }
+
+// WithHeaders adds the given value to the Headers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Headers field.
+func (b *MatchApplyConfiguration) WithHeaders(values ...*HeaderMatchApplyConfiguration) *MatchApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithHeaders")
+		}
+		b.Headers = append(b.Headers, *values[i])
+	}
+	return b
+}
@@ -28,7 +28,9 @@ import (
 // ModelMatch defines how to match against the model name in the request body.
 type ModelMatchApplyConfiguration struct {
 	// Type specifies the kind of string matching to use.
-	// Supported value is "Exact". Defaults to "Exact".
+	// Supported values are "Exact", "Prefix" and "RegularExpression".
+	// Defaults to "Exact". Regular expressions use the RE2 syntax and must
+	// match the whole model name.
 	Type *apixv1alpha2.MatchValidationType `json:"type,omitempty"`
 	// Value is the model name string to match against.
 	Value *string `json:"value,omitempty"`
@@ -66,6 +66,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		// Group=inference.networking.x-k8s.io, Version=v1alpha2
 	case v1alpha2.SchemeGroupVersion.WithKind("Extension"):
 		return &apixv1alpha2.ExtensionApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("FallbackTarget"):
+		return &apixv1alpha2.FallbackTargetApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("HeaderMatch"):
+		return &apixv1alpha2.HeaderMatchApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("InferenceModelRewrite"):
 		return &apixv1alpha2.InferenceModelRewriteApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("InferenceModelRewriteRule"):
@@ -78,6 +82,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apixv1alpha2.InferenceObjectiveApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("InferenceObjectiveSpec"):
 		return &apixv1alpha2.InferenceObjectiveSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("InferenceObjectiveStats"):
+		return &apixv1alpha2.InferenceObjectiveStatsApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("InferenceObjectiveStatus"):
 		return &apixv1alpha2.InferenceObjectiveStatusApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("InferencePool"):
@@ -86,6 +92,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apixv1alpha2.InferencePoolSpecApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("InferencePoolStatus"):
 		return &apixv1alpha2.InferencePoolStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("LatencyPercentiles"):
+		return &apixv1alpha2.LatencyPercentilesApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("LatencyTargets"):
+		return &apixv1alpha2.LatencyTargetsApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("Match"):
 		return &apixv1alpha2.MatchApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("ModelMatch"):
@@ -96,6 +106,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apixv1alpha2.PoolObjectReferenceApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("PoolStatus"):
 		return &apixv1alpha2.PoolStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("StickySplit"):
+		return &apixv1alpha2.StickySplitApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("TargetModel"):
 		return &apixv1alpha2.TargetModelApplyConfiguration{}
 
@@ -42,6 +42,8 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/basemodelextractor"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/celtransform"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/requestpolicy"
 	runserver "sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/server"
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/profiling"
@@ -259,6 +261,9 @@ func (r *Runner) Run(ctx context.Context) error {
 func (r *Runner) registerInTreePlugins() {
 	framework.Register(plugins.BodyFieldToHeaderPluginType, plugins.BodyFieldToHeaderPluginFactory)
 	framework.Register(basemodelextractor.BaseModelToHeaderPluginType, basemodelextractor.BaseModelToHeaderPluginFactory)
+	framework.Register(requestpolicy.RequestPolicyPluginType, requestpolicy.RequestPolicyPluginFactory)
+	framework.Register(celtransform.CELRequestTransformPluginType, celtransform.CELRequestTransformPluginFactory)
+	framework.Register(celtransform.CELResponseTransformPluginType, celtransform.CELResponseTransformPluginFactory)
 }
 
 // registerHealthServer adds the Health gRPC server as a Runnable to the given manager.
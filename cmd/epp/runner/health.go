@@ -33,7 +33,7 @@ import (
 
 type healthServer struct {
 	logger                logr.Logger
-	datastore             datastore.Datastore
+	datastores            []datastore.Datastore
 	isLeader              *atomic.Bool
 	leaderElectionEnabled bool
 }
@@ -44,7 +44,7 @@ const (
 )
 
 func (s *healthServer) Check(ctx context.Context, in *healthPb.HealthCheckRequest) (*healthPb.HealthCheckResponse, error) {
-	isLive := s.datastore.PoolHasSynced()
+	isLive := s.anyPoolHasSynced()
 
 	// If leader election is disabled, use current logic: all checks are based on whether the pool has synced.
 	if !s.leaderElectionEnabled {
@@ -94,6 +94,17 @@ func (s *healthServer) Check(ctx context.Context, in *healthPb.HealthCheckReques
 	return &healthPb.HealthCheckResponse{Status: healthPb.HealthCheckResponse_SERVING}, nil
 }
 
+// anyPoolHasSynced reports whether any of the served pools has synced, so that an Endpoint Picker
+// serving several pools keeps serving the healthy pools while another one is unavailable.
+func (s *healthServer) anyPoolHasSynced() bool {
+	for _, ds := range s.datastores {
+		if ds.PoolHasSynced() {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *healthServer) List(ctx context.Context, _ *healthPb.HealthListRequest) (*healthPb.HealthListResponse, error) {
 	statuses := make(map[string]*healthPb.HealthCheckResponse)
 
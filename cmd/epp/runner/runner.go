@@ -52,6 +52,7 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config/loader"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/discovery"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/contracts"
 	fccontroller "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/controller"
@@ -62,11 +63,19 @@ import (
 	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
 	extractormetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/streammetrics"
 	sourcemetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/metrics"
 	sourcenotifications "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/notifications"
+	sourcestream "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/stream"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/remote"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/loraloader"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/loraplacement"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/requestattributereporter"
 	testresponsereceived "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/test/responsereceived"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/openai"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/filter/metricsstaleness"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/filter/outlierdetection"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/filter/slowstart"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/profile"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/kvcacheutilization"
@@ -76,9 +85,11 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/queuedepth"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/runningrequests"
 	testfilter "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/test/filter"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/wasm"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics/collectors"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/objectivestats"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/requestcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/saturationdetector/framework/plugins/utilizationdetector"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
@@ -154,6 +165,15 @@ func (r *Runner) Run(ctx context.Context) error {
 	// Setup a very basic logger in case command line argument parsing fails
 	logutil.InitSetupLogging()
 
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case validateConfigCommand:
+			return validateConfig(ctx, os.Args[2:], os.Stdout)
+		case configSchemaCommand:
+			return printConfigSchema(os.Stdout)
+		}
+	}
+
 	setupLog.Info(r.eppExecutableName+" build", "commit-sha", version.CommitSHA, "build-ref", version.BuildRef)
 
 	opts := runserver.NewOptions()
@@ -185,10 +205,18 @@ func (r *Runner) Run(ctx context.Context) error {
 	}
 
 	// --- Get Kubernetes Config ---
-	cfg, err := ctrl.GetConfig()
-	if err != nil {
-		setupLog.Error(err, "Failed to get Kubernetes rest config")
-		return err
+	var cfg *rest.Config
+	if opts.DiscoversEndpoints() {
+		// No controller nor leader election runs, so the manager never reaches the API server.
+		setupLog.Info("Endpoints are discovered from a file or DNS, running without Kubernetes")
+		cfg = &rest.Config{}
+	} else {
+		var err error
+		cfg, err = ctrl.GetConfig()
+		if err != nil {
+			setupLog.Error(err, "Failed to get Kubernetes rest config")
+			return err
+		}
 	}
 
 	pmc, err := backendmetrics.NewPodMetricsClientImpl(setupLog, backendmetrics.Config{
@@ -235,7 +263,22 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 	}
 
 	epf := r.setupMetricsCollection(r.featureGates[datalayer.ExperimentalDatalayerFeatureGate], opts, pmc)
-	gknn, err := extractGKNN(opts.PoolName, opts.PoolGroup, opts.PoolNamespace, opts.EndpointSelector)
+	poolNames, err := resolvePoolNames(ctx, cfg, opts)
+	if err != nil {
+		setupLog.Error(err, "Failed to resolve InferencePools")
+		return nil, nil, err
+	}
+	multiPool := len(poolNames) > 0
+
+	var gknn *common.GKNN
+	if multiPool {
+		// The Endpoint Picker serving several pools is identified by its own Deployment.
+		gknn, err = eppGKNN(opts.PoolNamespace)
+	} else if opts.DiscoversEndpoints() {
+		gknn = discoveryGKNN(opts.PoolNamespace)
+	} else {
+		gknn, err = extractGKNN(opts.PoolName, opts.PoolGroup, opts.PoolNamespace, opts.EndpointSelector)
+	}
 	if err != nil {
 		setupLog.Error(err, "Failed to extract GKNN")
 		return nil, nil, err
@@ -243,25 +286,53 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 
 	startCrdReconcilers := opts.EndpointSelector == "" // If endpointSelector is empty, it means it's not in the standalone mode. Then we should start the inferencePool and other CRD Reconciler.
 	controllerCfg := runserver.NewControllerConfig(startCrdReconcilers)
+	if multiPool {
+		controllerCfg = runserver.NewMultiPoolControllerConfig(opts.PoolGroup)
+	} else if opts.DiscoversEndpoints() {
+		controllerCfg = runserver.NewEndpointDiscoveryControllerConfig()
+	}
 	if err := controllerCfg.PopulateControllerConfig(cfg); err != nil {
 		setupLog.Error(err, "Failed to populate controller config")
 		return nil, nil, err
 	}
 
-	ds, err := setupDatastore(ctx, epf, int32(opts.ModelServerMetricsPort), startCrdReconcilers,
-		opts.PoolNamespace, opts.PoolName, opts.EndpointSelector, opts.EndpointTargetPorts)
-	if err != nil {
-		setupLog.Error(err, "Failed to setup datastore")
-		return nil, nil, err
+	var pools []runserver.PoolServer
+	var datastores []datastore.Datastore
+	if multiPool {
+		for _, name := range poolNames {
+			ds := datastore.NewDatastore(ctx, epf, int32(opts.ModelServerMetricsPort))
+			pools = append(pools, runserver.PoolServer{
+				GKNN: common.GKNN{
+					NamespacedName: types.NamespacedName{Name: name, Namespace: gknn.Namespace},
+					GroupKind:      schema.GroupKind{Group: opts.PoolGroup, Kind: "InferencePool"},
+				},
+				Datastore: ds,
+			})
+			datastores = append(datastores, ds)
+		}
+		setupLog.Info("Serving multiple InferencePools", "pools", poolNames)
+	} else if opts.DiscoversEndpoints() {
+		// The pool is set by the endpoint discovery, once the manager starts.
+		datastores = append(datastores, datastore.NewDatastore(ctx, epf, int32(opts.ModelServerMetricsPort)))
+	} else {
+		ds, err := setupDatastore(ctx, epf, int32(opts.ModelServerMetricsPort), startCrdReconcilers,
+			opts.PoolNamespace, opts.PoolName, opts.EndpointSelector, opts.EndpointTargetPorts)
+		if err != nil {
+			setupLog.Error(err, "Failed to setup datastore")
+			return nil, nil, err
+		}
+		datastores = append(datastores, ds)
 	}
-	eppConfig, err := r.parseConfigurationPhaseTwo(ctx, rawConfig, ds)
+	ds := datastores[0]
+
+	eppConfig, err := r.parseConfigurationPhaseTwo(ctx, rawConfig, opts, datastores...)
 	if err != nil {
 		setupLog.Error(err, "Failed to parse configuration")
 		return nil, nil, err
 	}
 
 	// --- Setup Metrics Server ---
-	r.customCollectors = append(r.customCollectors, collectors.NewInferencePoolMetricsCollector(ds))
+	r.customCollectors = append(r.customCollectors, collectors.NewInferencePoolMetricsCollector(datastores...))
 	metrics.Register(r.customCollectors...)
 	metrics.RecordInferenceExtensionInfo(version.CommitSHA, version.BuildRef)
 	// Register metrics handler.
@@ -304,6 +375,13 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 		isLeader.Store(true)
 	}
 
+	if opts.DiscoversEndpoints() {
+		if err := mgr.Add(discovery.NewSyncer(ds, endpointSource(opts), gknn.NamespacedName)); err != nil {
+			setupLog.Error(err, "Failed to register endpoint discovery")
+			return nil, nil, err
+		}
+	}
+
 	if opts.EnablePprof {
 		setupLog.Info("Setting pprof handlers")
 		if err = profiling.SetupPprofHandlers(mgr); err != nil {
@@ -335,7 +413,11 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 	var admissionController requestcontrol.AdmissionController
 	var locator contracts.PodLocator
 	locator = requestcontrol.NewDatastorePodLocator(ds, requestcontrol.WithDisableEndpointSubsetFilter(opts.DisableEndpointSubsetFilter))
-	if r.featureGates[flowcontrol.FeatureGate] {
+	if r.featureGates[flowcontrol.FeatureGate] && multiPool {
+		err := errors.New("the Flow Control layer is not supported when serving multiple InferencePools")
+		setupLog.Error(err, "Failed to initialize admission control")
+		return nil, nil, err
+	} else if r.featureGates[flowcontrol.FeatureGate] {
 		locator = requestcontrol.NewCachedPodLocator(ctx, locator, time.Millisecond*50)
 		setupLog.Info("Initializing experimental Flow Control layer")
 		registry, err := fcregistry.NewFlowRegistry(eppConfig.FlowControlConfig.Registry, setupLog)
@@ -359,7 +441,38 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 		admissionController = requestcontrol.NewLegacyAdmissionController(saturationDetector, locator)
 	}
 
-	director := requestcontrol.NewDirectorWithConfig(ds, scheduler, admissionController, r.parser, locator, r.requestControlConfig)
+	var objectiveStats *objectivestats.Tracker
+	if opts.ObjectiveStatsReportInterval > 0 {
+		objectiveStats = objectivestats.NewTracker()
+		objectiveDatastores := make([]objectivestats.Datastore, 0, len(datastores))
+		for _, ds := range datastores {
+			objectiveDatastores = append(objectiveDatastores, ds)
+		}
+		if err := mgr.Add(objectivestats.NewStatusReporter(mgr.GetClient(), objectiveStats, opts.ObjectiveStatsReportInterval, objectiveDatastores...)); err != nil {
+			setupLog.Error(err, "Failed to register InferenceObjective status reporter")
+			return nil, nil, err
+		}
+	}
+
+	spillover := requestcontrol.NewSpillover(saturationDetector, opts.SpilloverPriorities...)
+	var director handlers.Director = requestcontrol.NewDirectorWithConfig(ds, scheduler, admissionController, r.parser, locator, r.requestControlConfig).
+		WithSpillover(spillover).
+		WithObjectiveStats(objectiveStats)
+	if multiPool {
+		// Each pool gets its own candidate set and admission control, while the scheduler, the
+		// plugins and the saturation detector are shared.
+		routes := make([]requestcontrol.PoolRoute, 0, len(pools))
+		for _, pool := range pools {
+			poolLocator := requestcontrol.NewDatastorePodLocator(pool.Datastore, requestcontrol.WithDisableEndpointSubsetFilter(opts.DisableEndpointSubsetFilter))
+			poolDirector := requestcontrol.NewDirectorWithConfig(pool.Datastore, scheduler,
+				requestcontrol.NewLegacyAdmissionController(saturationDetector, poolLocator), r.parser, poolLocator, r.requestControlConfig).
+				WithSpillover(spillover).
+				WithObjectiveStats(objectiveStats)
+			spillover.WithPool(pool.GKNN.NamespacedName, poolDirector)
+			routes = append(routes, requestcontrol.PoolRoute{Pool: pool.GKNN.NamespacedName, Director: poolDirector})
+		}
+		director = requestcontrol.NewPoolRouter(r.parser, routes...)
+	}
 
 	// --- Setup ExtProc Server Runner ---
 	serverRunner := &runserver.ExtProcServerRunner{
@@ -377,6 +490,7 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 		Parser:                           r.parser,
 		SaturationDetector:               saturationDetector,
 		UseExperimentalDatalayerV2:       r.featureGates[datalayer.ExperimentalDatalayerFeatureGate], // pluggable data layer feature flag
+		Pools:                            pools,
 	}
 
 	if err := serverRunner.SetupWithManager(mgr); err != nil {
@@ -386,7 +500,7 @@ func (r *Runner) setup(ctx context.Context, cfg *rest.Config, opts *runserver.Op
 
 	// --- Add Runnables to Manager ---
 	// Register health server.
-	if err := registerHealthServer(mgr, ctrl.Log.WithName("health"), ds, opts.GRPCHealthPort, isLeader, opts.EnableLeaderElection); err != nil {
+	if err := registerHealthServer(mgr, ctrl.Log.WithName("health"), datastores, opts.GRPCHealthPort, isLeader, opts.EnableLeaderElection); err != nil {
 		return nil, nil, err
 	}
 
@@ -446,23 +560,31 @@ func setupDatastore(ctx context.Context, epFactory datalayer.EndpointFactory, mo
 	}
 }
 
-// registerInTreePlugins registers the factory functions of all known plugins
-func (r *Runner) registerInTreePlugins() {
-	fwkplugin.Register(prefix.PrefixCachePluginType, prefix.PrefixCachePluginFactory)
-	fwkplugin.Register(picker.MaxScorePickerType, picker.MaxScorePickerFactory)
-	fwkplugin.Register(picker.RandomPickerType, picker.RandomPickerFactory)
-	fwkplugin.Register(picker.WeightedRandomPickerType, picker.WeightedRandomPickerFactory)
+// RegisterInTreePlugins registers the factory functions of all known plugins. It is exported for
+// the tools loading EPP configurations outside of the EPP, such as the replay tool.
+func RegisterInTreePlugins() {
+	fwkplugin.RegisterWithParameters(prefix.PrefixCachePluginType, prefix.PrefixCachePluginFactory, prefix.DefaultConfig)
+	fwkplugin.RegisterWithParameters(picker.MaxScorePickerType, picker.MaxScorePickerFactory, picker.DefaultParameters)
+	fwkplugin.RegisterWithParameters(picker.RandomPickerType, picker.RandomPickerFactory, picker.DefaultParameters)
+	fwkplugin.RegisterWithParameters(picker.WeightedRandomPickerType, picker.WeightedRandomPickerFactory, picker.DefaultParameters)
 	fwkplugin.Register(profile.SingleProfileHandlerType, profile.SingleProfileHandlerFactory)
 	fwkplugin.Register(kvcacheutilization.KvCacheUtilizationScorerType, kvcacheutilization.KvCacheUtilizationScorerFactory)
 	fwkplugin.Register(queuedepth.QueueScorerType, queuedepth.QueueScorerFactory)
 	fwkplugin.Register(runningrequests.RunningRequestsSizeScorerType, runningrequests.RunningRequestsSizeScorerFactory)
 	fwkplugin.Register(loraaffinity.LoraAffinityScorerType, loraaffinity.LoraAffinityScorerFactory)
+	fwkplugin.RegisterWithParameters(outlierdetection.OutlierDetectionFilterType, outlierdetection.OutlierDetectionFilterFactory, outlierdetection.DefaultConfig)
+	fwkplugin.RegisterWithParameters(metricsstaleness.MetricsStalenessFilterType, metricsstaleness.MetricsStalenessFilterFactory, metricsstaleness.DefaultConfig)
+	fwkplugin.RegisterWithParameters(slowstart.SlowStartFilterType, slowstart.SlowStartFilterFactory, slowstart.DefaultConfig)
 	// Flow Control plugins
 	fwkplugin.Register(fairness.GlobalStrictFairnessPolicyType, fairness.GlobalStrictFairnessPolicyFactory)
 	fwkplugin.Register(fairness.RoundRobinFairnessPolicyType, fairness.RoundRobinFairnessPolicyFactory)
 	fwkplugin.Register(ordering.FCFSOrderingPolicyType, ordering.FCFSOrderingPolicyFactory)
 	fwkplugin.Register(ordering.EDFOrderingPolicyType, ordering.EDFOrderingPolicyFactory)
 	fwkplugin.Register(ordering.SLODeadlineOrderingPolicyType, ordering.SLODeadlineOrderingPolicyFactory)
+	// register the plugin proxying extension points to an external gRPC service
+	fwkplugin.Register(remote.RemotePluginType, remote.RemotePluginFactory)
+	// register the plugin running WebAssembly modules
+	fwkplugin.Register(wasm.WasmPluginType, wasm.WasmPluginFactory)
 	// Latency predictor plugins
 	fwkplugin.Register(predictedlatency.PredictedLatencyPluginType, predictedlatency.PredictedLatencyFactory)
 	// register filter for test purpose only (used in conformance tests)
@@ -472,11 +594,23 @@ func (r *Runner) registerInTreePlugins() {
 	// register datalayer metrics collection plugins
 	fwkplugin.Register(sourcemetrics.MetricsDataSourceType, sourcemetrics.MetricsDataSourceFactory)
 	fwkplugin.Register(extractormetrics.MetricsExtractorType, extractormetrics.CoreMetricsExtractorFactory)
+	// register datalayer streaming source plugins
+	fwkplugin.Register(sourcestream.StreamDataSourceType, sourcestream.StreamDataSourceFactory)
+	fwkplugin.Register(streammetrics.StreamMetricsExtractorType, streammetrics.StreamMetricsExtractorFactory)
 	// register datalayer k8s notification source plugin
 	fwkplugin.Register(sourcenotifications.NotificationSourceType, sourcenotifications.NotificationSourceFactory)
 	// register request control pluigns
 	fwkplugin.Register(requestattributereporter.RequestAttributeReporterType, requestattributereporter.RequestAttributeReporterPluginFactory)
 	fwkplugin.Register(openai.OpenAIParserType, openai.OpenAIParserPluginFactory)
+	fwkplugin.Register(loraloader.LoraAdapterLoaderType, loraloader.LoraAdapterLoaderFactory)
+	fwkplugin.Register(loraplacement.LoraPlacementPlannerType, loraplacement.LoraPlacementPlannerFactory)
+}
+
+// registerFeatureGates registers the feature gates known to the configuration loader.
+func registerFeatureGates() {
+	loader.RegisterFeatureGate(datalayer.ExperimentalDatalayerFeatureGate)
+	loader.RegisterFeatureGate(flowcontrol.FeatureGate)
+	loader.RegisterFeatureGate(datalayer.PrepareDataPluginsFeatureGate)
 }
 
 func (r *Runner) parseConfigurationPhaseOne(ctx context.Context, opts *runserver.Options) (*configapi.EndpointPickerConfig, error) {
@@ -493,11 +627,8 @@ func (r *Runner) parseConfigurationPhaseOne(ctx context.Context, opts *runserver
 		}
 	}
 
-	loader.RegisterFeatureGate(datalayer.ExperimentalDatalayerFeatureGate)
-	loader.RegisterFeatureGate(flowcontrol.FeatureGate)
-	loader.RegisterFeatureGate(datalayer.PrepareDataPluginsFeatureGate)
-
-	r.registerInTreePlugins()
+	registerFeatureGates()
+	RegisterInTreePlugins()
 
 	rawConfig, featureGates, err := loader.LoadRawConfig(configBytes, logger)
 	if err != nil {
@@ -510,25 +641,35 @@ func (r *Runner) parseConfigurationPhaseOne(ctx context.Context, opts *runserver
 }
 
 // Return a function that can be used in the EPP Handle to list pod names.
-func makePodListFunc(ds datastore.Datastore) func() []types.NamespacedName {
+func makePodListFunc(datastores ...datastore.Datastore) func() []types.NamespacedName {
 	return func() []types.NamespacedName {
-		pods := ds.PodList(datastore.AllPodsPredicate)
-		names := make([]types.NamespacedName, 0, len(pods))
-
-		for _, p := range pods {
-			names = append(names, p.GetMetadata().NamespacedName)
+		var names []types.NamespacedName
+		for _, ds := range datastores {
+			for _, p := range ds.PodList(datastore.AllPodsPredicate) {
+				names = append(names, p.GetMetadata().NamespacedName)
+			}
 		}
 		return names
 	}
 }
 
-func (r *Runner) parseConfigurationPhaseTwo(ctx context.Context, rawConfig *configapi.EndpointPickerConfig, ds datastore.Datastore) (*config.Config, error) {
+func makeEndpointListFunc(datastores ...datastore.Datastore) fwkdl.EndpointListFunc {
+	return func() []fwkdl.Endpoint {
+		var endpoints []fwkdl.Endpoint
+		for _, ds := range datastores {
+			endpoints = append(endpoints, ds.PodList(datastore.AllPodsPredicate)...)
+		}
+		return endpoints
+	}
+}
+
+func (r *Runner) parseConfigurationPhaseTwo(ctx context.Context, rawConfig *configapi.EndpointPickerConfig, opts *runserver.Options, datastores ...datastore.Datastore) (*config.Config, error) {
 	logger := log.FromContext(ctx)
 
 	applyDeprecatedEnvFeatureGate(enableExperimentalDatalayerV2, "Data Layer V2", datalayer.ExperimentalDatalayerFeatureGate, rawConfig)
 	applyDeprecatedEnvFeatureGate(enableExperimentalFlowControlLayer, "Flow Control layer", flowcontrol.FeatureGate, rawConfig)
 
-	handle := fwkplugin.NewEppHandle(ctx, makePodListFunc(ds))
+	handle := fwkplugin.NewEppHandle(ctx, makePodListFunc(datastores...), fwkplugin.WithMetricsStalenessThreshold(opts.MetricsStalenessThreshold))
 	cfg, err := loader.InstantiateAndConfigure(rawConfig, handle, logger)
 
 	if err != nil {
@@ -540,6 +681,38 @@ func (r *Runner) parseConfigurationPhaseTwo(ctx context.Context, rawConfig *conf
 	// Add requestControl plugins
 	r.requestControlConfig.AddPlugins(handle.GetAllPlugins()...)
 
+	// Notify the plugins keeping per-endpoint state of draining and removed endpoints.
+	var endpointListeners []fwkdl.EndpointListener
+	for _, plugin := range handle.GetAllPlugins() {
+		if listener, ok := plugin.(fwkdl.EndpointListener); ok {
+			endpointListeners = append(endpointListeners, listener)
+		}
+	}
+	for _, ds := range datastores {
+		ds.SetEndpointListeners(endpointListeners...)
+	}
+
+	// Give the plugins working outside of the request path access to the live endpoints.
+	for _, plugin := range handle.GetAllPlugins() {
+		if consumer, ok := plugin.(fwkdl.EndpointListConsumer); ok {
+			consumer.SetEndpointList(makeEndpointListFunc(datastores...))
+		}
+	}
+
+	// Register the metrics of the plugins with the custom collectors. Instances of the same plugin
+	// type share their collectors, which must be registered once.
+	registered := map[prometheus.Collector]bool{}
+	for _, plugin := range handle.GetAllPlugins() {
+		if collector, ok := plugin.(fwkplugin.MetricsCollector); ok {
+			for _, c := range collector.Collectors() {
+				if !registered[c] {
+					registered[c] = true
+					r.customCollectors = append(r.customCollectors, c)
+				}
+			}
+		}
+	}
+
 	// Sort data plugins in DAG order (topological sort). Also check DAG for cycles.
 	dag, err := datalayer.ValidateAndOrderDataDependencies(handle.GetAllPlugins())
 
@@ -551,8 +724,10 @@ func (r *Runner) parseConfigurationPhaseTwo(ctx context.Context, rawConfig *conf
 		// If the feature gate is disabled, clear any prepare data plugins so they are not used.
 		r.requestControlConfig.WithPrepareDataPlugins()
 	}
-	// The plugins will be executed in topologically sorted order to ensure that data is produced before it is consumed.
+	// The plugins will be executed in topologically sorted order to ensure that data is produced before it is consumed,
+	// with the timeouts and failure policies of the configuration.
 	r.requestControlConfig.OrderPrepareDataPlugins(dag)
+	r.requestControlConfig.WithPrepareDataConfig(cfg.PrepareDataConfig)
 
 	r.applyDeprecatedSaturationConfig(cfg)
 
@@ -615,8 +790,8 @@ func (r *Runner) setupDataLayer(enableNewMetrics bool, cfg *datalayer.Config,
 		return errors.New("data layer enabled but no data sources configured")
 	}
 
-	// Partition sources: poll-based go to the endpoint factory, notification
-	// sources get bound to the manager's watch/reconciliation loops.
+	// Partition sources: poll-based and streaming sources go to the endpoint factory,
+	// notification sources get bound to the manager's watch/reconciliation loops.
 	var collectors []fwkdl.DataSource
 	for _, src := range allSources {
 		if notifySrc, ok := src.(fwkdl.NotificationSource); ok {
@@ -626,6 +801,8 @@ func (r *Runner) setupDataLayer(enableNewMetrics bool, cfg *datalayer.Config,
 			setupLog.Info("notification source bound", "source", notifySrc.TypedName().String(), "gvk", notifySrc.GVK())
 		} else if _, isPolling := src.(fwkdl.PollingDataSource); isPolling {
 			collectors = append(collectors, src)
+		} else if _, isStreaming := src.(fwkdl.StreamingDataSource); isStreaming {
+			collectors = append(collectors, src)
 		} else {
 			return fmt.Errorf("skipping unknown datasource plugin type %s", src.TypedName().String())
 		}
@@ -657,11 +834,11 @@ func registerExtProcServer(mgr manager.Manager, runner *runserver.ExtProcServerR
 }
 
 // registerHealthServer adds the Health gRPC server as a Runnable to the given manager.
-func registerHealthServer(mgr manager.Manager, logger logr.Logger, ds datastore.Datastore, port int, isLeader *atomic.Bool, leaderElectionEnabled bool) error {
+func registerHealthServer(mgr manager.Manager, logger logr.Logger, datastores []datastore.Datastore, port int, isLeader *atomic.Bool, leaderElectionEnabled bool) error {
 	srv := grpc.NewServer()
 	healthPb.RegisterHealthServer(srv, &healthServer{
 		logger:                logger,
-		datastore:             ds,
+		datastores:            datastores,
 		isLeader:              isLeader,
 		leaderElectionEnabled: leaderElectionEnabled,
 	})
@@ -702,26 +879,73 @@ func extractGKNN(poolName, poolGroup, poolNamespace, endpointSelector string) (*
 	}
 
 	if endpointSelector != "" {
-		// Determine EPP namespace: NAMESPACE env var; else default
-		resolvedPoolNamespace := resolvePoolNamespace(poolNamespace)
-		// Determine EPP name: POD_NAME env var
-		eppPodNameEnv := os.Getenv("POD_NAME")
-		if eppPodNameEnv == "" {
-			return nil, errors.New("failed to get environment variable POD_NAME")
-
-		}
-		eppName, err := extractDeploymentName(eppPodNameEnv)
-		if err != nil {
-			return nil, err
-		}
-		return &common.GKNN{
-			NamespacedName: types.NamespacedName{Namespace: resolvedPoolNamespace, Name: eppName},
-			GroupKind:      schema.GroupKind{Kind: "Deployment", Group: "apps"},
-		}, nil
+		return eppGKNN(poolNamespace)
 	}
 	return nil, errors.New("can't construct gknn as both pool-name and endpoint-selector are missing")
 }
 
+// eppGKNN returns the GKNN of the Deployment of this Endpoint Picker, for modes in which it is
+// not identified by a single InferencePool.
+func eppGKNN(poolNamespace string) (*common.GKNN, error) {
+	// Determine EPP namespace: NAMESPACE env var; else default
+	resolvedPoolNamespace := resolvePoolNamespace(poolNamespace)
+	// Determine EPP name: POD_NAME env var
+	eppPodNameEnv := os.Getenv("POD_NAME")
+	if eppPodNameEnv == "" {
+		return nil, errors.New("failed to get environment variable POD_NAME")
+
+	}
+	eppName, err := extractDeploymentName(eppPodNameEnv)
+	if err != nil {
+		return nil, err
+	}
+	return &common.GKNN{
+		NamespacedName: types.NamespacedName{Namespace: resolvedPoolNamespace, Name: eppName},
+		GroupKind:      schema.GroupKind{Kind: "Deployment", Group: "apps"},
+	}, nil
+}
+
+// discoveryGKNN returns the GKNN of an Endpoint Picker whose endpoints are discovered from a file
+// or DNS. It is identified by its Deployment when it runs in Kubernetes, and by a fixed name
+// otherwise.
+func discoveryGKNN(poolNamespace string) *common.GKNN {
+	if gknn, err := eppGKNN(poolNamespace); err == nil {
+		return gknn
+	}
+	return &common.GKNN{
+		NamespacedName: types.NamespacedName{Namespace: resolvePoolNamespace(poolNamespace), Name: "epp"},
+		GroupKind:      schema.GroupKind{Kind: "Deployment", Group: "apps"},
+	}
+}
+
+// endpointSource returns the source of the endpoints discovered from a file or DNS.
+func endpointSource(opts *runserver.Options) discovery.Source {
+	if opts.EndpointsFile != "" {
+		return discovery.NewFileSource(opts.EndpointsFile)
+	}
+	return discovery.NewDNSSource(opts.EndpointsDNSSRV, opts.EndpointsDNSRefreshInterval, nil)
+}
+
+// resolvePoolNames returns the names of the InferencePools served when the Endpoint Picker
+// serves several pools, or nil when it serves a single pool or runs in standalone mode.
+func resolvePoolNames(ctx context.Context, cfg *rest.Config, opts *runserver.Options) ([]string, error) {
+	if len(opts.PoolNames) > 0 {
+		return opts.PoolNames, nil
+	}
+	if opts.StartupPoolSelector == "" {
+		return nil, nil
+	}
+	namespace := resolvePoolNamespace(opts.PoolNamespace)
+	names, err := runserver.ListInferencePoolNames(ctx, cfg, opts.PoolGroup, namespace, opts.StartupPoolSelector)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no InferencePools in namespace %s match the selector %q", namespace, opts.StartupPoolSelector)
+	}
+	return names, nil
+}
+
 func resolvePoolNamespace(poolNamespace string) string {
 	if poolNamespace != "" {
 		return poolNamespace
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config/schema"
+)
+
+// configSchemaCommand is the subcommand printing the JSON Schema of the configuration.
+const configSchemaCommand = "config-schema"
+
+// printConfigSchema implements the config-schema subcommand. It writes the JSON Schema of the
+// configuration, describing the parameters of the plugins registered in this binary.
+func printConfigSchema(out io.Writer) error {
+	registerFeatureGates()
+	RegisterInTreePlugins()
+
+	configSchema, err := schema.Generate()
+	if err != nil {
+		return err
+	}
+	encoded, err := json.MarshalIndent(configSchema, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to print the configuration schema - %w", err)
+	}
+	_, err = fmt.Fprintln(out, string(encoded))
+	return err
+}
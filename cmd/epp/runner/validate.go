@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config/loader"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// validateConfigCommand is the subcommand checking a configuration without running the EPP.
+const validateConfigCommand = "validate-config"
+
+// validateConfig implements the validate-config subcommand. It loads the configuration given by the
+// arguments in a dry run, with the plugins registered in this binary and a handle that sees no
+// endpoints, and writes the effective configuration, the execution order of the data plugins and all
+// the problems found. It fails when the configuration has problems.
+func validateConfig(ctx context.Context, args []string, out io.Writer) error {
+	var configFile, configText string
+	fs := pflag.NewFlagSet(validateConfigCommand, pflag.ContinueOnError)
+	fs.StringVar(&configFile, "config-file", "", "The path to the configuration file.")
+	fs.StringVar(&configText, "config-text", "", "The configuration specified as text, in lieu of a file.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if configFile != "" && configText != "" {
+		return errors.New("the config-file and config-text flags are mutually exclusive")
+	}
+
+	configBytes := []byte(configText)
+	if configFile != "" {
+		var err error
+		configBytes, err = os.ReadFile(configFile)
+		if err != nil {
+			return fmt.Errorf("failed to load config from a file '%s' - %w", configFile, err)
+		}
+	}
+
+	registerFeatureGates()
+	RegisterInTreePlugins()
+
+	// Plugins may start background routines bound to the context of the handle, which end here.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	handle := fwkplugin.NewEppHandle(ctx, makePodListFunc())
+	result := loader.DryRun(configBytes, handle, logr.Discard())
+
+	if result.Config != nil {
+		effective, err := yaml.Marshal(result.Config)
+		if err != nil {
+			return fmt.Errorf("failed to print the effective configuration - %w", err)
+		}
+		fmt.Fprintf(out, "Effective configuration:\n%s\n", effective)
+	}
+	if result.PluginOrder != nil {
+		fmt.Fprintln(out, "Data plugin execution order:")
+		for i, name := range result.PluginOrder {
+			fmt.Fprintf(out, "  %d. %s\n", i+1, name)
+		}
+		fmt.Fprintln(out)
+	}
+
+	if len(result.Errors) == 0 {
+		fmt.Fprintln(out, "The configuration is valid.")
+		return nil
+	}
+	fmt.Fprintf(out, "The configuration has %d error(s):\n", len(result.Errors))
+	for _, err := range result.Errors {
+		fmt.Fprintf(out, "  %s\n", err.Error())
+	}
+	return fmt.Errorf("the configuration has %d error(s)", len(result.Errors))
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The replay command replays a JSONL trace of requests through the director of the EPP with the
+// plugins of one or more EPP configurations against a simulated fleet of model servers, and reports
+// the latency, prefix cache hit rate, load imbalance and SLO attainment of each configuration.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/pflag"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"sigs.k8s.io/gateway-api-inference-extension/cmd/epp/runner"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config/loader"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/replay"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+func main() {
+	if err := run(ctrl.SetupSignalHandler()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	model := replay.DefaultServingModel()
+	var tracePath, output string
+	var configs []string
+
+	fs := pflag.CommandLine
+	fs.StringVar(&tracePath, "trace", "", "Path of the JSONL trace of requests to replay.")
+	fs.StringArrayVar(&configs, "config", nil, "EPP configuration file to replay the trace against, as "+
+		"[name=]path. Repeat the flag to compare configurations. The default EPP configuration is used if none is given.")
+	fs.StringVar(&output, "output", outputTable, "Format of the report, either table or json.")
+	fs.IntVar(&model.Endpoints, "endpoints", model.Endpoints, "Number of simulated model servers.")
+	fs.IntVar(&model.MaxRunningRequests, "max-running-requests", model.MaxRunningRequests, "Maximum batch size of a model server.")
+	fs.IntVar(&model.KVCacheTokens, "kv-cache-tokens", model.KVCacheTokens, "KV cache capacity of a model server in tokens.")
+	fs.IntVar(&model.BlockSize, "block-size", model.BlockSize, "Number of tokens of a KV cache block.")
+	fs.IntVar(&model.MaxActiveModels, "max-active-models", model.MaxActiveModels, "Maximum number of models, including LoRA adapters, active on a model server.")
+	fs.DurationVar(&model.StepTime, "step-time", model.StepTime, "Fixed time of a step of a model server.")
+	fs.DurationVar(&model.DecodeTimePerRequest, "decode-time-per-request", model.DecodeTimePerRequest, "Time added to a step for every request generating a token.")
+	fs.DurationVar(&model.PrefillTimePerToken, "prefill-time-per-token", model.PrefillTimePerToken, "Time added to a step for every prompt token missing the prefix cache.")
+	logging := logutil.NewOptions()
+	logging.LogVerbosity = 0
+	logging.AddFlags(fs)
+	pflag.Parse()
+
+	if err := logging.Complete(); err != nil {
+		return err
+	}
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&logging.ZapOptions)))
+	if tracePath == "" {
+		return errors.New("--trace is required")
+	}
+	if output != outputTable && output != outputJSON {
+		return fmt.Errorf("unknown output format %q", output)
+	}
+	if err := model.Validate(); err != nil {
+		return err
+	}
+
+	traceFile, err := os.Open(tracePath)
+	if err != nil {
+		return fmt.Errorf("failed to open the trace - %w", err)
+	}
+	trace, err := replay.ReadTrace(traceFile)
+	_ = traceFile.Close()
+	if err != nil {
+		return err
+	}
+	configurations, err := loadConfigurations(configs)
+	if err != nil {
+		return err
+	}
+
+	loader.RegisterFeatureGate(datalayer.ExperimentalDatalayerFeatureGate)
+	loader.RegisterFeatureGate(flowcontrol.FeatureGate)
+	loader.RegisterFeatureGate(datalayer.PrepareDataPluginsFeatureGate)
+	runner.RegisterInTreePlugins()
+
+	reports := make([]*replay.Report, 0, len(configurations))
+	for _, configuration := range configurations {
+		report, err := replay.Run(ctx, trace, model, configuration)
+		if err != nil {
+			return err
+		}
+		reports = append(reports, report)
+	}
+
+	if output == outputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(reports)
+	}
+	return replay.WriteReports(os.Stdout, reports)
+}
+
+// loadConfigurations reads the configuration files given as [name=]path, naming each configuration
+// after its file unless a name is given.
+func loadConfigurations(configs []string) ([]replay.Configuration, error) {
+	if len(configs) == 0 {
+		return []replay.Configuration{{Name: "default"}}, nil
+	}
+	configurations := make([]replay.Configuration, 0, len(configs))
+	for _, config := range configs {
+		name, path, named := strings.Cut(config, "=")
+		if !named {
+			path = config
+			name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		}
+		text, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load config from a file '%s' - %w", path, err)
+		}
+		configurations = append(configurations, replay.Configuration{Name: name, Text: text})
+	}
+	return configurations, nil
+}
@@ -0,0 +1,46 @@
+#!/usr/bin/env bash
+
+# Copyright 2026 The Kubernetes Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Generates the Go code of the protobuf APIs. The protoc, protoc-gen-go and protoc-gen-go-grpc
+# versions are pinned in the Makefile, run through "make generate-proto".
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+SCRIPT_ROOT=$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)
+LOCALBIN=${LOCALBIN:-${SCRIPT_ROOT}/bin}
+PROTOC=${PROTOC:-${LOCALBIN}/protoc}
+BOILERPLATE="${SCRIPT_ROOT}/hack/boilerplate/boilerplate.generatego.txt"
+
+PROTOS=(
+  pkg/epp/framework/plugins/remote/api/v1alpha1/remote.proto
+)
+
+cd "${SCRIPT_ROOT}"
+for proto in "${PROTOS[@]}"; do
+  echo "Generating ${proto}"
+  PATH="${LOCALBIN}:${PATH}" "${PROTOC}" \
+    --proto_path=. \
+    --go_out=. --go_opt=paths=source_relative \
+    --go-grpc_out=. --go-grpc_opt=paths=source_relative \
+    "${proto}"
+
+  for generated in "${proto%.proto}.pb.go" "${proto%.proto}_grpc.pb.go"; do
+    { cat "${BOILERPLATE}"; echo; cat "${generated}"; } > "${generated}.tmp"
+    mv "${generated}.tmp" "${generated}"
+  done
+done
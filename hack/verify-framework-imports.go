@@ -47,6 +47,7 @@ var (
 // These are utility packages that the framework is permitted to import.
 var globalExceptions = []string{
 	"pkg/common/observability/logging",
+	"pkg/common/observability/metrics",
 }
 
 // currentCodeExceptionMap maps existing violation in files to their allowed import exceptions.
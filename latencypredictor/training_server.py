@@ -1855,6 +1855,8 @@ async def model_download_info():
         info["available_endpoints"]["lightgbm"] = {
             "ttft_model_txt": "/model/ttft/lgb/txt",
             "tpot_model_txt": "/model/tpot/lgb/txt",
+            "ttft_model_json": "/model/ttft/lgb/json",
+            "tpot_model_json": "/model/tpot/lgb/json",
             "ttft_importances": "/model/ttft/lgb/importances",
             "tpot_importances": "/model/tpot/lgb/importances"
         }
@@ -1877,48 +1879,74 @@ async def model_download_info():
     
     return info
 
-@app.get("/model/ttft/xgb/json")
-async def ttft_xgb_json():
+def _tree_model(name: str, regime: Optional[str]):
+    """
+    Return the TTFT or TPOT model to dump, or one of its gated sub-models when
+    a queue regime ("noqueue" or "queued") is given.
+    """
+    if regime is None:
+        model = predictor.ttft_model if name == "ttft" else predictor.tpot_model
+        if not model:
+            raise HTTPException(status_code=404, detail=f"{name.upper()} model not available")
+        return model
+    if regime not in ("noqueue", "queued"):
+        raise HTTPException(status_code=400, detail=f"Unknown queue regime: {regime}")
+    gated = predictor.ttft_gated if name == "ttft" else predictor.tpot_gated
+    if not predictor.ensemble_active or gated is None:
+        raise HTTPException(status_code=404, detail=f"{name.upper()} ensemble not active")
+    return gated.noqueue_model if regime == "noqueue" else gated.queued_model
+
+
+def _xgb_base_score(booster) -> float:
     """
-    Dump the TTFT XGBoost model as JSON trees.
+    Return the base score XGBoost adds to the sum of the tree leaves. It is not
+    part of the tree dump; newer versions report it as a one-element list.
     """
+    config = json.loads(booster.save_config())
+    return float(config["learner"]["learner_model_param"]["base_score"].strip("[]"))
+
+
+def _tree_dump_headers(base_score: Optional[float] = None) -> Dict[str, str]:
+    """Headers clients need to evaluate a dumped tree model natively."""
+    headers = {"X-Ensemble-Active": "true" if predictor.ensemble_active else "false"}
+    if base_score is not None:
+        headers["X-Base-Score"] = repr(base_score)
+    return headers
+
+
+def _xgb_json(name: str, regime: Optional[str]) -> JSONResponse:
     if predictor.model_type != ModelType.XGBOOST:
-        raise HTTPException(status_code=404, detail="TTFT model is not XGBoost")
-    
-    if not predictor.ttft_model:
-        raise HTTPException(status_code=404, detail="TTFT model not available")
-        
+        raise HTTPException(status_code=404, detail=f"{name.upper()} model is not XGBoost")
+
+    model = _tree_model(name, regime)
     try:
-        booster = predictor.ttft_model.get_booster()
+        booster = model.get_booster()
         # get_dump with dump_format="json" gives one JSON string per tree
         raw_trees = booster.get_dump(dump_format="json")
         # parse each string into a dict so the response is a JSON array of objects
         trees = [json.loads(t) for t in raw_trees]
-        return JSONResponse(content=trees)
+        return JSONResponse(content=trees, headers=_tree_dump_headers(_xgb_base_score(booster)))
     except Exception as e:
-        logging.error(f"Error dumping TTFT XGBoost trees: {e}", exc_info=True)
-        raise HTTPException(status_code=500, detail="Error dumping TTFT XGBoost trees")
+        logging.error(f"Error dumping {name.upper()} XGBoost trees: {e}", exc_info=True)
+        raise HTTPException(status_code=500, detail=f"Error dumping {name.upper()} XGBoost trees")
+
+
+@app.get("/model/ttft/xgb/json")
+async def ttft_xgb_json(regime: Optional[str] = None):
+    """
+    Dump the TTFT XGBoost model as JSON trees. Pass regime=noqueue or
+    regime=queued to dump a gated ensemble sub-model instead.
+    """
+    return _xgb_json("ttft", regime)
 
 
 @app.get("/model/tpot/xgb/json")
-async def tpot_xgb_json():
+async def tpot_xgb_json(regime: Optional[str] = None):
     """
-    Dump the TPOT XGBoost model as JSON trees.
+    Dump the TPOT XGBoost model as JSON trees. Pass regime=noqueue or
+    regime=queued to dump a gated ensemble sub-model instead.
     """
-    if predictor.model_type != ModelType.XGBOOST:
-        raise HTTPException(status_code=404, detail="TPOT model is not XGBoost")
-    
-    if not predictor.tpot_model:
-        raise HTTPException(status_code=404, detail="TPOT model not available")
-        
-    try:
-        booster = predictor.tpot_model.get_booster()
-        raw_trees = booster.get_dump(dump_format="json")
-        trees = [json.loads(t) for t in raw_trees]
-        return JSONResponse(content=trees)
-    except Exception as e:
-        logging.error(f"Error dumping TPOT XGBoost trees: {e}", exc_info=True)
-        raise HTTPException(status_code=500, detail="Error dumping TPOT XGBoost trees")
+    return _xgb_json("tpot", regime)
 
 
 
@@ -2069,6 +2097,33 @@ async def tpot_lgb_txt():
         filename='tpot_lgb_model.txt'
     )
 
+def _lgb_json(name: str, regime: Optional[str]) -> JSONResponse:
+    if predictor.model_type != ModelType.LIGHTGBM:
+        raise HTTPException(status_code=404, detail=f"{name.upper()} model is not LightGBM")
+
+    model = _tree_model(name, regime)
+    try:
+        return JSONResponse(content=model.booster_.dump_model(), headers=_tree_dump_headers())
+    except Exception as e:
+        logging.error(f"Error dumping {name.upper()} LightGBM model: {e}", exc_info=True)
+        raise HTTPException(status_code=500, detail=f"Error dumping {name.upper()} LightGBM model")
+
+@app.get("/model/ttft/lgb/json")
+async def ttft_lgb_json(regime: Optional[str] = None):
+    """
+    Dump the TTFT LightGBM model as JSON (Booster.dump_model). Pass
+    regime=noqueue or regime=queued to dump a gated ensemble sub-model instead.
+    """
+    return _lgb_json("ttft", regime)
+
+@app.get("/model/tpot/lgb/json")
+async def tpot_lgb_json(regime: Optional[str] = None):
+    """
+    Dump the TPOT LightGBM model as JSON (Booster.dump_model). Pass
+    regime=noqueue or regime=queued to dump a gated ensemble sub-model instead.
+    """
+    return _lgb_json("tpot", regime)
+
 @app.get("/model/ttft/lgb/importances")
 async def ttft_lgb_importances():
     """
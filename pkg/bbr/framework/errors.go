@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ImmediateResponse is returned as an error by a RequestProcessor to stop processing a request and
+// respond to the client directly, without forwarding the request upstream.
+type ImmediateResponse struct {
+	// StatusCode is the HTTP status code returned to the client.
+	StatusCode int
+	// Headers are the headers set on the response.
+	Headers map[string]string
+	// Body is the response body returned to the client.
+	Body []byte
+}
+
+func (r *ImmediateResponse) Error() string {
+	return fmt.Sprintf("immediate response with status %d: %s", r.StatusCode, r.Body)
+}
+
+// NewErrorResponse returns an ImmediateResponse carrying an OpenAI compatible JSON error body.
+func NewErrorResponse(statusCode int, errType, message string) *ImmediateResponse {
+	body, _ := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"message": message,
+			"type":    errType,
+			"code":    statusCode,
+		},
+	})
+	return &ImmediateResponse{
+		StatusCode: statusCode,
+		Headers:    map[string]string{"content-type": "application/json"},
+		Body:       body,
+	}
+}
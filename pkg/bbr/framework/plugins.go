@@ -30,6 +30,8 @@ type RequestProcessor interface {
 	BBRPlugin
 	// ProcessRequest runs the RequestProcessor plugin.
 	// RequestProcessor can mutate the headers and/or the body of the request.
+	// Returning an *ImmediateResponse rejects the request and sends the given response to the client.
+	// Any other error is logged and the request is forwarded unmodified.
 	ProcessRequest(ctx context.Context, request *InferenceRequest) error
 }
 
@@ -19,11 +19,13 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
 	eppb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+	envoyTypePb "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
@@ -42,6 +44,11 @@ func (s *Server) HandleRequestBody(ctx context.Context, reqCtx *RequestContext,
 	}
 
 	if err := s.runRequestPlugins(ctx, reqCtx.Request); err != nil {
+		var immediate *framework.ImmediateResponse
+		if errors.As(err, &immediate) {
+			logger.V(logutil.VERBOSE).Info("Request rejected by request plugin", "reason", err.Error())
+			return []*eppb.ProcessingResponse{buildImmediateResponse(immediate)}, nil
+		}
 		logger.V(logutil.DEFAULT).Error(err, "failed to execute request plugins")
 		if s.streaming {
 			ret = append(ret, &eppb.ProcessingResponse{
@@ -136,6 +143,10 @@ func (s *Server) runRequestPlugins(ctx context.Context, request *framework.Infer
 		err = plugin.ProcessRequest(ctx, request)
 		metrics.RecordPluginProcessingLatency(requestPluginExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, time.Since(before))
 		if err != nil {
+			var immediate *framework.ImmediateResponse
+			if errors.As(err, &immediate) {
+				metrics.RecordRequestRejected(plugin.TypedName().Type, plugin.TypedName().Name, immediate.StatusCode)
+			}
 			return fmt.Errorf("failed to execute request plugin '%s' - %w", plugin.TypedName(), err)
 		}
 	}
@@ -143,6 +154,27 @@ func (s *Server) runRequestPlugins(ctx context.Context, request *framework.Infer
 	return nil
 }
 
+// buildImmediateResponse converts an ImmediateResponse returned by a request plugin into an
+// ext-proc response that ends the request with the given status, headers and body.
+func buildImmediateResponse(immediate *framework.ImmediateResponse) *eppb.ProcessingResponse {
+	response := &eppb.ImmediateResponse{
+		Status: &envoyTypePb.HttpStatus{
+			Code: envoyTypePb.StatusCode(immediate.StatusCode),
+		},
+		Body: immediate.Body,
+	}
+	if len(immediate.Headers) > 0 {
+		response.Headers = &eppb.HeaderMutation{
+			SetHeaders: envoy.GenerateHeadersMutation(immediate.Headers),
+		}
+	}
+	return &eppb.ProcessingResponse{
+		Response: &eppb.ProcessingResponse_ImmediateResponse{
+			ImmediateResponse: response,
+		},
+	}
+}
+
 func addStreamedBodyResponse(responses []*eppb.ProcessingResponse, requestBodyBytes []byte) []*eppb.ProcessingResponse {
 	commonResponses := envoy.BuildChunkedBodyResponses(requestBodyBytes, true)
 	for _, commonResp := range commonResponses {
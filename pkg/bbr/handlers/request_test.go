@@ -19,12 +19,14 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 	"strconv"
 	"strings"
 	"testing"
 
 	basepb "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+	envoyTypePb "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/testing/protocmp"
 	metricsutils "k8s.io/component-base/metrics/testutil"
@@ -687,3 +689,54 @@ func TestHandleRequestBody_BodyMutation(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleRequestBody_ImmediateResponse(t *testing.T) {
+	metrics.Register()
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	rejecting := &bodyMutatingPlugin{
+		name: "rejecter",
+		mutateFn: func(_ context.Context, _ *framework.InferenceRequest) error {
+			return framework.NewErrorResponse(http.StatusBadRequest, "invalid_request_error", "rejected")
+		},
+	}
+	wantBody := framework.NewErrorResponse(http.StatusBadRequest, "invalid_request_error", "rejected").Body
+
+	for _, streaming := range []bool{false, true} {
+		server := NewServer(streaming, []framework.RequestProcessor{rejecting}, []framework.ResponseProcessor{})
+		reqCtx := &RequestContext{Request: framework.NewInferenceRequest()}
+
+		resp, err := server.HandleRequestBody(ctx, reqCtx, mapToBytes(t, map[string]any{"model": "foo"}))
+		if err != nil {
+			t.Fatalf("HandleRequestBody returned unexpected error: %v", err)
+		}
+
+		want := []*extProcPb.ProcessingResponse{
+			{
+				Response: &extProcPb.ProcessingResponse_ImmediateResponse{
+					ImmediateResponse: &extProcPb.ImmediateResponse{
+						Status: &envoyTypePb.HttpStatus{Code: envoyTypePb.StatusCode_BadRequest},
+						Headers: &extProcPb.HeaderMutation{
+							SetHeaders: []*basepb.HeaderValueOption{
+								{Header: &basepb.HeaderValue{Key: "content-type", RawValue: []byte("application/json")}},
+							},
+						},
+						Body: wantBody,
+					},
+				},
+			},
+		}
+		if diff := cmp.Diff(want, resp, protocmp.Transform()); diff != "" {
+			t.Errorf("HandleRequestBody(streaming=%v) returned unexpected response, diff(-want, +got): %v", streaming, diff)
+		}
+	}
+
+	wantMetrics := `
+	# HELP bbr_request_rejected_total [ALPHA] Count of requests rejected by a plugin with an immediate response.
+	# TYPE bbr_request_rejected_total counter
+	bbr_request_rejected_total{code="400",plugin_name="rejecter",plugin_type="fake"} 2
+	`
+	if err := metricsutils.GatherAndCompare(crmetrics.Registry, strings.NewReader(wantMetrics), "bbr_request_rejected_total"); err != nil {
+		t.Error(err)
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package metrics
 
 import (
+	"strconv"
 	"sync"
 	"time"
 
@@ -67,6 +68,15 @@ var (
 		[]string{"field"},
 	)
 
+	requestRejectedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: component,
+			Name:      "request_rejected_total",
+			Help:      metricsutil.HelpMsgWithStability("Count of requests rejected by a plugin with an immediate response.", compbasemetrics.ALPHA),
+		},
+		[]string{"plugin_type", "plugin_name", "code"},
+	)
+
 	pluginProcessingLatencies = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Subsystem: component,
@@ -89,6 +99,7 @@ func Register(customCollectors ...prometheus.Collector) {
 		metrics.Registry.MustRegister(successCounter)
 		metrics.Registry.MustRegister(bodyFieldNotFoundCounter)
 		metrics.Registry.MustRegister(bodyFieldEmptyCounter)
+		metrics.Registry.MustRegister(requestRejectedCounter)
 		metrics.Registry.MustRegister(pluginProcessingLatencies)
 		for _, collector := range customCollectors {
 			metrics.Registry.MustRegister(collector)
@@ -116,6 +127,11 @@ func RecordBodyFieldEmpty(fieldName string) {
 	bodyFieldEmptyCounter.WithLabelValues(fieldName).Inc()
 }
 
+// RecordRequestRejected records the number of requests rejected by a plugin with an immediate response.
+func RecordRequestRejected(pluginType, pluginName string, statusCode int) {
+	requestRejectedCounter.WithLabelValues(pluginType, pluginName, strconv.Itoa(statusCode)).Inc()
+}
+
 // RecordPluginProcessingLatency records the processing latency for a BBR plugin.
 func RecordPluginProcessingLatency(extensionPoint, pluginType, pluginName string, duration time.Duration) {
 	pluginProcessingLatencies.WithLabelValues(extensionPoint, pluginType, pluginName).Observe(duration.Seconds())
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celtransform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	CELRequestTransformPluginType  = "cel-request-transform"
+	CELResponseTransformPluginType = "cel-response-transform"
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &CELRequestTransformPlugin{}
+	_ framework.ResponseProcessor = &CELResponseTransformPlugin{}
+)
+
+// CELRequestTransformPluginFactory defines the factory function for NewCELRequestTransformPlugin.
+func CELRequestTransformPluginFactory(name string, rawParameters json.RawMessage) (framework.BBRPlugin, error) {
+	config, err := parseConfig(CELRequestTransformPluginType, rawParameters)
+	if err != nil {
+		return nil, err
+	}
+	plugin, err := NewCELRequestTransformPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", CELRequestTransformPluginType, err)
+	}
+	return plugin.WithName(name), nil
+}
+
+// CELResponseTransformPluginFactory defines the factory function for NewCELResponseTransformPlugin.
+func CELResponseTransformPluginFactory(name string, rawParameters json.RawMessage) (framework.BBRPlugin, error) {
+	config, err := parseConfig(CELResponseTransformPluginType, rawParameters)
+	if err != nil {
+		return nil, err
+	}
+	plugin, err := NewCELResponseTransformPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", CELResponseTransformPluginType, err)
+	}
+	return plugin.WithName(name), nil
+}
+
+func parseConfig(pluginType string, rawParameters json.RawMessage) (Config, error) {
+	var config Config
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return config, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", pluginType, err)
+		}
+	}
+	return config, nil
+}
+
+// NewCELRequestTransformPlugin initializes a new CELRequestTransformPlugin and returns its pointer.
+func NewCELRequestTransformPlugin(config Config) (*CELRequestTransformPlugin, error) {
+	t, err := newTransformer(config)
+	if err != nil {
+		return nil, err
+	}
+	return &CELRequestTransformPlugin{
+		typedName: plugin.TypedName{
+			Type: CELRequestTransformPluginType,
+			Name: CELRequestTransformPluginType,
+		},
+		transformer: t,
+	}, nil
+}
+
+// CELRequestTransformPlugin mutates request headers and body fields using CEL expressions.
+type CELRequestTransformPlugin struct {
+	typedName   plugin.TypedName
+	transformer *transformer
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *CELRequestTransformPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *CELRequestTransformPlugin) WithName(name string) *CELRequestTransformPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest applies the configured transformations to the request.
+func (p *CELRequestTransformPlugin) ProcessRequest(ctx context.Context, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil {
+		return nil // this shouldn't happen
+	}
+	p.transformer.apply(ctx, &request.InferenceMessage)
+	return nil
+}
+
+// NewCELResponseTransformPlugin initializes a new CELResponseTransformPlugin and returns its pointer.
+func NewCELResponseTransformPlugin(config Config) (*CELResponseTransformPlugin, error) {
+	t, err := newTransformer(config)
+	if err != nil {
+		return nil, err
+	}
+	return &CELResponseTransformPlugin{
+		typedName: plugin.TypedName{
+			Type: CELResponseTransformPluginType,
+			Name: CELResponseTransformPluginType,
+		},
+		transformer: t,
+	}, nil
+}
+
+// CELResponseTransformPlugin mutates response headers and body fields using CEL expressions.
+type CELResponseTransformPlugin struct {
+	typedName   plugin.TypedName
+	transformer *transformer
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *CELResponseTransformPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *CELResponseTransformPlugin) WithName(name string) *CELResponseTransformPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessResponse applies the configured transformations to the response.
+func (p *CELResponseTransformPlugin) ProcessResponse(ctx context.Context, response *framework.InferenceResponse) error {
+	if response == nil || response.Headers == nil {
+		return nil // this shouldn't happen
+	}
+	p.transformer.apply(ctx, &response.InferenceMessage)
+	return nil
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celtransform
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestCELRequestTransformPlugin_ProcessRequest(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          Config
+		headers         map[string]string
+		body            map[string]any
+		wantHeaders     map[string]string
+		wantRemoved     []string
+		wantBody        map[string]any
+		wantBodyMutated bool
+	}{
+		{
+			name: "header from nested body field",
+			config: Config{
+				SetHeaders: []Assignment{{
+					Name:       "x-tenant",
+					Expression: "body.metadata.tenant",
+					Condition:  "has(body.metadata) && has(body.metadata.tenant)",
+				}},
+			},
+			body:        map[string]any{"metadata": map[string]any{"tenant": "team-a"}},
+			wantHeaders: map[string]string{"x-tenant": "team-a"},
+			wantBody:    map[string]any{"metadata": map[string]any{"tenant": "team-a"}},
+		},
+		{
+			name: "condition not met skips assignment",
+			config: Config{
+				SetHeaders: []Assignment{{
+					Name:       "x-tenant",
+					Expression: "body.metadata.tenant",
+					Condition:  "has(body.metadata)",
+				}},
+			},
+			body:        map[string]any{"model": "m"},
+			wantHeaders: map[string]string{},
+			wantBody:    map[string]any{"model": "m"},
+		},
+		{
+			name: "failed expression is skipped without affecting other rules",
+			config: Config{
+				SetHeaders: []Assignment{
+					{Name: "x-missing", Expression: "body.metadata.tenant"},
+					{Name: "x-model", Expression: "body.model"},
+				},
+			},
+			body:        map[string]any{"model": "m"},
+			wantHeaders: map[string]string{"x-model": "m"},
+			wantBody:    map[string]any{"model": "m"},
+		},
+		{
+			name: "non string header value is converted",
+			config: Config{
+				SetHeaders: []Assignment{{Name: "x-max-tokens", Expression: "int(body.max_tokens)"}},
+			},
+			body:        map[string]any{"max_tokens": float64(128)},
+			wantHeaders: map[string]string{"x-max-tokens": "128"},
+			wantBody:    map[string]any{"max_tokens": float64(128)},
+		},
+		{
+			name: "inject stream_options.include_usage default",
+			config: Config{
+				SetBodyFields: []Assignment{{
+					Name:       "stream_options.include_usage",
+					Expression: "true",
+					Condition:  "has(body.stream) && body.stream == true",
+				}},
+			},
+			body:            map[string]any{"stream": true, "stream_options": map[string]any{"foo": "bar"}},
+			wantHeaders:     map[string]string{},
+			wantBody:        map[string]any{"stream": true, "stream_options": map[string]any{"foo": "bar", "include_usage": true}},
+			wantBodyMutated: true,
+		},
+		{
+			name: "set body field creates intermediate objects",
+			config: Config{
+				SetBodyFields: []Assignment{{Name: "metadata.route", Expression: "headers['x-route']"}},
+			},
+			headers:         map[string]string{"x-route": "blue"},
+			body:            map[string]any{},
+			wantHeaders:     map[string]string{"x-route": "blue"},
+			wantBody:        map[string]any{"metadata": map[string]any{"route": "blue"}},
+			wantBodyMutated: true,
+		},
+		{
+			name: "set body field through non object is skipped",
+			config: Config{
+				SetBodyFields: []Assignment{{Name: "model.name", Expression: "'x'"}},
+			},
+			body:        map[string]any{"model": "m"},
+			wantHeaders: map[string]string{},
+			wantBody:    map[string]any{"model": "m"},
+		},
+		{
+			name: "remove body fields and headers",
+			config: Config{
+				RemoveBodyFields: []string{"metadata.internal", "user", "missing.field"},
+				RemoveHeaders:    []string{"x-internal"},
+			},
+			headers:         map[string]string{"x-internal": "1"},
+			body:            map[string]any{"user": "u", "metadata": map[string]any{"internal": true, "tenant": "a"}},
+			wantHeaders:     map[string]string{},
+			wantRemoved:     []string{"x-internal"},
+			wantBody:        map[string]any{"metadata": map[string]any{"tenant": "a"}},
+			wantBodyMutated: true,
+		},
+		{
+			name: "expressions see the original message",
+			config: Config{
+				SetHeaders:       []Assignment{{Name: "x-user", Expression: "body.user"}},
+				RemoveBodyFields: []string{"user"},
+			},
+			body:            map[string]any{"user": "u"},
+			wantHeaders:     map[string]string{"x-user": "u"},
+			wantBody:        map[string]any{},
+			wantBodyMutated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewCELRequestTransformPlugin(tt.config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			request := framework.NewInferenceRequest()
+			for k, v := range tt.headers {
+				request.Headers[k] = v
+			}
+			request.Body = tt.body
+
+			if err := p.ProcessRequest(context.Background(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			wantMutated := map[string]string{}
+			for k, v := range tt.wantHeaders {
+				if tt.headers[k] != v {
+					wantMutated[k] = v
+				}
+			}
+			if diff := cmp.Diff(wantMutated, request.MutatedHeaders()); diff != "" {
+				t.Errorf("unexpected mutated headers (-want +got): %v", diff)
+			}
+			if diff := cmp.Diff(tt.wantRemoved, request.RemovedHeaders(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected removed headers (-want +got): %v", diff)
+			}
+			if diff := cmp.Diff(tt.wantBody, request.Body); diff != "" {
+				t.Errorf("unexpected body (-want +got): %v", diff)
+			}
+			if got := request.BodyMutated(); got != tt.wantBodyMutated {
+				t.Errorf("BodyMutated() = %v, want %v", got, tt.wantBodyMutated)
+			}
+		})
+	}
+}
+
+func TestCELResponseTransformPlugin_ProcessResponse(t *testing.T) {
+	p, err := NewCELResponseTransformPlugin(Config{
+		SetHeaders: []Assignment{{
+			Name:       "x-completion-tokens",
+			Expression: "string(int(body.usage.completion_tokens))",
+			Condition:  "has(body.usage)",
+		}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	response := framework.NewInferenceResponse()
+	response.Body = map[string]any{"usage": map[string]any{"completion_tokens": float64(42)}}
+
+	if err := p.ProcessResponse(context.Background(), response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := response.MutatedHeaders()["x-completion-tokens"]; got != "42" {
+		t.Errorf("x-completion-tokens = %q, want %q", got, "42")
+	}
+}
+
+func TestCELTransformPluginFactories(t *testing.T) {
+	valid := json.RawMessage(`{"set_headers": [{"name": "x-tenant", "expression": "body.metadata.tenant"}]}`)
+
+	plugin, err := CELRequestTransformPluginFactory("req", valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := plugin.(framework.ResponseProcessor); ok {
+		t.Error("request transform plugin must not be a response processor")
+	}
+	if got := plugin.TypedName(); got.Type != CELRequestTransformPluginType || got.Name != "req" {
+		t.Errorf("TypedName() = %v", got)
+	}
+
+	plugin, err = CELResponseTransformPluginFactory("resp", valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := plugin.(framework.RequestProcessor); ok {
+		t.Error("response transform plugin must not be a request processor")
+	}
+
+	invalid := map[string]string{
+		"no transformations":       `{}`,
+		"malformed json":           `{"set_headers": "x"}`,
+		"empty name":               `{"set_headers": [{"expression": "'a'"}]}`,
+		"empty expression":         `{"set_headers": [{"name": "x-a"}]}`,
+		"invalid expression":       `{"set_headers": [{"name": "x-a", "expression": "body."}]}`,
+		"non boolean condition":    `{"set_headers": [{"name": "x-a", "expression": "'a'", "condition": "'yes'"}]}`,
+		"unknown variable":         `{"set_body_fields": [{"name": "a", "expression": "request.model"}]}`,
+		"invalid remove body path": `{"remove_body_fields": ["a..b"]}`,
+		"empty remove header":      `{"remove_headers": [""]}`,
+	}
+	for name, params := range invalid {
+		if _, err := CELRequestTransformPluginFactory("req", json.RawMessage(params)); err == nil {
+			t.Errorf("%s: expected error, got nil", name)
+		}
+	}
+}
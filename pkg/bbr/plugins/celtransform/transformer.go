@@ -0,0 +1,306 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celtransform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"google.golang.org/protobuf/types/known/structpb"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+)
+
+var structpbValueType = reflect.TypeOf(&structpb.Value{})
+
+// Config defines the JSON configuration structure shared by the CEL transform plugins.
+// All expressions are evaluated against the message as received by the plugin, then the
+// mutations are applied in order: set_body_fields, remove_body_fields, set_headers, remove_headers.
+type Config struct {
+	// SetHeaders computes header values from CEL expressions.
+	SetHeaders []Assignment `json:"set_headers"`
+	// RemoveHeaders lists the headers to remove.
+	RemoveHeaders []string `json:"remove_headers"`
+	// SetBodyFields computes body field values from CEL expressions.
+	SetBodyFields []Assignment `json:"set_body_fields"`
+	// RemoveBodyFields lists the dotted paths of the body fields to remove.
+	RemoveBodyFields []string `json:"remove_body_fields"`
+}
+
+// Assignment sets a header or body field to the result of a CEL expression.
+// Expressions can reference the `headers` (map of string to string) and `body` (JSON object) variables.
+type Assignment struct {
+	// Name is the header name, or the dotted path of the body field, e.g. "stream_options.include_usage".
+	Name string `json:"name"`
+	// Expression is the CEL expression computing the value.
+	Expression string `json:"expression"`
+	// Condition is an optional CEL expression that must evaluate to true for the assignment to apply.
+	Condition string `json:"condition,omitempty"`
+}
+
+type compiledAssignment struct {
+	name          string
+	path          []string
+	expression    string
+	expressionPrg cel.Program
+	conditionPrg  cel.Program // nil if no condition
+}
+
+// transformer holds the compiled CEL programs of a Config.
+type transformer struct {
+	setHeaders       []compiledAssignment
+	removeHeaders    []string
+	setBodyFields    []compiledAssignment
+	removeBodyFields [][]string
+}
+
+func newTransformer(config Config) (*transformer, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("headers", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("body", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &transformer{removeHeaders: config.RemoveHeaders}
+	if t.setHeaders, err = compileAssignments(env, "set_headers", config.SetHeaders); err != nil {
+		return nil, err
+	}
+	if t.setBodyFields, err = compileAssignments(env, "set_body_fields", config.SetBodyFields); err != nil {
+		return nil, err
+	}
+	for i, header := range config.RemoveHeaders {
+		if header == "" {
+			return nil, fmt.Errorf("remove_headers[%d] cannot be empty", i)
+		}
+	}
+	for i, field := range config.RemoveBodyFields {
+		path, err := splitPath(field)
+		if err != nil {
+			return nil, fmt.Errorf("remove_body_fields[%d]: %w", i, err)
+		}
+		t.removeBodyFields = append(t.removeBodyFields, path)
+	}
+
+	if len(t.setHeaders)+len(t.removeHeaders)+len(t.setBodyFields)+len(t.removeBodyFields) == 0 {
+		return nil, errors.New("at least one transformation must be configured")
+	}
+	return t, nil
+}
+
+func compileAssignments(env *cel.Env, field string, assignments []Assignment) ([]compiledAssignment, error) {
+	compiled := make([]compiledAssignment, 0, len(assignments))
+	for i, assignment := range assignments {
+		path, err := splitPath(assignment.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d].name: %w", field, i, err)
+		}
+		if assignment.Expression == "" {
+			return nil, fmt.Errorf("%s[%d].expression cannot be empty", field, i)
+		}
+		expressionPrg, err := compile(env, assignment.Expression, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d].expression: %w", field, i, err)
+		}
+		var conditionPrg cel.Program
+		if assignment.Condition != "" {
+			if conditionPrg, err = compile(env, assignment.Condition, cel.BoolType); err != nil {
+				return nil, fmt.Errorf("%s[%d].condition: %w", field, i, err)
+			}
+		}
+		compiled = append(compiled, compiledAssignment{
+			name:          assignment.Name,
+			path:          path,
+			expression:    assignment.Expression,
+			expressionPrg: expressionPrg,
+			conditionPrg:  conditionPrg,
+		})
+	}
+	return compiled, nil
+}
+
+// compile compiles the expression, checking its output type when outputType is set.
+func compile(env *cel.Env, expression string, outputType *cel.Type) (cel.Program, error) {
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to compile %q: %w", expression, issues.Err())
+	}
+	if outputType != nil && !ast.OutputType().IsExactType(outputType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression %q must evaluate to %s, got %s", expression, outputType, ast.OutputType())
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create program for %q: %w", expression, err)
+	}
+	return prg, nil
+}
+
+func splitPath(name string) ([]string, error) {
+	if name == "" {
+		return nil, errors.New("cannot be empty")
+	}
+	path := strings.Split(name, ".")
+	for _, segment := range path {
+		if segment == "" {
+			return nil, fmt.Errorf("invalid path %q", name)
+		}
+	}
+	return path, nil
+}
+
+// apply evaluates the configured expressions against msg and applies the resulting mutations.
+// Assignments whose expression fails to evaluate, e.g. because a referenced field is missing, are
+// skipped, so that a single rule does not prevent the others from being applied.
+func (t *transformer) apply(ctx context.Context, msg *framework.InferenceMessage) {
+	logger := log.FromContext(ctx).V(logutil.VERBOSE)
+	body := msg.Body
+	if body == nil {
+		body = map[string]any{}
+	}
+	activation := map[string]any{"headers": msg.Headers, "body": body}
+
+	headerValues := make(map[string]string, len(t.setHeaders))
+	for _, assignment := range t.setHeaders {
+		val, ok := evaluate(ctx, assignment, activation)
+		if !ok {
+			continue
+		}
+		str := val.ConvertToType(types.StringType)
+		if types.IsError(str) {
+			logger.Info("CEL expression result cannot be used as a header value", "header", assignment.name, "expression", assignment.expression, "error", str)
+			continue
+		}
+		headerValues[assignment.name] = str.Value().(string)
+	}
+
+	type bodyValue struct {
+		path  []string
+		value any
+	}
+	bodyValues := make([]bodyValue, 0, len(t.setBodyFields))
+	for _, assignment := range t.setBodyFields {
+		val, ok := evaluate(ctx, assignment, activation)
+		if !ok {
+			continue
+		}
+		native, err := val.ConvertToNative(structpbValueType)
+		if err != nil {
+			logger.Info("CEL expression result cannot be used as a body value", "field", assignment.name, "expression", assignment.expression, "error", err)
+			continue
+		}
+		bodyValues = append(bodyValues, bodyValue{path: assignment.path, value: native.(*structpb.Value).AsInterface()})
+	}
+
+	if msg.Body != nil {
+		for _, v := range bodyValues {
+			if err := setBodyField(msg, v.path, v.value); err != nil {
+				logger.Info("Failed to set body field", "field", strings.Join(v.path, "."), "error", err)
+			}
+		}
+		for _, path := range t.removeBodyFields {
+			removeBodyField(msg, path)
+		}
+	}
+	for _, assignment := range t.setHeaders {
+		if value, ok := headerValues[assignment.name]; ok {
+			msg.SetHeader(assignment.name, value)
+		}
+	}
+	for _, header := range t.removeHeaders {
+		msg.RemoveHeader(header)
+	}
+}
+
+// evaluate runs the assignment condition and expression, returning false if the assignment does not apply.
+func evaluate(ctx context.Context, assignment compiledAssignment, activation map[string]any) (ref.Val, bool) {
+	logger := log.FromContext(ctx).V(logutil.VERBOSE)
+	if assignment.conditionPrg != nil {
+		cond, _, err := assignment.conditionPrg.Eval(activation)
+		if err != nil {
+			logger.Info("Failed to evaluate CEL condition, skipping", "name", assignment.name, "error", err)
+			return nil, false
+		}
+		if matched, ok := cond.Value().(bool); !ok || !matched {
+			return nil, false
+		}
+	}
+	val, _, err := assignment.expressionPrg.Eval(activation)
+	if err != nil {
+		logger.Info("Failed to evaluate CEL expression, skipping", "name", assignment.name, "expression", assignment.expression, "error", err)
+		return nil, false
+	}
+	return val, true
+}
+
+// setBodyField sets the value at the given path, creating intermediate objects as needed.
+func setBodyField(msg *framework.InferenceMessage, path []string, value any) error {
+	parent := msg.Body
+	for i, key := range path[:len(path)-1] {
+		child, ok := parent[key]
+		if !ok || child == nil {
+			break
+		}
+		next, ok := child.(map[string]any)
+		if !ok {
+			return fmt.Errorf("field %q is not an object", strings.Join(path[:i+1], "."))
+		}
+		parent = next
+	}
+
+	parent = msg.Body
+	for _, key := range path[:len(path)-1] {
+		next, ok := parent[key].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			parent[key] = next
+		}
+		parent = next
+	}
+	parent[path[len(path)-1]] = value
+	msg.SetBodyField(path[0], msg.Body[path[0]]) // marks the body as mutated
+	return nil
+}
+
+// removeBodyField removes the value at the given path if it exists.
+func removeBodyField(msg *framework.InferenceMessage, path []string) {
+	if len(path) == 1 {
+		msg.RemoveBodyField(path[0])
+		return
+	}
+	parent := msg.Body
+	for _, key := range path[:len(path)-1] {
+		next, ok := parent[key].(map[string]any)
+		if !ok {
+			return
+		}
+		parent = next
+	}
+	if _, ok := parent[path[len(path)-1]]; !ok {
+		return
+	}
+	delete(parent, path[len(path)-1])
+	msg.SetBodyField(path[0], msg.Body[path[0]]) // marks the body as mutated
+}
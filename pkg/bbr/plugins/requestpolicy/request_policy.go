@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	RequestPolicyPluginType = "request-policy"
+
+	invalidRequestErrorType = "invalid_request_error"
+)
+
+// maxTokensFields are the body fields that bound the number of generated tokens across the
+// completions, chat completions and responses APIs.
+var maxTokensFields = []string{"max_tokens", "max_completion_tokens", "max_output_tokens"}
+
+// compile-time type validation
+var _ framework.RequestProcessor = &RequestPolicyPlugin{}
+
+// RequestPolicyConfig defines the JSON configuration structure for the plugin.
+// Zero values disable the corresponding check.
+type RequestPolicyConfig struct {
+	// MaxTokens is the largest value accepted for max_tokens, max_completion_tokens and max_output_tokens.
+	MaxTokens int `json:"max_tokens"`
+	// AllowedModels is the list of model names accepted in the model field.
+	AllowedModels []string `json:"allowed_models"`
+	// ForbiddenParameters is the list of top-level body fields that must not be set.
+	ForbiddenParameters []string `json:"forbidden_parameters"`
+	// MaxMessages is the largest number of entries accepted in the messages field.
+	MaxMessages int `json:"max_messages"`
+}
+
+// RequestPolicyPluginFactory defines the factory function for NewRequestPolicyPlugin.
+func RequestPolicyPluginFactory(name string, rawParameters json.RawMessage) (framework.BBRPlugin, error) {
+	var config RequestPolicyConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", RequestPolicyPluginType, err)
+		}
+	}
+
+	plugin, err := NewRequestPolicyPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", RequestPolicyPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewRequestPolicyPlugin initializes a new RequestPolicyPlugin and returns its pointer.
+func NewRequestPolicyPlugin(config RequestPolicyConfig) (*RequestPolicyPlugin, error) {
+	if config.MaxTokens < 0 {
+		return nil, errors.New("max_tokens must not be negative in RequestPolicy plugin")
+	}
+	if config.MaxMessages < 0 {
+		return nil, errors.New("max_messages must not be negative in RequestPolicy plugin")
+	}
+
+	return &RequestPolicyPlugin{
+		typedName: plugin.TypedName{
+			Type: RequestPolicyPluginType,
+			Name: RequestPolicyPluginType,
+		},
+		maxTokens:           config.MaxTokens,
+		allowedModels:       sets.New(config.AllowedModels...),
+		forbiddenParameters: config.ForbiddenParameters,
+		maxMessages:         config.MaxMessages,
+	}, nil
+}
+
+// RequestPolicyPlugin rejects requests that violate the configured limits with a 400 response.
+type RequestPolicyPlugin struct {
+	typedName           plugin.TypedName
+	maxTokens           int
+	allowedModels       sets.Set[string]
+	forbiddenParameters []string
+	maxMessages         int
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *RequestPolicyPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *RequestPolicyPlugin) WithName(name string) *RequestPolicyPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest validates the request body against the policy. A violation is returned as a
+// framework.ImmediateResponse so that the request is rejected before reaching the model server.
+func (p *RequestPolicyPlugin) ProcessRequest(ctx context.Context, request *framework.InferenceRequest) error {
+	if request == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	if msg := p.validate(request.Body); msg != "" {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("request violates policy", "reason", msg)
+		return framework.NewErrorResponse(http.StatusBadRequest, invalidRequestErrorType, msg)
+	}
+	return nil
+}
+
+// validate returns a client facing description of the first policy violation, or an empty string.
+func (p *RequestPolicyPlugin) validate(body map[string]any) string {
+	for _, param := range p.forbiddenParameters {
+		if _, ok := body[param]; ok {
+			return fmt.Sprintf("parameter '%s' is not allowed", param)
+		}
+	}
+
+	if p.allowedModels.Len() > 0 {
+		model, _ := body["model"].(string)
+		if !p.allowedModels.Has(model) {
+			return fmt.Sprintf("model '%s' is not allowed, allowed models are: %s", model, strings.Join(sets.List(p.allowedModels), ", "))
+		}
+	}
+
+	if p.maxTokens > 0 {
+		for _, field := range maxTokensFields {
+			raw, ok := body[field]
+			if !ok || raw == nil {
+				continue
+			}
+			value, ok := raw.(float64)
+			if !ok {
+				return fmt.Sprintf("parameter '%s' must be a number", field)
+			}
+			if value > float64(p.maxTokens) {
+				return fmt.Sprintf("parameter '%s' must not exceed %d", field, p.maxTokens)
+			}
+		}
+	}
+
+	if p.maxMessages > 0 {
+		if messages, ok := body["messages"].([]any); ok && len(messages) > p.maxMessages {
+			return fmt.Sprintf("request has %d messages, at most %d are allowed", len(messages), p.maxMessages)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestRequestPolicyPlugin_ProcessRequest(t *testing.T) {
+	config := RequestPolicyConfig{
+		MaxTokens:           1024,
+		AllowedModels:       []string{"llama-3", "mistral"},
+		ForbiddenParameters: []string{"logit_bias"},
+		MaxMessages:         2,
+	}
+
+	tests := []struct {
+		name       string
+		config     RequestPolicyConfig
+		body       map[string]any
+		wantReject bool
+	}{
+		{
+			name:   "valid request",
+			config: config,
+			body: map[string]any{
+				"model":      "llama-3",
+				"max_tokens": float64(512),
+				"messages":   []any{map[string]any{"role": "user", "content": "hi"}},
+			},
+		},
+		{
+			name:       "model not allowed",
+			config:     config,
+			body:       map[string]any{"model": "gpt-4"},
+			wantReject: true,
+		},
+		{
+			name:       "model missing",
+			config:     config,
+			body:       map[string]any{"prompt": "hi"},
+			wantReject: true,
+		},
+		{
+			name:       "max_tokens too large",
+			config:     config,
+			body:       map[string]any{"model": "mistral", "max_tokens": float64(4096)},
+			wantReject: true,
+		},
+		{
+			name:       "max_completion_tokens too large",
+			config:     config,
+			body:       map[string]any{"model": "mistral", "max_completion_tokens": float64(2048)},
+			wantReject: true,
+		},
+		{
+			name:       "max_tokens not a number",
+			config:     config,
+			body:       map[string]any{"model": "mistral", "max_tokens": "many"},
+			wantReject: true,
+		},
+		{
+			name:       "forbidden parameter",
+			config:     config,
+			body:       map[string]any{"model": "mistral", "logit_bias": map[string]any{"1": 100}},
+			wantReject: true,
+		},
+		{
+			name:   "too many messages",
+			config: config,
+			body: map[string]any{
+				"model":    "mistral",
+				"messages": []any{"a", "b", "c"},
+			},
+			wantReject: true,
+		},
+		{
+			name:   "empty policy accepts everything",
+			config: RequestPolicyConfig{},
+			body:   map[string]any{"model": "anything", "max_tokens": float64(1 << 20), "logit_bias": map[string]any{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewRequestPolicyPlugin(tt.config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+
+			err = p.ProcessRequest(context.Background(), request)
+			if !tt.wantReject {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var immediate *framework.ImmediateResponse
+			if !errors.As(err, &immediate) {
+				t.Fatalf("expected an immediate response, got %v", err)
+			}
+			if immediate.StatusCode != http.StatusBadRequest {
+				t.Errorf("StatusCode = %d, want %d", immediate.StatusCode, http.StatusBadRequest)
+			}
+			var body map[string]map[string]any
+			if err := json.Unmarshal(immediate.Body, &body); err != nil {
+				t.Fatalf("response body is not valid JSON: %v", err)
+			}
+			if body["error"]["type"] != invalidRequestErrorType || body["error"]["message"] == "" {
+				t.Errorf("unexpected error body: %s", immediate.Body)
+			}
+		})
+	}
+}
+
+func TestRequestPolicyPluginFactory(t *testing.T) {
+	plugin, err := RequestPolicyPluginFactory("policy", json.RawMessage(`{"max_tokens": 10, "allowed_models": ["a"]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := plugin.TypedName().Name; got != "policy" {
+		t.Errorf("Name = %q, want %q", got, "policy")
+	}
+
+	if _, err := RequestPolicyPluginFactory("policy", json.RawMessage(`{"max_tokens": -1}`)); err == nil {
+		t.Error("expected error for negative max_tokens, got nil")
+	}
+	if _, err := RequestPolicyPluginFactory("policy", json.RawMessage(`{"allowed_models": "a"}`)); err == nil {
+		t.Error("expected error for malformed parameters, got nil")
+	}
+}
@@ -37,9 +37,12 @@ const (
 type podMetrics struct {
 	metadata atomic.Pointer[fwkdl.EndpointMetadata]
 	metrics  atomic.Pointer[MetricsState]
-	pmc      PodMetricsClient
-	ds       datalayer.PoolInfo
-	interval time.Duration
+	// attributes holds the endpoint attributes set outside of the metrics refresh loop, such as
+	// the time the endpoint joined the pool.
+	attributes *fwkdl.Attributes
+	pmc        PodMetricsClient
+	ds         datalayer.PoolInfo
+	interval   time.Duration
 
 	startOnce sync.Once // ensures the refresh loop goroutine is started only once
 	stopOnce  sync.Once // ensures the done channel is closed only once
@@ -122,12 +125,13 @@ func (pm *podMetrics) stopRefreshLoop() {
 }
 
 // Allowing forward compatibility between PodMetrics and datalayer.Endpoint, by
-// implementing missing functions (e.g., extended attributes support) as no-op.
-func (*podMetrics) Put(string, fwkdl.Cloneable)        {}
-func (*podMetrics) Get(string) (fwkdl.Cloneable, bool) { return nil, false }
-func (*podMetrics) Keys() []string                     { return nil }
-func (*podMetrics) GetAttributes() fwkdl.AttributeMap {
-	return nil
+// implementing the extended attributes support over the attributes of the endpoint.
+func (pm *podMetrics) Put(key string, value fwkdl.Cloneable)  { pm.attributes.Put(key, value) }
+func (pm *podMetrics) Get(key string) (fwkdl.Cloneable, bool) { return pm.attributes.Get(key) }
+func (pm *podMetrics) Delete(key string)                      { pm.attributes.Delete(key) }
+func (pm *podMetrics) Keys() []string                         { return pm.attributes.Keys() }
+func (pm *podMetrics) GetAttributes() fwkdl.AttributeMap {
+	return pm.attributes
 }
 
 func (pm *podMetrics) UpdateMetrics(updated *MetricsState) {
@@ -94,3 +94,21 @@ func (f *FakeRefresherDataStore) PodList(func(PodMetrics) bool) []PodMetrics {
 	// Not implemented.
 	return nil
 }
+
+func TestAttributesSharedWithEndpoint(t *testing.T) {
+	pmf := NewPodMetricsFactory(&FakePodMetricsClient{}, time.Minute)
+	endpoint := pmf.NewEndpoint(context.Background(), pod1Info, &FakeRefresherDataStore{})
+	defer pmf.ReleaseEndpoint(endpoint)
+	pm := endpoint.(*podMetrics)
+
+	joined := fwkdl.NewJoinTime(time.Now())
+	pm.Put(fwkdl.JoinTimeKey, joined)
+
+	got, ok := pm.GetAttributes().Get(fwkdl.JoinTimeKey)
+	assert.True(t, ok, "attribute set through Put must be visible through GetAttributes")
+	assert.Equal(t, joined, got)
+
+	pm.Delete(fwkdl.JoinTimeKey)
+	_, ok = pm.GetAttributes().Get(fwkdl.JoinTimeKey)
+	assert.False(t, ok, "attribute deleted through Delete must be removed from GetAttributes")
+}
@@ -55,13 +55,14 @@ func (f *PodMetricsFactory) SetSources(_ []fwkdl.DataSource) {
 
 func (f *PodMetricsFactory) NewEndpoint(parentCtx context.Context, metadata *fwkdl.EndpointMetadata, ds datalayer.PoolInfo) fwkdl.Endpoint {
 	pm := &podMetrics{
-		pmc:       f.pmc,
-		ds:        ds,
-		interval:  f.refreshMetricsInterval,
-		startOnce: sync.Once{},
-		stopOnce:  sync.Once{},
-		done:      make(chan struct{}),
-		logger:    log.FromContext(parentCtx).WithValues("endpoint", metadata.NamespacedName),
+		attributes: &fwkdl.Attributes{},
+		pmc:        f.pmc,
+		ds:         ds,
+		interval:   f.refreshMetricsInterval,
+		startOnce:  sync.Once{},
+		stopOnce:   sync.Once{},
+		done:       make(chan struct{}),
+		logger:     log.FromContext(parentCtx).WithValues("endpoint", metadata.NamespacedName),
 	}
 	pm.metadata.Store(metadata)
 	pm.metrics.Store(fwkdl.NewMetrics())
@@ -20,6 +20,7 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/requestcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/saturationdetector/framework/plugins/utilizationdetector"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
 )
@@ -31,4 +32,5 @@ type Config struct {
 	DataConfig               *datalayer.Config
 	FlowControlConfig        *flowcontrol.Config
 	ParserConfig             *handlers.Config
+	PrepareDataConfig        *requestcontrol.PrepareDataConfig
 }
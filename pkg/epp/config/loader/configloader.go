@@ -19,6 +19,8 @@ package loader
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,6 +38,7 @@ import (
 	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/profile"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/requestcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/saturationdetector/framework/plugins/utilizationdetector"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
 )
@@ -43,6 +46,11 @@ import (
 var (
 	scheme                 = runtime.NewScheme()
 	registeredFeatureGates = sets.New[string]()
+
+	// yamlLinePattern matches the line reported by YAML syntax errors.
+	yamlLinePattern = regexp.MustCompile(`line (\d+)`)
+	// strictFieldPattern matches the fields reported by strict decoding errors.
+	strictFieldPattern = regexp.MustCompile(`(unknown|duplicate) field "([^"]+)"`)
 )
 
 func init() {
@@ -57,12 +65,22 @@ func RegisterFeatureGate(gate string) {
 // LoadRawConfig parses the raw configuration bytes, applies initial defaults, and extracts feature gates.
 // It does not instantiate plugins.
 func LoadRawConfig(configBytes []byte, logger logr.Logger) (*configapi.EndpointPickerConfig, map[string]bool, error) {
+	rawConfig, errs := loadRawConfig(configBytes, logger)
+	if len(errs) > 0 {
+		return nil, nil, joinValidationErrors(errs)
+	}
+	return rawConfig, loadFeatureConfig(rawConfig.FeatureGates), nil
+}
+
+// loadRawConfig parses the raw configuration bytes and applies initial defaults. It returns all the problems
+// found. The configuration is nil when it cannot be decoded.
+func loadRawConfig(configBytes []byte, logger logr.Logger) (*configapi.EndpointPickerConfig, []ValidationError) {
 	var rawConfig *configapi.EndpointPickerConfig
-	var err error
 	if len(configBytes) != 0 {
+		var err error
 		rawConfig, err = decodeRawConfig(configBytes)
 		if err != nil {
-			return nil, nil, err
+			return nil, decodingErrors(err)
 		}
 		logger.Info("Loaded raw configuration", "config", rawConfig.String())
 	} else {
@@ -74,58 +92,86 @@ func LoadRawConfig(configBytes []byte, logger logr.Logger) (*configapi.EndpointP
 	applyStaticDefaults(rawConfig)
 
 	// We validate gates early because they might dictate downstream loading logic.
-	if err := validateFeatureGates(rawConfig.FeatureGates); err != nil {
-		return nil, nil, fmt.Errorf("feature gate validation failed: %w", err)
-	}
-
-	featureConfig := loadFeatureConfig(rawConfig.FeatureGates)
-	return rawConfig, featureConfig, nil
+	return rawConfig, featureGateErrors(rawConfig.FeatureGates)
 }
 
 // InstantiateAndConfigure performs the heavy lifting of plugin instantiation, system architecture injection, and
-// scheduler construction.
+// scheduler construction. It reports all the problems found in the configuration, not only the first one.
 func InstantiateAndConfigure(
 	rawConfig *configapi.EndpointPickerConfig,
 	handle fwkplugin.Handle,
 	logger logr.Logger,
 ) (*config.Config, error) {
+	cfg, errs := instantiateAndConfigure(rawConfig, handle, logger)
+	if len(errs) > 0 {
+		return nil, joinValidationErrors(errs)
+	}
+	return cfg, nil
+}
 
-	if err := instantiatePlugins(rawConfig.Plugins, handle); err != nil {
-		return nil, fmt.Errorf("plugin instantiation failed: %w", err)
+// instantiateAndConfigure instantiates the plugins of the configuration and builds the configuration of the EPP
+// components, carrying on past the problems found to return all of them. The configuration is only complete when
+// no problem is returned.
+func instantiateAndConfigure(
+	rawConfig *configapi.EndpointPickerConfig,
+	handle fwkplugin.Handle,
+	logger logr.Logger,
+) (*config.Config, []ValidationError) {
+	var errs []ValidationError
+	report := func(field string, err error) {
+		errs = append(errs, ValidationError{Field: field, Err: err})
+	}
+
+	pluginNames := sets.New[string]()
+	for i, spec := range rawConfig.Plugins {
+		if field, err := instantiatePlugin(spec, pluginNames, handle); err != nil {
+			report(fmt.Sprintf("plugins[%d].%s", i, field), err)
+		}
 	}
 
 	if err := applySystemDefaults(rawConfig, handle); err != nil {
-		return nil, fmt.Errorf("system default application failed: %w", err)
+		report("", fmt.Errorf("system default application failed: %w", err))
 	}
 	logger.Info("Effective configuration loaded", "config", rawConfig)
 
-	if err := validateConfig(rawConfig); err != nil {
-		return nil, fmt.Errorf("configuration validation failed: %w", err)
+	errs = append(errs, schedulingProfileErrors(rawConfig)...)
+	for i, profile := range rawConfig.SchedulingProfiles {
+		for j, pluginRef := range profile.Plugins {
+			if plugin := handle.Plugin(pluginRef.PluginRef); plugin != nil {
+				if err := validateProfilePlugin(plugin, pluginRef.PluginRef, profile.Name); err != nil {
+					report(fmt.Sprintf("schedulingProfiles[%d].plugins[%d].pluginRef", i, j), err)
+				}
+			}
+		}
 	}
-
-	schedulerConfig, err := buildSchedulerConfig(rawConfig.SchedulingProfiles, handle)
-	if err != nil {
-		return nil, fmt.Errorf("scheduler config build failed: %w", err)
+	// The scheduler is only built from valid plugins and profiles, to not report their problems twice.
+	var schedulerConfig *scheduling.SchedulerConfig
+	if len(errs) == 0 {
+		var err error
+		if schedulerConfig, err = buildSchedulerConfig(rawConfig.SchedulingProfiles, handle); err != nil {
+			report("schedulingProfiles", err)
+		}
 	}
 
 	featureGates := loadFeatureConfig(rawConfig.FeatureGates)
-	dataConfig, err := buildDataLayerConfig(rawConfig.Data, featureGates[datalayer.ExperimentalDatalayerFeatureGate], handle)
-	if err != nil {
-		return nil, fmt.Errorf("data layer config build failed: %w", err)
-	}
+	dataConfig := buildDataLayerConfig(rawConfig.Data, featureGates[datalayer.ExperimentalDatalayerFeatureGate], handle, report)
 
 	var flowControlConfig *flowcontrol.Config
 	if featureGates[flowcontrol.FeatureGate] {
 		var err error
-		flowControlConfig, err = flowcontrol.NewConfigFromAPI(rawConfig.FlowControl, handle)
-		if err != nil {
-			return nil, fmt.Errorf("failed to load flow control config: %w", err)
+		if flowControlConfig, err = flowcontrol.NewConfigFromAPI(rawConfig.FlowControl, handle); err != nil {
+			report("flowControl", fmt.Errorf("failed to load flow control config: %w", err))
 		}
 	}
 
 	parserConfig, err := buildParserConfig(rawConfig.Parser, handle)
 	if err != nil {
-		return nil, fmt.Errorf("parse config build failed: %w", err)
+		report("parser", err)
+	}
+
+	prepareDataConfig, err := requestcontrol.NewPrepareDataConfigFromAPI(rawConfig.PrepareData, handle)
+	if err != nil {
+		report("prepareData", err)
 	}
 
 	return &config.Config{
@@ -134,7 +180,8 @@ func InstantiateAndConfigure(
 		DataConfig:               dataConfig,
 		FlowControlConfig:        flowControlConfig,
 		ParserConfig:             parserConfig,
-	}, nil
+		PrepareDataConfig:        prepareDataConfig,
+	}, errs
 }
 
 func decodeRawConfig(configBytes []byte) (*configapi.EndpointPickerConfig, error) {
@@ -146,30 +193,46 @@ func decodeRawConfig(configBytes []byte) (*configapi.EndpointPickerConfig, error
 	return cfg, nil
 }
 
-func instantiatePlugins(configuredPlugins []configapi.PluginSpec, handle fwkplugin.Handle) error {
-	pluginNames := sets.New[string]()
-	for _, spec := range configuredPlugins {
-		if spec.Type == "" {
-			return fmt.Errorf("plugin '%s' is missing a type", spec.Name)
-		}
-		if pluginNames.Has(spec.Name) {
-			return fmt.Errorf("duplicate plugin name '%s'", spec.Name)
-		}
-		pluginNames.Insert(spec.Name)
+// decodingErrors turns a decoding error into validation errors, one per offending field when the
+// decoder reports them.
+func decodingErrors(err error) []ValidationError {
+	var errs []ValidationError
+	for _, match := range strictFieldPattern.FindAllStringSubmatch(err.Error(), -1) {
+		errs = append(errs, ValidationError{Field: match[2], Err: fmt.Errorf("%s field", match[1])})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	line := 0
+	if match := yamlLinePattern.FindStringSubmatch(err.Error()); match != nil {
+		line, _ = strconv.Atoi(match[1])
+	}
+	return []ValidationError{{Line: line, Err: err}}
+}
 
-		factory, ok := fwkplugin.Registry[spec.Type]
-		if !ok {
-			return fmt.Errorf("plugin type '%s' is not registered", spec.Type)
-		}
+// instantiatePlugin creates the plugin of the spec and adds it to the handle, collecting its name in
+// pluginNames to detect duplicates. On failure, it also returns the field of the spec at fault.
+func instantiatePlugin(spec configapi.PluginSpec, pluginNames sets.Set[string], handle fwkplugin.Handle) (string, error) {
+	if spec.Type == "" {
+		return "type", fmt.Errorf("plugin '%s' is missing a type", spec.Name)
+	}
+	if pluginNames.Has(spec.Name) {
+		return "name", fmt.Errorf("duplicate plugin name '%s'", spec.Name)
+	}
+	pluginNames.Insert(spec.Name)
 
-		plugin, err := factory(spec.Name, spec.Parameters, handle)
-		if err != nil {
-			return fmt.Errorf("failed to create plugin '%s' (type: %s): %w", spec.Name, spec.Type, err)
-		}
+	factory, ok := fwkplugin.Registry[spec.Type]
+	if !ok {
+		return "type", fmt.Errorf("plugin type '%s' is not registered", spec.Type)
+	}
 
-		handle.AddPlugin(spec.Name, plugin)
+	plugin, err := factory(spec.Name, spec.Parameters, handle)
+	if err != nil {
+		return "parameters", fmt.Errorf("failed to create plugin '%s' (type: %s): %w", spec.Name, spec.Type, err)
 	}
-	return nil
+
+	handle.AddPlugin(spec.Name, plugin)
+	return "", nil
 }
 
 func buildSchedulerConfig(
@@ -189,6 +252,9 @@ func buildSchedulerConfig(
 					"plugin '%s' referenced in profile '%s' not found in handle",
 					pluginRef.PluginRef, cfgProfile.Name)
 			}
+			if err := validateProfilePlugin(plugin, pluginRef.PluginRef, cfgProfile.Name); err != nil {
+				return nil, err
+			}
 
 			// Wrap Scorers with weights.
 			if scorer, ok := plugin.(framework.Scorer); ok {
@@ -278,9 +344,12 @@ func buildParserConfig(rawParserConfig *configapi.ParserConfig, handle fwkplugin
 	}, nil
 }
 
-func buildDataLayerConfig(rawDataConfig *configapi.DataLayerConfig, dataLayerEnabled bool, handle fwkplugin.Handle) (*datalayer.Config, error) {
+// buildDataLayerConfig builds the configuration of the data layer, reporting all its problems.
+func buildDataLayerConfig(rawDataConfig *configapi.DataLayerConfig, dataLayerEnabled bool, handle fwkplugin.Handle,
+	report func(field string, err error)) *datalayer.Config {
 	if dataLayerEnabled && (rawDataConfig == nil || rawDataConfig.Sources == nil) { // enabled but no configuration
-		return nil, errors.New("the Datalayer has been enabled. You must specify the Data section in the configuration")
+		report("data", errors.New("the Datalayer has been enabled. You must specify the Data section in the configuration"))
+		return nil
 	}
 
 	cfg := datalayer.Config{
@@ -288,26 +357,28 @@ func buildDataLayerConfig(rawDataConfig *configapi.DataLayerConfig, dataLayerEna
 	}
 
 	if rawDataConfig == nil { // metrics data collection not enabled and no additional configuration
-		return &cfg, nil
+		return &cfg
 	}
 
-	for _, source := range rawDataConfig.Sources {
-		if sourcePlugin, ok := handle.Plugin(source.PluginRef).(fwkdl.DataSource); ok {
-			sourceConfig := datalayer.DataSourceConfig{
-				Plugin:     sourcePlugin,
-				Extractors: []fwkdl.Extractor{},
-			}
-			for _, extractor := range source.Extractors {
-				if extractorPlugin, ok := handle.Plugin(extractor.PluginRef).(fwkdl.Extractor); ok {
-					sourceConfig.Extractors = append(sourceConfig.Extractors, extractorPlugin)
-				} else {
-					return nil, fmt.Errorf("the plugin %s is not a fwkdl.Extractor", source.PluginRef)
-				}
+	for i, source := range rawDataConfig.Sources {
+		sourcePlugin, ok := handle.Plugin(source.PluginRef).(fwkdl.DataSource)
+		if !ok {
+			report(fmt.Sprintf("data.sources[%d].pluginRef", i), fmt.Errorf("the plugin %s is not a fwkdl.DataSource", source.PluginRef))
+			continue
+		}
+		sourceConfig := datalayer.DataSourceConfig{
+			Plugin:     sourcePlugin,
+			Extractors: []fwkdl.Extractor{},
+		}
+		for j, extractor := range source.Extractors {
+			extractorPlugin, ok := handle.Plugin(extractor.PluginRef).(fwkdl.Extractor)
+			if !ok {
+				report(fmt.Sprintf("data.sources[%d].extractors[%d].pluginRef", i, j), fmt.Errorf("the plugin %s is not a fwkdl.Extractor", extractor.PluginRef))
+				continue
 			}
-			cfg.Sources = append(cfg.Sources, sourceConfig)
-		} else {
-			return nil, fmt.Errorf("the plugin %s is not a fwkdl.DataSource", source.PluginRef)
+			sourceConfig.Extractors = append(sourceConfig.Extractors, extractorPlugin)
 		}
+		cfg.Sources = append(cfg.Sources, sourceConfig)
 	}
-	return &cfg, nil
+	return &cfg
 }
@@ -40,6 +40,7 @@ import (
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	flowcontrolmocks "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol/mocks"
 	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	fwkrc "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
 	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/openai"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker"
@@ -47,6 +48,7 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/kvcacheutilization"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/prefix"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/queuedepth"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/requestcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/saturationdetector/framework/plugins/utilizationdetector"
 	"sigs.k8s.io/gateway-api-inference-extension/test/utils"
 )
@@ -60,6 +62,7 @@ const (
 	testProfileHandler = "test-profile-handler"
 	testSourceType     = "test-source"
 	testExtractorType  = "test-extractor"
+	testPrepareData    = "test-prepare-data"
 )
 
 // --- Test: Phase 1 (Raw Loading & Static Defaults) ---
@@ -386,6 +389,33 @@ func TestInstantiateAndConfigure(t *testing.T) {
 				require.Equal(t, openai.OpenAIParserType, cfg.ParserConfig.Parser.TypedName().Type, "Should contain openai parser type")
 			},
 		},
+		{
+			name:       "Success - Prepare Data Config",
+			configText: successPrepareDataConfigText,
+			wantErr:    false,
+			validate: func(t *testing.T, handle fwkplugin.Handle, rawCfg *configapi.EndpointPickerConfig, cfg *config.Config) {
+				require.Equal(t, &requestcontrol.PrepareDataConfig{
+					Timeout: 200 * time.Millisecond,
+					Plugins: map[string]requestcontrol.PrepareDataPluginConfig{
+						"producer": {
+							Timeout:       50 * time.Millisecond,
+							FailurePolicy: configapi.PrepareDataFailurePolicyMarkMissing,
+						},
+						"consumer": {
+							FailurePolicy: configapi.PrepareDataFailurePolicySkip,
+						},
+					},
+				}, cfg.PrepareDataConfig)
+			},
+		},
+		{
+			name:       "Success - Default Prepare Data Config",
+			configText: successSchedulerConfigText,
+			wantErr:    false,
+			validate: func(t *testing.T, handle fwkplugin.Handle, rawCfg *configapi.EndpointPickerConfig, cfg *config.Config) {
+				require.Equal(t, requestcontrol.NewPrepareDataConfig(), cfg.PrepareDataConfig)
+			},
+		},
 
 		// --- Instantiation Errors ---
 		{
@@ -493,6 +523,18 @@ func TestInstantiateAndConfigure(t *testing.T) {
 			configText: errorParserWrongPluginNameText,
 			wantErr:    true,
 		},
+
+		// --- Feature: Prepare Data
+		{
+			name:       "Error (PrepareData) - Wrong Plugin Type",
+			configText: errorPrepareDataWrongPluginTypeText,
+			wantErr:    true,
+		},
+		{
+			name:       "Error (PrepareData) - Unknown Failure Policy",
+			configText: errorPrepareDataUnknownFailurePolicyText,
+			wantErr:    true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -526,6 +568,36 @@ func TestInstantiateAndConfigure(t *testing.T) {
 	}
 }
 
+// Verify that all the problems of a configuration are reported at once, not only the first one.
+func TestInstantiateAndConfigureReportsAllErrors(t *testing.T) {
+	// Not parallel because it modifies global plugin registry.
+	registerTestPlugins(t)
+
+	logger := logging.NewTestLogger()
+	rawConfig, _, err := LoadRawConfig([]byte(`apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: unknown
+  type: no-such-type
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: maxScore
+  - pluginRef: missing
+prepareData:
+  plugins:
+  - pluginRef: maxScore
+`), logger)
+	require.NoError(t, err)
+
+	_, err = InstantiateAndConfigure(rawConfig, utils.NewTestHandle(context.Background()), logger)
+	require.ErrorContains(t, err, "plugins[0].type: plugin type 'no-such-type' is not registered")
+	require.ErrorContains(t, err, "schedulingProfiles[0].plugins[1].pluginRef: schedulingProfiles[default] references undefined plugin 'missing'")
+	require.ErrorContains(t, err, "prepareData: the plugin maxScore is not a PrepareData plugin")
+}
+
 // Verify the SaturationConfig builder specifically.
 func TestBuildSaturationConfig(t *testing.T) {
 	t.Parallel()
@@ -638,6 +710,19 @@ func (m *mockHandler) ProcessResults(context.Context, *framework.CycleState, *fr
 	return nil, nil
 }
 
+// Mock PrepareData plugin
+type mockPrepareData struct{ mockPlugin }
+
+func (m *mockPrepareData) PrepareRequestData(_ context.Context, _ *framework.LLMRequest, _ []framework.Endpoint) error {
+	return nil
+}
+
+func (m *mockPrepareData) Produces() map[string]any { return nil }
+
+func (m *mockPrepareData) Consumes() map[string]any { return nil }
+
+var _ fwkrc.PrepareDataPlugin = &mockPrepareData{}
+
 // Mock Source
 type mockSource struct{ mockPlugin }
 
@@ -718,6 +803,10 @@ func registerTestPlugins(t *testing.T) {
 		return &mockExtractor{mockPlugin{t: fwkplugin.TypedName{Name: name, Type: testExtractorType}}}, nil
 	})
 
+	fwkplugin.Register(testPrepareData, func(name string, _ json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+		return &mockPrepareData{mockPlugin{t: fwkplugin.TypedName{Name: name, Type: testPrepareData}}}, nil
+	})
+
 	fwkplugin.Register(fairness.GlobalStrictFairnessPolicyType, func(name string, _ json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
 		return &flowcontrolmocks.MockFairnessPolicy{
 			TypedNameV: fwkplugin.TypedName{Name: name, Type: fairness.GlobalStrictFairnessPolicyType},
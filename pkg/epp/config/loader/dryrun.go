@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loader
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"gopkg.in/yaml.v3"
+
+	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// DryRunResult is the outcome of the dry run of a configuration.
+type DryRunResult struct {
+	// Config is the effective configuration, with all the defaults applied. It is nil when the
+	// configuration cannot be decoded.
+	Config *configapi.EndpointPickerConfig
+	// PluginOrder is the execution order of the plugins producing and consuming data.
+	PluginOrder []string
+	// Errors lists all the problems found in the configuration.
+	Errors []ValidationError
+}
+
+// DryRun loads the configuration as LoadRawConfig and InstantiateAndConfigure do, instantiating its
+// plugins with the given handle, and reports all the problems found with their lines in the
+// configuration text. The handle is expected to be a throwaway one, not shared with a running EPP.
+func DryRun(configBytes []byte, handle fwkplugin.Handle, logger logr.Logger) *DryRunResult {
+	rawConfig, errs := loadRawConfig(configBytes, logger)
+	result := &DryRunResult{Config: rawConfig}
+	if rawConfig != nil {
+		_, configErrs := instantiateAndConfigure(rawConfig, handle, logger)
+		errs = append(errs, configErrs...)
+		errs = append(errs, extractorErrors(rawConfig.Data, handle)...)
+
+		order, err := datalayer.ValidateAndOrderDataDependencies(handle.GetAllPlugins())
+		if err != nil {
+			errs = append(errs, ValidationError{Err: fmt.Errorf("data dependency validation failed: %w", err)})
+		} else {
+			result.PluginOrder = order
+		}
+	}
+
+	positions := newFieldPositions(configBytes)
+	for i := range errs {
+		if errs[i].Line == 0 {
+			errs[i].Line = positions.line(errs[i].Field)
+		}
+	}
+	result.Errors = errs
+	return result
+}
+
+// extractorErrors returns the problems the set up of the data layer finds when adding the extractors
+// to their data sources. The references to plugins of the wrong type are reported by the loader.
+func extractorErrors(rawDataConfig *configapi.DataLayerConfig, handle fwkplugin.Handle) []ValidationError {
+	if rawDataConfig == nil {
+		return nil
+	}
+	var errs []ValidationError
+	for i, source := range rawDataConfig.Sources {
+		sourcePlugin, ok := handle.Plugin(source.PluginRef).(fwkdl.DataSource)
+		if !ok {
+			continue
+		}
+		for j, extractor := range source.Extractors {
+			extractorPlugin, ok := handle.Plugin(extractor.PluginRef).(fwkdl.Extractor)
+			if !ok {
+				continue
+			}
+			if err := datalayer.ValidateExtractor(sourcePlugin, extractorPlugin); err != nil {
+				errs = append(errs, ValidationError{Field: fmt.Sprintf("data.sources[%d].extractors[%d].pluginRef", i, j), Err: err})
+			}
+		}
+	}
+	return errs
+}
+
+// fieldPositions maps the paths of the fields of a configuration text, such as plugins[2].type, to
+// their lines.
+type fieldPositions map[string]int
+
+// newFieldPositions indexes the fields of the configuration text. It returns an empty index when the
+// text is not valid YAML.
+func newFieldPositions(configBytes []byte) fieldPositions {
+	positions := fieldPositions{}
+	var root yaml.Node
+	if err := yaml.Unmarshal(configBytes, &root); err != nil {
+		return positions
+	}
+	positions.index(&root, "")
+	return positions
+}
+
+func (p fieldPositions) index(node *yaml.Node, path string) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			p.index(child, path)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			childPath := key.Value
+			if path != "" {
+				childPath = path + "." + key.Value
+			}
+			p[childPath] = key.Line
+			p.index(node.Content[i+1], childPath)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+			p[childPath] = child.Line
+			p.index(child, childPath)
+		}
+	}
+}
+
+// line returns the line of the field, or of its closest enclosing field found in the text, or zero.
+func (p fieldPositions) line(field string) int {
+	for field != "" {
+		if line, ok := p[field]; ok {
+			return line
+		}
+		cut := max(strings.LastIndex(field, "."), strings.LastIndex(field, "["))
+		if cut < 0 {
+			return 0
+		}
+		field = field[:cut]
+	}
+	return 0
+}
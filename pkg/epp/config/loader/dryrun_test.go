@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol"
+	"sigs.k8s.io/gateway-api-inference-extension/test/utils"
+)
+
+// errorManyMistakesText has a problem on almost every line, all reported by a dry run.
+const errorManyMistakesText = `apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: unknown
+  type: no-such-type
+- type: test-scorer
+  parameters:
+    blockSize: "not a number"
+- name: test1
+  type: test-plugin
+- type: test-source
+- type: test-extractor
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: test1
+  - pluginRef: missing
+data:
+  sources:
+  - pluginRef: test-source
+    extractors:
+    - pluginRef: test-extractor
+featureGates:
+- dataLayer
+- noSuchGate
+`
+
+func TestDryRun(t *testing.T) {
+	// Not parallel because it modifies global plugin registry.
+	registerTestPlugins(t)
+
+	RegisterFeatureGate(datalayer.ExperimentalDatalayerFeatureGate)
+	RegisterFeatureGate(flowcontrol.FeatureGate)
+
+	tests := []struct {
+		name       string
+		configText string
+		wantErrors []string // the expected errors, with their line and field
+		validate   func(t *testing.T, result *DryRunResult)
+	}{
+		{
+			name:       "valid configuration",
+			configText: successSchedulerConfigText,
+			validate: func(t *testing.T, result *DryRunResult) {
+				require.NotNil(t, result.Config, "the effective configuration should be returned")
+				require.NotNil(t, result.Config.Parser, "the default parser should be applied")
+				require.Len(t, result.Config.SchedulingProfiles[0].Plugins, 2)
+			},
+		},
+		{
+			name:       "default configuration",
+			configText: "",
+			validate: func(t *testing.T, result *DryRunResult) {
+				require.NotNil(t, result.Config)
+				require.Len(t, result.Config.SchedulingProfiles, 1)
+			},
+		},
+		{
+			name:       "all mistakes at once",
+			configText: errorManyMistakesText,
+			wantErrors: []string{
+				"line 25: featureGates[1]: feature gate 'noSuchGate' is unknown or unregistered",
+				"line 5: plugins[0].type: plugin type 'no-such-type' is not registered",
+				"line 7: plugins[1].parameters: failed to create plugin 'test-scorer' (type: test-scorer): " +
+					"json: cannot unmarshal string into Go struct field .blockSize of type int",
+				"line 17: schedulingProfiles[0].plugins[1].pluginRef: schedulingProfiles[default] references undefined plugin 'missing'",
+				"line 16: schedulingProfiles[0].plugins[0].pluginRef: plugin 'test1' (type: test-plugin) referenced in profile " +
+					"'default' is not a filter, scorer or picker",
+				"line 22: data.sources[0].extractors[0].pluginRef: extractor test-extractor/test-extractor input type incompatible " +
+					"with datasource test-source/test-source: extractor input type string is not compatible with data source output type datalayer.NotificationEvent",
+			},
+		},
+		{
+			name:       "invalid prepare data configuration",
+			configText: errorPrepareDataUnknownFailurePolicyText,
+			wantErrors: []string{
+				"line 13: prepareData: unknown failurePolicy 'Retry' of the plugin producer",
+			},
+		},
+		{
+			name: "unknown fields",
+			configText: `apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: test-scorer
+  paramters: {}
+schedulingProfile: []
+`,
+			wantErrors: []string{
+				`line 5: plugins[0].paramters: unknown field`,
+				`line 6: schedulingProfile: unknown field`,
+			},
+		},
+		{
+			name:       "malformed YAML",
+			configText: "plugins:\n- type: a\n  name: [\n",
+			validate: func(t *testing.T, result *DryRunResult) {
+				require.Nil(t, result.Config)
+				require.Len(t, result.Errors, 1)
+				require.NotZero(t, result.Errors[0].Line, "the line of a syntax error should be reported")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handle := utils.NewTestHandle(context.Background())
+			result := DryRun([]byte(tc.configText), handle, logging.NewTestLogger())
+
+			if tc.validate != nil {
+				tc.validate(t, result)
+			}
+			if tc.validate == nil || tc.wantErrors != nil {
+				got := make([]string, 0, len(result.Errors))
+				for _, err := range result.Errors {
+					got = append(got, err.Error())
+				}
+				require.ElementsMatch(t, tc.wantErrors, got)
+			}
+		})
+	}
+}
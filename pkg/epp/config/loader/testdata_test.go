@@ -245,6 +245,30 @@ parser:
   pluginRef: openaiParser
 `
 
+// successPrepareDataConfigText tests that the timeouts and failure policies of PrepareData plugins are correctly loaded.
+const successPrepareDataConfigText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: producer
+  type: test-prepare-data
+- name: consumer
+  type: test-prepare-data
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: maxScore
+prepareData:
+  timeout: 200ms
+  plugins:
+  - pluginRef: producer
+    timeout: 50ms
+    failurePolicy: MarkMissing
+  - pluginRef: consumer
+`
+
 // --- Invalid Configurations (Syntax/Structure) ---
 
 // errorBadYamlText contains invalid YAML syntax.
@@ -601,3 +625,39 @@ schedulingProfiles:
 parser:
   pluginRef: wrongParser # Wrong names
 `
+
+// errorPrepareDataWrongPluginTypeText configures a plugin that is not a PrepareData plugin.
+const errorPrepareDataWrongPluginTypeText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: maxScore
+prepareData:
+  plugins:
+  - pluginRef: maxScore
+    failurePolicy: FailRequest
+`
+
+// errorPrepareDataUnknownFailurePolicyText configures an unknown failure policy.
+const errorPrepareDataUnknownFailurePolicyText = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- name: producer
+  type: test-prepare-data
+- name: maxScore
+  type: max-score-picker
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: maxScore
+prepareData:
+  plugins:
+  - pluginRef: producer
+    failurePolicy: Retry
+`
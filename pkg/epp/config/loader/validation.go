@@ -17,64 +17,105 @@ limitations under the License.
 package loader
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+
 	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 )
 
-// validateConfig performs a deep validation of the configuration integrity.
-// It checks relationships between profiles, plugins, and feature gates.
-func validateConfig(cfg *configapi.EndpointPickerConfig) error {
-	if err := validateFeatureGates(cfg.FeatureGates); err != nil {
-		return fmt.Errorf("feature gate validation failed: %w", err)
+// ValidationError is a problem found in a configuration.
+type ValidationError struct {
+	// Field is the path of the offending field, such as plugins[2].type. It is empty for the problems
+	// of the configuration as a whole.
+	Field string
+	// Line is the line of the field in the configuration text, or zero if unknown.
+	Line int
+	// Err describes the problem.
+	Err error
+}
+
+func (e ValidationError) Error() string {
+	var sb strings.Builder
+	if e.Line > 0 {
+		fmt.Fprintf(&sb, "line %d: ", e.Line)
 	}
-	if err := validateSchedulingProfiles(cfg); err != nil {
-		return fmt.Errorf("scheduling profile validation failed: %w", err)
+	if e.Field != "" {
+		sb.WriteString(e.Field + ": ")
 	}
-	return nil
+	sb.WriteString(e.Err.Error())
+	return sb.String()
 }
 
-func validateSchedulingProfiles(cfg *configapi.EndpointPickerConfig) error {
+func (e ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// joinValidationErrors joins the problems found in a configuration into a single error.
+func joinValidationErrors(validationErrors []ValidationError) error {
+	errs := make([]error, 0, len(validationErrors))
+	for _, err := range validationErrors {
+		errs = append(errs, err)
+	}
+	return fmt.Errorf("configuration validation failed: %w", errors.Join(errs...))
+}
+
+// schedulingProfileErrors returns all the problems of the structure of the scheduling profiles.
+func schedulingProfileErrors(cfg *configapi.EndpointPickerConfig) []ValidationError {
 	definedPlugins := sets.New[string]()
 	for _, p := range cfg.Plugins {
 		definedPlugins.Insert(p.Name)
 	}
 	seenProfileNames := sets.New[string]()
 
+	var errs []ValidationError
 	for i, profile := range cfg.SchedulingProfiles {
+		field := fmt.Sprintf("schedulingProfiles[%d]", i)
 		if profile.Name == "" {
-			return fmt.Errorf("schedulingProfiles[%d] is missing a name", i)
-		}
-		if seenProfileNames.Has(profile.Name) {
-			return fmt.Errorf("schedulingProfiles[%d] has duplicate name '%s'", i, profile.Name)
+			errs = append(errs, ValidationError{Field: field + ".name", Err: fmt.Errorf("schedulingProfiles[%d] is missing a name", i)})
+		} else if seenProfileNames.Has(profile.Name) {
+			errs = append(errs, ValidationError{Field: field + ".name", Err: fmt.Errorf("schedulingProfiles[%d] has duplicate name '%s'", i, profile.Name)})
 		}
 		seenProfileNames.Insert(profile.Name)
 
 		for j, pluginRef := range profile.Plugins {
+			refField := fmt.Sprintf("%s.plugins[%d].pluginRef", field, j)
 			if pluginRef.PluginRef == "" {
-				return fmt.Errorf("schedulingProfiles[%s].plugins[%d] is missing a 'pluginRef'", profile.Name, j)
+				errs = append(errs, ValidationError{Field: refField, Err: fmt.Errorf("schedulingProfiles[%s].plugins[%d] is missing a 'pluginRef'", profile.Name, j)})
+				continue
 			}
 
 			if !definedPlugins.Has(pluginRef.PluginRef) {
-				return fmt.Errorf("schedulingProfiles[%s] references undefined plugin '%s'",
-					profile.Name, pluginRef.PluginRef)
+				errs = append(errs, ValidationError{Field: refField, Err: fmt.Errorf("schedulingProfiles[%s] references undefined plugin '%s'",
+					profile.Name, pluginRef.PluginRef)})
 			}
 		}
 	}
-	return nil
+	return errs
 }
 
-func validateFeatureGates(gates configapi.FeatureGates) error {
-	if gates == nil {
+// validateProfilePlugin checks that a plugin referenced in a scheduling profile takes part in scheduling.
+func validateProfilePlugin(plugin fwkplugin.Plugin, pluginRef, profileName string) error {
+	switch plugin.(type) {
+	case framework.Filter, framework.Scorer, framework.Picker:
 		return nil
 	}
+	return fmt.Errorf("plugin '%s' (type: %s) referenced in profile '%s' is not a filter, scorer or picker",
+		pluginRef, plugin.TypedName().Type, profileName)
+}
 
-	for _, gate := range gates {
+// featureGateErrors returns the problems of the feature gates.
+func featureGateErrors(gates configapi.FeatureGates) []ValidationError {
+	var errs []ValidationError
+	for i, gate := range gates {
 		if !registeredFeatureGates.Has(gate) {
-			return fmt.Errorf("feature gate '%s' is unknown or unregistered", gate)
+			errs = append(errs, ValidationError{Field: fmt.Sprintf("featureGates[%d]", i),
+				Err: fmt.Errorf("feature gate '%s' is unknown or unregistered", gate)})
 		}
 	}
-
-	return nil
+	return errs
 }
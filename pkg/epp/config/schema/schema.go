@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schema generates the JSON Schema of the EndpointPickerConfig, including the parameters of
+// the plugins registered in the binary, for editors and CI pipelines to validate configurations.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// Draft is the JSON Schema dialect of the generated schemas.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// durationPattern matches the durations accepted by time.ParseDuration, such as "1.5s" or "2m30s".
+const durationPattern = `^[-+]?(0|([0-9]+(\.[0-9]*)?|\.[0-9]+)(ns|us|µs|ms|s|m|h))+$`
+
+var (
+	durationType     = reflect.TypeOf(metav1.Duration{})
+	timeDurationType = reflect.TypeOf(time.Duration(0))
+	quantityType     = reflect.TypeOf(resource.Quantity{})
+	rawMessageType   = reflect.TypeOf(json.RawMessage{})
+)
+
+// required lists the fields of the configuration types the loader rejects when missing, by the name
+// of their definition.
+var required = map[string][]string{
+	"PluginSpec":         {"type"},
+	"SchedulingProfile":  {"name"},
+	"SchedulingPlugin":   {"pluginRef"},
+	"DataLayerSource":    {"pluginRef"},
+	"DataLayerExtractor": {"pluginRef"},
+	"ParserConfig":       {"pluginRef"},
+	"PriorityBandConfig": {"priority"},
+}
+
+// Generate returns the JSON Schema of the EndpointPickerConfig. The type of a plugin must be one of
+// the registered plugin types. The parameters of the plugins registered with their default
+// parameters are described by the schema of their parameters, with these defaults; the parameters
+// of the other plugins are not constrained. No plugin is created.
+func Generate() (map[string]any, error) {
+	g := &generator{defs: map[string]any{}}
+	root := g.forStruct(reflect.TypeOf(configapi.EndpointPickerConfig{}), nil)
+	root["$schema"] = Draft
+	root["title"] = "EndpointPickerConfig"
+
+	properties := root["properties"].(map[string]any)
+	properties["apiVersion"] = map[string]any{"type": "string", "enum": []string{configapi.GroupVersion.String()}}
+	properties["kind"] = map[string]any{"type": "string", "enum": []string{"EndpointPickerConfig"}}
+
+	pluginTypes := make([]string, 0, len(fwkplugin.Registry))
+	for pluginType := range fwkplugin.Registry {
+		pluginTypes = append(pluginTypes, pluginType)
+	}
+	slices.Sort(pluginTypes)
+
+	pluginSpec, ok := g.defs["PluginSpec"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("the schema of %T has no PluginSpec definition", configapi.EndpointPickerConfig{})
+	}
+	pluginSpec["properties"].(map[string]any)["type"] = map[string]any{"type": "string", "enum": pluginTypes}
+
+	conditions := []any{}
+	for _, pluginType := range pluginTypes {
+		defaultParameters, ok := fwkplugin.ParametersRegistry[pluginType]
+		if !ok {
+			continue
+		}
+		parameters, err := ForValue(defaultParameters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate the schema of the parameters of the %s plugin - %w", pluginType, err)
+		}
+		conditions = append(conditions, map[string]any{
+			"if": map[string]any{
+				"properties": map[string]any{"type": map[string]any{"const": pluginType}},
+				"required":   []string{"type"},
+			},
+			"then": map[string]any{
+				"properties": map[string]any{"parameters": parameters},
+			},
+		})
+	}
+	if len(conditions) > 0 {
+		pluginSpec["allOf"] = conditions
+	}
+
+	root["$defs"] = g.defs
+	return root, nil
+}
+
+// ForValue returns the JSON Schema of the JSON encoding of the type of v, with the values of v as
+// defaults. The fields of structs are named by their json tags, and unknown fields are rejected.
+func ForValue(v any) (map[string]any, error) {
+	if v == nil {
+		return map[string]any{}, nil
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var defaults any
+	if err := json.Unmarshal(encoded, &defaults); err != nil {
+		return nil, err
+	}
+	return (&generator{}).forType(reflect.TypeOf(v), defaults), nil
+}
+
+// generator builds the schemas of types. When defs is set, the named structs are added to defs and
+// referenced by name, otherwise they are described in place.
+type generator struct {
+	defs map[string]any
+}
+
+// forType returns the schema of t. defaults is the decoded JSON encoding of a value of t, if any.
+func (g *generator) forType(t reflect.Type, defaults any) map[string]any {
+	schema := g.forKind(t, defaults)
+	_, described := schema["properties"] // the defaults of structs are given by their fields
+	if defaults != nil && !described {
+		schema["default"] = defaults
+	}
+	return schema
+}
+
+func (g *generator) forKind(t reflect.Type, defaults any) map[string]any {
+	switch t {
+	case durationType:
+		return map[string]any{"type": "string", "pattern": durationPattern}
+	case timeDurationType:
+		return map[string]any{"type": "integer", "description": "A duration in nanoseconds."}
+	case quantityType:
+		return map[string]any{"anyOf": []any{map[string]any{"type": "string"}, map[string]any{"type": "number"}}}
+	case rawMessageType:
+		return map[string]any{}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.forType(t.Elem(), defaults)
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": g.forType(t.Elem(), nil)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": g.forType(t.Elem(), nil)}
+	case reflect.Struct:
+		if g.defs == nil || t.Name() == "" {
+			return g.forStruct(t, defaults)
+		}
+		if _, ok := g.defs[t.Name()]; !ok {
+			g.defs[t.Name()] = nil // guards against recursive types
+			g.defs[t.Name()] = g.forStruct(t, nil)
+		}
+		return map[string]any{"$ref": "#/$defs/" + t.Name()}
+	default:
+		return map[string]any{}
+	}
+}
+
+// forStruct returns the schema of the struct t, whose fields are described by their json tags.
+func (g *generator) forStruct(t reflect.Type, defaults any) map[string]any {
+	properties := map[string]any{}
+	g.addFields(t, defaults, properties)
+	schema := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if fields, ok := required[t.Name()]; ok && g.defs != nil {
+		schema["required"] = fields
+	}
+	return schema
+}
+
+// addFields adds the schemas of the fields of the struct t to properties, inlining the embedded
+// structs and the fields tagged inline.
+func (g *generator) addFields(t reflect.Type, defaults any, properties map[string]any) {
+	defaultValues, _ := defaults.(map[string]any)
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Struct && (strings.Contains(options, "inline") || (field.Anonymous && name == "")) {
+			g.addFields(fieldType, defaults, properties)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = g.forType(field.Type, defaultValues[name])
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+type testNested struct {
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type testParameters struct {
+	metav1.TypeMeta `json:",inline"`
+	Threshold       int             `json:"threshold"`
+	Ratio           float64         `json:"ratio"`
+	Enabled         bool            `json:"enabled"`
+	Window          metav1.Duration `json:"window"`
+	Names           []string        `json:"names"`
+	Nested          *testNested     `json:"nested,omitempty"`
+	Ignored         string          `json:"-"`
+	unexported      string
+}
+
+func TestForValue(t *testing.T) {
+	got, err := ForValue(testParameters{Threshold: 3, Ratio: 0.5, Window: metav1.Duration{Duration: time.Minute}})
+	require.NoError(t, err)
+
+	want := map[string]any{
+		"type":                 "object",
+		"additionalProperties": false,
+		"properties": map[string]any{
+			"apiVersion": map[string]any{"type": "string"},
+			"kind":       map[string]any{"type": "string"},
+			"threshold":  map[string]any{"type": "integer", "default": float64(3)},
+			"ratio":      map[string]any{"type": "number", "default": 0.5},
+			"enabled":    map[string]any{"type": "boolean", "default": false},
+			"window":     map[string]any{"type": "string", "pattern": durationPattern, "default": "1m0s"},
+			"names":      map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+			"nested": map[string]any{
+				"type":                 "object",
+				"additionalProperties": false,
+				"properties": map[string]any{
+					"labels": map[string]any{"type": "object", "additionalProperties": map[string]any{"type": "string"}},
+				},
+			},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestGenerate(t *testing.T) {
+	created := 0
+	factory := func(string, json.RawMessage, fwkplugin.Handle) (fwkplugin.Plugin, error) {
+		created++
+		return nil, errors.New("parameters are required")
+	}
+	fwkplugin.RegisterWithParameters("test-described", factory,
+		testParameters{Threshold: 3, Window: metav1.Duration{Duration: time.Minute}})
+	fwkplugin.Register("test-plain", factory)
+
+	got, err := Generate()
+	require.NoError(t, err)
+	assert.Zero(t, created, "no plugin must be created to generate the schema")
+
+	assert.Equal(t, Draft, got["$schema"])
+	defs := got["$defs"].(map[string]any)
+	pluginSpec := defs["PluginSpec"].(map[string]any)
+	assert.Equal(t, []string{"type"}, pluginSpec["required"])
+	assert.Equal(t, []string{"test-described", "test-plain"},
+		pluginSpec["properties"].(map[string]any)["type"].(map[string]any)["enum"])
+
+	conditions := pluginSpec["allOf"].([]any)
+	require.Len(t, conditions, 1)
+	condition := conditions[0].(map[string]any)
+	assert.Equal(t, map[string]any{"type": map[string]any{"const": "test-described"}},
+		condition["if"].(map[string]any)["properties"])
+	parameters := condition["then"].(map[string]any)["properties"].(map[string]any)["parameters"].(map[string]any)
+	assert.Equal(t, float64(3), parameters["properties"].(map[string]any)["threshold"].(map[string]any)["default"])
+
+	assert.Equal(t, []string{"pluginRef"}, defs["SchedulingPlugin"].(map[string]any)["required"])
+	assert.Equal(t, map[string]any{"$ref": "#/$defs/SchedulingProfile"},
+		got["properties"].(map[string]any)["schedulingProfiles"].(map[string]any)["items"])
+}
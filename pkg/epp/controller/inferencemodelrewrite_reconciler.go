@@ -19,8 +19,13 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -34,9 +39,15 @@ import (
 )
 
 type InferenceModelRewriteReconciler struct {
-	client.Reader
+	client.Client
 	Datastore datastore.Datastore
 	PoolGKNN  common.GKNN
+	// ServedPools are the other InferencePools served by the Endpoint Picker, which the fallbacks of
+	// the rewrites may spill over to.
+	ServedPools sets.Set[types.NamespacedName]
+	// ControllerName optionally overrides the controller name, which must be unique when the
+	// controllers of several InferencePools run in the same manager.
+	ControllerName string
 }
 
 func (c *InferenceModelRewriteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -67,14 +78,105 @@ func (c *InferenceModelRewriteReconciler) Reconcile(ctx context.Context, req ctr
 
 	// Add or update if the InferenceModelRewrite instance has a creation timestamp older than the existing entry of the model.
 	logger = logger.WithValues("poolRef", infModelRewrite.Spec.PoolRef)
-	c.Datastore.ModelRewriteSet(infModelRewrite)
+	served, unserved := c.resolveFallbacks(infModelRewrite)
+	if err := c.Datastore.ModelRewriteSet(served); err != nil {
+		// The last accepted version of the rewrite, if any, keeps applying until the spec is fixed. Requeuing
+		// does not help, as the spec does not change.
+		logger.Error(err, "Rejected invalid InferenceModelRewrite")
+		return ctrl.Result{}, c.updateStatus(ctx, infModelRewrite, acceptedCondition(infModelRewrite, err))
+	}
 	logger.Info("Added/Updated InferenceModelRewrite")
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{}, c.updateStatus(ctx, infModelRewrite, acceptedCondition(infModelRewrite, nil), resolvedRefsCondition(infModelRewrite, unserved))
+}
+
+// acceptedCondition reports whether the rewrite is accepted, given the error returned when it was
+// set in the datastore.
+func acceptedCondition(infModelRewrite *v1alpha2.InferenceModelRewrite, err error) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               string(v1alpha2.RewriteConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(v1alpha2.RewriteReasonAccepted),
+		ObservedGeneration: infModelRewrite.Generation,
+	}
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(v1alpha2.RewriteReasonInvalidMatch)
+		condition.Message = err.Error()
+	}
+	return condition
+}
+
+// resolveFallbacks returns the rewrite without the fallbacks to InferencePools that the Endpoint
+// Picker does not serve, so that requests never try them, and the names of these pools.
+func (c *InferenceModelRewriteReconciler) resolveFallbacks(infModelRewrite *v1alpha2.InferenceModelRewrite) (*v1alpha2.InferenceModelRewrite, []string) {
+	var served *v1alpha2.InferenceModelRewrite
+	unserved := sets.New[string]()
+	for i, rule := range infModelRewrite.Spec.Rules {
+		fallbacks := make([]v1alpha2.FallbackTarget, 0, len(rule.Fallbacks))
+		for _, fallback := range rule.Fallbacks {
+			if fallback.PoolRef == nil || c.servesPool(string(fallback.PoolRef.Name)) {
+				fallbacks = append(fallbacks, fallback)
+				continue
+			}
+			unserved.Insert(string(fallback.PoolRef.Name))
+		}
+		if len(fallbacks) == len(rule.Fallbacks) {
+			continue
+		}
+		if served == nil {
+			served = infModelRewrite.DeepCopy()
+		}
+		served.Spec.Rules[i].Fallbacks = fallbacks
+	}
+	if served == nil {
+		return infModelRewrite, nil
+	}
+	return served, sets.List(unserved)
+}
+
+// servesPool reports whether the Endpoint Picker serves the pool of the given name, in the
+// namespace of the pool of the reconciler.
+func (c *InferenceModelRewriteReconciler) servesPool(name string) bool {
+	return name == c.PoolGKNN.Name || c.ServedPools.Has(types.NamespacedName{Namespace: c.PoolGKNN.Namespace, Name: name})
+}
+
+// resolvedRefsCondition reports whether the fallback pools of the rewrite are served.
+func resolvedRefsCondition(infModelRewrite *v1alpha2.InferenceModelRewrite, unserved []string) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               string(v1alpha2.RewriteConditionResolvedRefs),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(v1alpha2.RewriteReasonResolvedRefs),
+		ObservedGeneration: infModelRewrite.Generation,
+	}
+	if len(unserved) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(v1alpha2.RewriteReasonFallbackPoolNotServed)
+		condition.Message = fmt.Sprintf("Fallbacks to InferencePools not served by the Endpoint Picker are ignored: %s", strings.Join(unserved, ", "))
+	}
+	return condition
+}
+
+// updateStatus sets the given conditions in the status of the rewrite, and patches it if they
+// changed.
+func (c *InferenceModelRewriteReconciler) updateStatus(ctx context.Context, infModelRewrite *v1alpha2.InferenceModelRewrite, conditions ...metav1.Condition) error {
+	patched := infModelRewrite.DeepCopy()
+	changed := false
+	for _, condition := range conditions {
+		changed = meta.SetStatusCondition(&patched.Status.Conditions, condition) || changed
+	}
+	if !changed {
+		return nil
+	}
+	if err := c.Status().Patch(ctx, patched, client.MergeFrom(infModelRewrite)); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("unable to update the status of InferenceModelRewrite - %w", err)
+	}
+	return nil
 }
 
 func (c *InferenceModelRewriteReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
+		Named(c.ControllerName).
 		For(&v1alpha2.InferenceModelRewrite{}).
 		WithEventFilter(predicate.Funcs{
 			CreateFunc: func(e event.CreateEvent) bool { return c.eventPredicate(e.Object.(*v1alpha2.InferenceModelRewrite)) },
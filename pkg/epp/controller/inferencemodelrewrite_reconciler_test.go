@@ -23,11 +23,14 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -86,6 +89,22 @@ var (
 			Rules: []v1alpha2.InferenceModelRewriteRule{{}},
 		},
 	}
+	rewrite1Invalid = &v1alpha2.InferenceModelRewrite{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              rewrite1.Name,
+			Namespace:         rewrite1.Namespace,
+			CreationTimestamp: metav1.Unix(1003, 0),
+		},
+		Spec: v1alpha2.InferenceModelRewriteSpec{
+			PoolRef: &v1alpha2.PoolObjectReference{
+				Name:  v1alpha2.ObjectName(poolForRewrite.Name),
+				Group: v1alpha2.Group(poolForRewrite.GroupVersionKind().Group),
+			},
+			Rules: []v1alpha2.InferenceModelRewriteRule{{
+				Matches: []v1alpha2.Match{{Model: &v1alpha2.ModelMatch{Type: ptr.To(v1alpha2.MatchRegularExpression), Value: "llama-(["}}},
+			}},
+		},
+	}
 	rewrite1Deleted = &v1alpha2.InferenceModelRewrite{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:              rewrite1.Name,
@@ -156,6 +175,12 @@ func TestInferenceModelRewriteReconciler(t *testing.T) {
 			rewrite:         rewrite1Updated,
 			wantRewrites:    []*v1alpha2.InferenceModelRewrite{rewrite1Updated},
 		},
+		{
+			name:            "Rewrite with an invalid match rejected, last accepted version kept",
+			rewritesInStore: []*v1alpha2.InferenceModelRewrite{rewrite1, rewrite2},
+			rewrite:         rewrite1Invalid,
+			wantRewrites:    []*v1alpha2.InferenceModelRewrite{rewrite1, rewrite2},
+		},
 		{
 			name:            "Rewrite not found, no matching existing rewrite to delete",
 			rewritesInStore: []*v1alpha2.InferenceModelRewrite{rewrite1},
@@ -191,15 +216,18 @@ func TestInferenceModelRewriteReconciler(t *testing.T) {
 				fakeClient := fake.NewClientBuilder().
 					WithScheme(scheme).
 					WithObjects(initObjs...).
+					WithStatusSubresource(&v1alpha2.InferenceModelRewrite{}).
 					Build()
 				ds := datastore.NewDatastore(t.Context(), epf, 0)
 				for _, r := range test.rewritesInStore {
-					ds.ModelRewriteSet(r)
+					if err := ds.ModelRewriteSet(r); err != nil {
+						t.Fatalf("failed to set rewrite: %v", err)
+					}
 				}
 				endpointPool := poolutil.InferencePoolToEndpointPool(poolForRewrite)
 				_ = ds.PoolSet(context.Background(), fakeClient, endpointPool)
 				reconciler := &InferenceModelRewriteReconciler{
-					Reader:    fakeClient,
+					Client:    fakeClient,
 					Datastore: ds,
 					PoolGKNN: common.GKNN{
 						NamespacedName: types.NamespacedName{Name: poolForRewrite.Name, Namespace: poolForRewrite.Namespace},
@@ -231,6 +259,159 @@ func TestInferenceModelRewriteReconciler(t *testing.T) {
 	}
 }
 
+func TestInferenceModelRewriteReconcilerFallbackPools(t *testing.T) {
+	servedPool := types.NamespacedName{Name: "served-pool", Namespace: poolForRewrite.Namespace}
+	poolFallback := func(name string) v1alpha2.FallbackTarget {
+		return v1alpha2.FallbackTarget{PoolRef: &v1alpha2.PoolObjectReference{Name: v1alpha2.ObjectName(name)}}
+	}
+	newRewrite := func(fallbacks ...v1alpha2.FallbackTarget) *v1alpha2.InferenceModelRewrite {
+		rewrite := rewrite1.DeepCopy()
+		rewrite.ResourceVersion = ""
+		rewrite.Spec.Rules = []v1alpha2.InferenceModelRewriteRule{{Fallbacks: fallbacks}}
+		return rewrite
+	}
+	tests := []struct {
+		name          string
+		rewrite       *v1alpha2.InferenceModelRewrite
+		wantFallbacks []v1alpha2.FallbackTarget
+		wantCondition metav1.Condition
+	}{
+		{
+			name:          "served fallback pools",
+			rewrite:       newRewrite(poolFallback(servedPool.Name), poolFallback(poolForRewrite.Name), v1alpha2.FallbackTarget{ModelRewrite: "small"}),
+			wantFallbacks: []v1alpha2.FallbackTarget{poolFallback(servedPool.Name), poolFallback(poolForRewrite.Name), {ModelRewrite: "small"}},
+			wantCondition: metav1.Condition{
+				Type:   string(v1alpha2.RewriteConditionResolvedRefs),
+				Status: metav1.ConditionTrue,
+				Reason: string(v1alpha2.RewriteReasonResolvedRefs),
+			},
+		},
+		{
+			name:          "fallback pool not served",
+			rewrite:       newRewrite(poolFallback("other-pool"), poolFallback(servedPool.Name)),
+			wantFallbacks: []v1alpha2.FallbackTarget{poolFallback(servedPool.Name)},
+			wantCondition: metav1.Condition{
+				Type:    string(v1alpha2.RewriteConditionResolvedRefs),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(v1alpha2.RewriteReasonFallbackPoolNotServed),
+				Message: "Fallbacks to InferencePools not served by the Endpoint Picker are ignored: other-pool",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = v1alpha2.Install(scheme)
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(test.rewrite).
+				WithStatusSubresource(&v1alpha2.InferenceModelRewrite{}).
+				Build()
+			ds := datastore.NewDatastore(t.Context(), backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second), 0)
+			reconciler := &InferenceModelRewriteReconciler{
+				Client:    fakeClient,
+				Datastore: ds,
+				PoolGKNN: common.GKNN{
+					NamespacedName: types.NamespacedName{Name: poolForRewrite.Name, Namespace: poolForRewrite.Namespace},
+					GroupKind:      schema.GroupKind{Group: poolForRewrite.GroupVersionKind().Group, Kind: poolForRewrite.GroupVersionKind().Kind},
+				},
+				ServedPools: sets.New(servedPool),
+			}
+
+			key := client.ObjectKeyFromObject(test.rewrite)
+			if _, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: key}); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			rewrites := ds.ModelRewriteGetAll()
+			if len(rewrites) != 1 {
+				t.Fatalf("Unexpected number of rewrites; want: 1, got: %d", len(rewrites))
+			}
+			if diff := cmp.Diff(test.wantFallbacks, rewrites[0].Spec.Rules[0].Fallbacks); diff != "" {
+				t.Errorf("Unexpected fallbacks (-want +got): %s", diff)
+			}
+
+			got := &v1alpha2.InferenceModelRewrite{}
+			if err := fakeClient.Get(context.Background(), key, got); err != nil {
+				t.Fatalf("failed to get rewrite: %v", err)
+			}
+			condition := meta.FindStatusCondition(got.Status.Conditions, string(v1alpha2.RewriteConditionResolvedRefs))
+			if condition == nil {
+				t.Fatal("expected the ResolvedRefs condition to be set")
+			}
+			if diff := cmp.Diff(test.wantCondition, *condition, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected condition (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestInferenceModelRewriteReconcilerAcceptedCondition(t *testing.T) {
+	tests := []struct {
+		name          string
+		rewrite       *v1alpha2.InferenceModelRewrite
+		wantCondition metav1.Condition
+	}{
+		{
+			name:    "valid rewrite",
+			rewrite: rewrite1,
+			wantCondition: metav1.Condition{
+				Type:   string(v1alpha2.RewriteConditionAccepted),
+				Status: metav1.ConditionTrue,
+				Reason: string(v1alpha2.RewriteReasonAccepted),
+			},
+		},
+		{
+			name:    "invalid regular expression",
+			rewrite: rewrite1Invalid,
+			wantCondition: metav1.Condition{
+				Type:    string(v1alpha2.RewriteConditionAccepted),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(v1alpha2.RewriteReasonInvalidMatch),
+				Message: "invalid match 0 of rule 0 of InferenceModelRewrite rewrite1: error parsing regexp: missing closing ]: `[)$`",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = v1alpha2.Install(scheme)
+			rewrite := test.rewrite.DeepCopy()
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(rewrite).
+				WithStatusSubresource(&v1alpha2.InferenceModelRewrite{}).
+				Build()
+			ds := datastore.NewDatastore(t.Context(), backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second), 0)
+			reconciler := &InferenceModelRewriteReconciler{
+				Client:    fakeClient,
+				Datastore: ds,
+				PoolGKNN: common.GKNN{
+					NamespacedName: types.NamespacedName{Name: poolForRewrite.Name, Namespace: poolForRewrite.Namespace},
+					GroupKind:      schema.GroupKind{Group: poolForRewrite.GroupVersionKind().Group, Kind: poolForRewrite.GroupVersionKind().Kind},
+				},
+			}
+
+			key := client.ObjectKeyFromObject(rewrite)
+			if _, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: key}); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			got := &v1alpha2.InferenceModelRewrite{}
+			if err := fakeClient.Get(context.Background(), key, got); err != nil {
+				t.Fatalf("failed to get rewrite: %v", err)
+			}
+			condition := meta.FindStatusCondition(got.Status.Conditions, string(v1alpha2.RewriteConditionAccepted))
+			if condition == nil {
+				t.Fatal("expected the Accepted condition to be set")
+			}
+			if diff := cmp.Diff(test.wantCondition, *condition, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected condition (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
 func diffStoreRewrites(ds datastore.Datastore, wantRewrites []*v1alpha2.InferenceModelRewrite) string {
 	if wantRewrites == nil {
 		wantRewrites = []*v1alpha2.InferenceModelRewrite{}
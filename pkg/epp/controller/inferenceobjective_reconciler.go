@@ -37,6 +37,9 @@ type InferenceObjectiveReconciler struct {
 	client.Reader
 	Datastore datastore.Datastore
 	PoolGKNN  common.GKNN
+	// ControllerName optionally overrides the controller name, which must be unique when the
+	// controllers of several InferencePools run in the same manager.
+	ControllerName string
 }
 
 func (c *InferenceObjectiveReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -70,6 +73,7 @@ func (c *InferenceObjectiveReconciler) Reconcile(ctx context.Context, req ctrl.R
 
 func (c *InferenceObjectiveReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
+		Named(c.ControllerName).
 		For(&v1alpha2.InferenceObjective{}).
 		WithEventFilter(predicate.Funcs{
 			CreateFunc: func(e event.CreateEvent) bool { return c.eventPredicate(e.Object.(*v1alpha2.InferenceObjective)) },
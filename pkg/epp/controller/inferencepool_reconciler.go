@@ -25,6 +25,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	v1 "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/common"
@@ -41,6 +42,9 @@ type InferencePoolReconciler struct {
 	client.Reader
 	Datastore datastore.Datastore
 	PoolGKNN  common.GKNN
+	// ControllerName optionally overrides the controller name, which must be unique when the
+	// controllers of several InferencePools run in the same manager.
+	ControllerName string
 }
 
 func (c *InferencePoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -95,14 +99,22 @@ func (c *InferencePoolReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 }
 
 func (c *InferencePoolReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The manager cache may hold other pools when it is shared by the reconcilers of several pools.
+	ownPool := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetName() == c.PoolGKNN.Name && obj.GetNamespace() == c.PoolGKNN.Namespace
+	})
 	switch c.PoolGKNN.Group {
 	case v1alpha2.GroupName:
 		return ctrl.NewControllerManagedBy(mgr).
+			Named(c.ControllerName).
 			For(&v1alpha2.InferencePool{}).
+			WithEventFilter(ownPool).
 			Complete(c)
 	case v1.GroupName:
 		return ctrl.NewControllerManagedBy(mgr).
+			Named(c.ControllerName).
 			For(&v1.InferencePool{}).
+			WithEventFilter(ownPool).
 			Complete(c)
 	default:
 		return fmt.Errorf("unknown group %s", c.PoolGKNN.Group)
@@ -36,6 +36,9 @@ import (
 type PodReconciler struct {
 	client.Reader
 	Datastore datastore.Datastore
+	// ControllerName optionally overrides the controller name, which must be unique when the
+	// controllers of several InferencePools run in the same manager.
+	ControllerName string
 }
 
 func (c *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -82,6 +85,7 @@ func (c *PodReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
+		Named(c.ControllerName).
 		For(&corev1.Pod{}).
 		WithEventFilter(filter).
 		Complete(c)
@@ -89,7 +93,13 @@ func (c *PodReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 func (c *PodReconciler) updateDatastore(ctx context.Context, pod *corev1.Pod) {
 	logger := log.FromContext(ctx)
-	if !podutil.IsPodReady(pod) || !c.Datastore.PoolLabelsMatch(pod.Labels) {
+	if !pod.DeletionTimestamp.IsZero() && c.Datastore.PoolLabelsMatch(pod.Labels) {
+		// A terminating pod stops receiving new requests, but its endpoints stay in the datastore
+		// until the pod is deleted so that the requests already sent to it are tracked to completion.
+		if c.Datastore.PodDrain(pod.Name) {
+			logger.V(logutil.DEFAULT).Info("Pod draining")
+		}
+	} else if !podutil.IsPodReady(pod) || !c.Datastore.PoolLabelsMatch(pod.Labels) {
 		logger.V(logutil.DEBUG).Info("Pod removed or not added")
 		c.Datastore.PodDelete(pod.Name)
 	} else {
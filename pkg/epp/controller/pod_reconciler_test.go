@@ -56,6 +56,7 @@ func TestPodReconciler(t *testing.T) {
 		existingPods []*corev1.Pod
 		incomingPod  *corev1.Pod
 		wantPods     []*corev1.Pod
+		wantDraining []string
 		req          *ctrl.Request
 	}{
 		{
@@ -95,7 +96,7 @@ func TestPodReconciler(t *testing.T) {
 			wantPods: []*corev1.Pod{basePod11, basePod2},
 		},
 		{
-			name:         "Delete pod with DeletionTimestamp",
+			name:         "Drain pod with DeletionTimestamp",
 			existingPods: []*corev1.Pod{basePod1, basePod2},
 			pool: &v1.InferencePool{
 				Spec: v1.InferencePoolSpec{
@@ -111,6 +112,45 @@ func TestPodReconciler(t *testing.T) {
 				Labels(map[string]string{"some-key": "some-val"}).
 				DeletionTimestamp().
 				ReadyCondition().ObjRef(),
+			wantPods:     []*corev1.Pod{basePod1, basePod2},
+			wantDraining: []string{"pod1"},
+		},
+		{
+			name:         "Keep draining pod that is no longer ready",
+			existingPods: []*corev1.Pod{basePod1, basePod2},
+			pool: &v1.InferencePool{
+				Spec: v1.InferencePoolSpec{
+					TargetPorts: []v1.Port{{Number: v1.PortNumber(int32(8000))}},
+					Selector: v1.LabelSelector{
+						MatchLabels: map[v1.LabelKey]v1.LabelValue{
+							"some-key": "some-val",
+						},
+					},
+				},
+			},
+			incomingPod: utiltest.FromBase(basePod1).
+				Labels(map[string]string{"some-key": "some-val"}).
+				DeletionTimestamp().ObjRef(),
+			wantPods:     []*corev1.Pod{basePod1, basePod2},
+			wantDraining: []string{"pod1"},
+		},
+		{
+			name:         "Delete terminating pod that does not match selector",
+			existingPods: []*corev1.Pod{basePod1, basePod2},
+			pool: &v1.InferencePool{
+				Spec: v1.InferencePoolSpec{
+					TargetPorts: []v1.Port{{Number: v1.PortNumber(int32(8000))}},
+					Selector: v1.LabelSelector{
+						MatchLabels: map[v1.LabelKey]v1.LabelValue{
+							"some-key": "some-val",
+						},
+					},
+				},
+			},
+			incomingPod: utiltest.FromBase(basePod1).
+				Labels(map[string]string{"some-wrong-key": "some-val"}).
+				DeletionTimestamp().
+				ReadyCondition().ObjRef(),
 			wantPods: []*corev1.Pod{basePod2},
 		},
 		{
@@ -220,13 +260,20 @@ func TestPodReconciler(t *testing.T) {
 				}
 
 				var gotPods []*corev1.Pod
+				var gotDraining []string
 				for _, pm := range store.PodList(datastore.AllPodsPredicate) {
 					pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: pm.GetMetadata().PodName, Namespace: pm.GetMetadata().NamespacedName.Namespace}, Status: corev1.PodStatus{PodIP: pm.GetMetadata().GetIPAddress()}}
 					gotPods = append(gotPods, pod)
+					if pm.GetMetadata().Draining {
+						gotDraining = append(gotDraining, pm.GetMetadata().PodName)
+					}
 				}
 				if !cmp.Equal(gotPods, test.wantPods, cmpopts.SortSlices(func(a, b *corev1.Pod) bool { return a.Name < b.Name })) {
 					t.Errorf("got (%v) != want (%v);", gotPods, test.wantPods)
 				}
+				if diff := cmp.Diff(test.wantDraining, gotDraining, cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Unexpected draining pods (-want +got): %s", diff)
+				}
 			})
 		}
 	}
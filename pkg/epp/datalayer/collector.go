@@ -19,9 +19,11 @@ package datalayer
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
+	"github.com/go-logr/logr"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
@@ -37,6 +39,12 @@ import (
 
 const (
 	defaultCollectionTimeout = time.Second
+
+	// streaming sources are reconnected with exponential backoff, starting at
+	// defaultStreamInitialBackoff and capped at defaultStreamMaxBackoff. The backoff
+	// is reset once a connection has stayed up for at least defaultStreamMaxBackoff.
+	defaultStreamInitialBackoff = 500 * time.Millisecond
+	defaultStreamMaxBackoff     = 30 * time.Second
 )
 
 // Ticker implements a time source for periodic invocation.
@@ -74,16 +82,25 @@ type Collector struct {
 	startOnce sync.Once
 	stopOnce  sync.Once
 
+	// reconnection backoff for streaming sources
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
 	// TODO: optional metrics tracking collection (e.g., errors, invocations, ...)
 }
 
 // NewCollector returns a new collector.
 func NewCollector() *Collector {
-	return &Collector{}
+	return &Collector{
+		initialBackoff: defaultStreamInitialBackoff,
+		maxBackoff:     defaultStreamMaxBackoff,
+	}
 }
 
 // Start initiates data source collection for the endpoint.
-// All sources must implement PollingDataSource. Validation is performed by the caller.
+// All sources must implement either PollingDataSource or StreamingDataSource.
+// Polling sources are invoked on every tick, while each streaming source is run
+// in its own goroutine for the lifetime of the collector.
 // TODO: pass PoolInfo for backward compatibility
 func (c *Collector) Start(ctx context.Context, ticker Ticker, ep fwkdl.Endpoint, sources []fwkdl.DataSource) error {
 	// Validate sources slice is not empty
@@ -92,11 +109,18 @@ func (c *Collector) Start(ctx context.Context, ticker Ticker, ep fwkdl.Endpoint,
 	}
 
 	pollers := make([]fwkdl.PollingDataSource, 0, len(sources))
+	streamers := []fwkdl.StreamingDataSource{}
 	for _, src := range sources {
-		if src == nil {
+		switch typed := src.(type) {
+		case nil:
 			return errors.New("cannot add nil data source")
+		case fwkdl.PollingDataSource:
+			pollers = append(pollers, typed)
+		case fwkdl.StreamingDataSource:
+			streamers = append(streamers, typed)
+		default:
+			return fmt.Errorf("data source %s is neither polling nor streaming", src.TypedName())
 		}
-		pollers = append(pollers, src.(fwkdl.PollingDataSource))
 	}
 
 	var ready chan struct{}
@@ -116,6 +140,10 @@ func (c *Collector) Start(ctx context.Context, ticker Ticker, ep fwkdl.Endpoint,
 				ticker.Stop()
 			}()
 
+			for _, src := range streamers {
+				go c.stream(logger, src, endpoint)
+			}
+
 			close(ready) // signal ready to accept ticks
 
 			for {
@@ -154,6 +182,34 @@ func (c *Collector) Start(ctx context.Context, ticker Ticker, ep fwkdl.Endpoint,
 	}
 }
 
+// stream runs a streaming source for the endpoint until the collector is stopped,
+// reconnecting with exponential backoff whenever the stream terminates.
+func (c *Collector) stream(logger logr.Logger, src fwkdl.StreamingDataSource, ep fwkdl.Endpoint) {
+	logger = logger.WithValues("source", src.TypedName().String())
+	backoff := c.initialBackoff
+
+	for {
+		started := time.Now()
+		err := src.Stream(c.ctx, ep)
+		if c.ctx.Err() != nil { // per endpoint context cancelled
+			return
+		}
+		if time.Since(started) >= c.maxBackoff { // connection was healthy for a while
+			backoff = c.initialBackoff
+		}
+		logger.V(logging.DEBUG).Info("stream terminated, reconnecting", "error", err, "backoff", backoff)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-c.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		backoff = min(2*backoff, c.maxBackoff)
+	}
+}
+
 // Stop terminates the collector.
 func (c *Collector) Stop() error {
 	if c.ctx == nil || c.cancel == nil {
@@ -24,6 +24,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer/mocks"
@@ -138,6 +139,17 @@ func TestCollectorStartSourceValidation(t *testing.T) {
 			sources: []fwkdl.DataSource{&datasourcemocks.MetricsDataSource{}},
 			wantErr: false,
 		},
+		{
+			name:    "valid streaming source succeeds",
+			sources: []fwkdl.DataSource{&datasourcemocks.StreamingDataSource{Block: true}},
+			wantErr: false,
+		},
+		{
+			name: "notification source returns error",
+			sources: []fwkdl.DataSource{datasourcemocks.NewNotificationSource("notify", "notify",
+				schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"})},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -156,3 +168,35 @@ func TestCollectorStartSourceValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestCollectorStreamsUntilStopped(t *testing.T) {
+	source := &datasourcemocks.StreamingDataSource{Block: true}
+	c := NewCollector()
+	ticker := mocks.NewTicker()
+	ctx := context.Background()
+
+	require.NoError(t, c.Start(ctx, ticker, endpoint, []fwkdl.DataSource{source}))
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&source.CallCount) == 1
+	}, 1*time.Second, 2*time.Millisecond, "expected stream to be opened")
+
+	require.NoError(t, c.Stop())
+	time.Sleep(20 * time.Millisecond) // let the stream goroutine observe cancellation
+	assert.Equal(t, int64(1), atomic.LoadInt64(&source.CallCount), "stream reopened after stop")
+}
+
+func TestCollectorReconnectsStreamWithBackoff(t *testing.T) {
+	source := &datasourcemocks.StreamingDataSource{}
+	c := NewCollector()
+	c.initialBackoff = time.Millisecond
+	c.maxBackoff = 4 * time.Millisecond
+	ticker := mocks.NewTicker()
+	ctx := context.Background()
+
+	require.NoError(t, c.Start(ctx, ticker, endpoint, []fwkdl.DataSource{source}))
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&source.CallCount) >= 3
+	}, 1*time.Second, 2*time.Millisecond, "expected stream to be reconnected")
+
+	require.NoError(t, c.Stop())
+}
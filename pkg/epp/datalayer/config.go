@@ -55,23 +55,8 @@ func WithConfig(cfg *Config, disallowedExtractorType string) error {
 				return fmt.Errorf("disallowed Extractor %s is configured for source %s",
 					extractor.TypedName().String(), srcCfg.Plugin.TypedName().String())
 			}
-			// Validate extractor input type is compatible with datasource output type
-			if err := ValidateInputTypeCompatible(srcCfg.Plugin.OutputType(), extractor.ExpectedInputType()); err != nil {
-				return fmt.Errorf("extractor %s input type incompatible with datasource %s: %w",
-					extractor.TypedName(), srcCfg.Plugin.TypedName(), err)
-			}
-			// Validate extractor type is compatible with datasource expected extractor type
-			extractorType := reflect.TypeOf(extractor)
-			if err := ValidateExtractorCompatible(extractorType, srcCfg.Plugin.ExtractorType()); err != nil {
-				return fmt.Errorf("extractor %s type incompatible with datasource %s: %w",
-					extractor.TypedName(), srcCfg.Plugin.TypedName(), err)
-			}
-			// Allow datasource to perform additional custom validation
-			if validator, ok := srcCfg.Plugin.(fwkdl.ValidatingDataSource); ok {
-				if err := validator.ValidateExtractor(extractor); err != nil {
-					return fmt.Errorf("extractor %s failed custom validation for datasource %s: %w",
-						extractor.TypedName(), srcCfg.Plugin.TypedName(), err)
-				}
+			if err := ValidateExtractor(srcCfg.Plugin, extractor); err != nil {
+				return err
 			}
 			if err := srcCfg.Plugin.AddExtractor(extractor); err != nil {
 				return fmt.Errorf("failed to add Extractor %s to DataSource %s: %w", extractor.TypedName(),
@@ -84,3 +69,26 @@ func WithConfig(cfg *Config, disallowedExtractorType string) error {
 	}
 	return nil
 }
+
+// ValidateExtractor checks that the extractor can be added to the data source, without adding it.
+func ValidateExtractor(source fwkdl.DataSource, extractor fwkdl.Extractor) error {
+	// Validate extractor input type is compatible with datasource output type
+	if err := ValidateInputTypeCompatible(source.OutputType(), extractor.ExpectedInputType()); err != nil {
+		return fmt.Errorf("extractor %s input type incompatible with datasource %s: %w",
+			extractor.TypedName(), source.TypedName(), err)
+	}
+	// Validate extractor type is compatible with datasource expected extractor type
+	extractorType := reflect.TypeOf(extractor)
+	if err := ValidateExtractorCompatible(extractorType, source.ExtractorType()); err != nil {
+		return fmt.Errorf("extractor %s type incompatible with datasource %s: %w",
+			extractor.TypedName(), source.TypedName(), err)
+	}
+	// Allow datasource to perform additional custom validation
+	if validator, ok := source.(fwkdl.ValidatingDataSource); ok {
+		if err := validator.ValidateExtractor(extractor); err != nil {
+			return fmt.Errorf("extractor %s failed custom validation for datasource %s: %w",
+				extractor.TypedName(), source.TypedName(), err)
+		}
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -44,13 +45,19 @@ import (
 var (
 	errPoolNotSynced = errors.New("InferencePool is not initialized in data store")
 	AllPodsPredicate = func(_ fwkdl.Endpoint) bool { return true }
+	// SchedulablePodsPredicate matches the endpoints that may receive new requests, i.e. that
+	// are not draining.
+	SchedulablePodsPredicate = func(ep fwkdl.Endpoint) bool {
+		metadata := ep.GetMetadata()
+		return metadata == nil || !metadata.Draining
+	}
 )
 
 const (
-	// activePortsAnnotation is used to specify which ports on a pod should be considered
+	// ActivePortsAnnotation is used to specify which ports on a pod should be considered
 	// as active for inference traffic. The value should be a comma-separated list of port numbers.
 	// Example: "8000,8001,8002"
-	activePortsAnnotation = "inference.networking.k8s.io/active-ports"
+	ActivePortsAnnotation = "inference.networking.k8s.io/active-ports"
 )
 
 // The datastore is a local cache of relevant data for the given InferencePool (currently all pulled from k8s-api)
@@ -71,15 +78,24 @@ type Datastore interface {
 	ObjectiveGetAll() []*v1alpha2.InferenceObjective
 
 	// InferenceModelRewrite operations
-	ModelRewriteSet(infModelRewrite *v1alpha2.InferenceModelRewrite)
+	// ModelRewriteSet adds or updates an InferenceModelRewrite. It returns an error, and leaves the
+	// rewrites unchanged, if the rewrite has an invalid match.
+	ModelRewriteSet(infModelRewrite *v1alpha2.InferenceModelRewrite) error
 	ModelRewriteDelete(namespacedName types.NamespacedName)
-	ModelRewriteGet(modelName string) (*v1alpha2.InferenceModelRewriteRule, string)
+	ModelRewriteGet(modelName string, headers map[string]string) (*v1alpha2.InferenceModelRewriteRule, string)
 	ModelRewriteGetAll() []*v1alpha2.InferenceModelRewrite
 
 	// PodList lists pods matching the given predicate.
 	PodList(predicate func(backendmetrics.PodMetrics) bool) []backendmetrics.PodMetrics
 	PodUpdateOrAddIfNotExist(ctx context.Context, pod *corev1.Pod) bool
+	// PodDrain marks the endpoints of a terminating pod as draining, so that they receive no new
+	// requests while staying in the store until PodDelete is called. It returns whether any endpoint
+	// of the pod was not draining yet.
+	PodDrain(podName string) bool
 	PodDelete(podName string)
+	// SetEndpointListeners sets the listeners notified when endpoints start draining or are removed.
+	// It must be called before the datastore is populated.
+	SetEndpointListeners(listeners ...fwkdl.EndpointListener)
 
 	// Clears the store state, happens when the pool gets deleted.
 	Clear()
@@ -120,6 +136,8 @@ type datastore struct {
 	// used only if there is only one inference engine per pod
 	modelServerMetricsPort int32 // TODO: deprecating
 	epf                    datalayer.EndpointFactory
+	// listeners are notified when endpoints start draining or are removed.
+	listeners []fwkdl.EndpointListener
 }
 
 func (ds *datastore) WithEndpointPool(pool *datalayer.EndpointPool) *datastore {
@@ -134,11 +152,14 @@ func (ds *datastore) Clear() {
 	ds.objectives = make(map[string]*v1alpha2.InferenceObjective)
 	ds.modelRewrites = newModelRewriteStore()
 	// stop all pods go routines before clearing the pods map.
-	ds.pods.Range(func(_, v any) bool {
-		ds.epf.ReleaseEndpoint(v.(backendmetrics.PodMetrics))
+	ds.pods.Range(func(k, v any) bool {
+		ds.removeEndpoint(k, v.(fwkdl.Endpoint))
 		return true
 	})
-	ds.pods.Clear()
+}
+
+func (ds *datastore) SetEndpointListeners(listeners ...fwkdl.EndpointListener) {
+	ds.listeners = listeners
 }
 
 // /// Pool APIs ///
@@ -230,10 +251,10 @@ func (ds *datastore) ObjectiveGetAll() []*v1alpha2.InferenceObjective {
 	return res
 }
 
-func (ds *datastore) ModelRewriteSet(infModelRewrite *v1alpha2.InferenceModelRewrite) {
+func (ds *datastore) ModelRewriteSet(infModelRewrite *v1alpha2.InferenceModelRewrite) error {
 	ds.mu.Lock()
 	defer ds.mu.Unlock()
-	ds.modelRewrites.set(infModelRewrite)
+	return ds.modelRewrites.set(infModelRewrite)
 }
 
 func (ds *datastore) ModelRewriteDelete(namespacedName types.NamespacedName) {
@@ -242,10 +263,10 @@ func (ds *datastore) ModelRewriteDelete(namespacedName types.NamespacedName) {
 	ds.modelRewrites.delete(namespacedName)
 }
 
-func (ds *datastore) ModelRewriteGet(modelName string) (*v1alpha2.InferenceModelRewriteRule, string) {
+func (ds *datastore) ModelRewriteGet(modelName string, headers map[string]string) (*v1alpha2.InferenceModelRewriteRule, string) {
 	ds.mu.RLock()
 	defer ds.mu.RUnlock()
-	return ds.modelRewrites.getRule(modelName)
+	return ds.modelRewrites.getRule(modelName, headers)
 }
 
 func (ds *datastore) ModelRewriteGetAll() []*v1alpha2.InferenceModelRewrite {
@@ -334,6 +355,7 @@ func (ds *datastore) podUpdateOrAddIfNotExist(ctx context.Context, pod *corev1.P
 		existing, ok := ds.pods.Load(endpointMetadata.NamespacedName)
 		if !ok {
 			ep = ds.epf.NewEndpoint(ds.parentCtx, endpointMetadata, ds)
+			ep.GetAttributes().Put(fwkdl.JoinTimeKey, fwkdl.NewJoinTime(joinTime(pod)))
 			ds.pods.Store(endpointMetadata.NamespacedName, ep)
 			result = false
 		} else {
@@ -351,25 +373,53 @@ func (ds *datastore) podUpdateOrAddIfNotExist(ctx context.Context, pod *corev1.P
 
 		namespacedName := createEndpointNamespacedName(pod, idx)
 		if ep, ok := ds.pods.Load(namespacedName); ok {
-			ds.pods.Delete(namespacedName)
-			ds.epf.ReleaseEndpoint(ep.(fwkdl.Endpoint))
+			ds.removeEndpoint(namespacedName, ep.(fwkdl.Endpoint))
 		}
 	}
 
 	return result
 }
 
+func (ds *datastore) PodDrain(podName string) bool {
+	drained := false
+	ds.pods.Range(func(_, v any) bool {
+		ep := v.(fwkdl.Endpoint)
+		metadata := ep.GetMetadata()
+		if metadata.PodName != podName || metadata.Draining {
+			return true
+		}
+		metadata = metadata.Clone()
+		metadata.Draining = true
+		ep.UpdateMetadata(metadata)
+		drained = true
+		for _, listener := range ds.listeners {
+			listener.EndpointDraining(ds.parentCtx, metadata)
+		}
+		return true
+	})
+	return drained
+}
+
 func (ds *datastore) PodDelete(podName string) {
 	ds.pods.Range(func(k, v any) bool {
 		ep := v.(fwkdl.Endpoint)
 		if ep.GetMetadata().PodName == podName {
-			ds.pods.Delete(k)
-			ds.epf.ReleaseEndpoint(ep)
+			ds.removeEndpoint(k, ep)
 		}
 		return true
 	})
 }
 
+// removeEndpoint removes the endpoint stored under the given key, stops its data collection and
+// notifies the listeners.
+func (ds *datastore) removeEndpoint(key any, ep fwkdl.Endpoint) {
+	ds.pods.Delete(key)
+	ds.epf.ReleaseEndpoint(ep)
+	for _, listener := range ds.listeners {
+		listener.EndpointRemoved(ds.parentCtx, ep.GetMetadata())
+	}
+}
+
 func (ds *datastore) podResyncAll(ctx context.Context, reader client.Reader) error {
 	logger := log.FromContext(ctx)
 	podList := &corev1.PodList{}
@@ -384,6 +434,14 @@ func (ds *datastore) podResyncAll(ctx context.Context, reader client.Reader) err
 	// This ensures orphaned rank endpoints are removed when targetPorts shrinks.
 	activeEndpoints := sets.New[types.NamespacedName]()
 	for _, pod := range podList.Items {
+		if !pod.DeletionTimestamp.IsZero() {
+			// Terminating pods keep their endpoints, if any, draining until they are deleted.
+			for idx := range ds.pool.TargetPorts {
+				activeEndpoints.Insert(createEndpointNamespacedName(&pod, idx))
+			}
+			ds.PodDrain(pod.Name)
+			continue
+		}
 		if !podutil.IsPodReady(&pod) {
 			continue
 		}
@@ -405,8 +463,7 @@ func (ds *datastore) podResyncAll(ctx context.Context, reader client.Reader) err
 		endpointName := ep.GetMetadata().NamespacedName
 		if !activeEndpoints.Has(endpointName) {
 			logger.V(logutil.VERBOSE).Info("Removing endpoint", "endpoint", endpointName)
-			ds.pods.Delete(k)
-			ds.epf.ReleaseEndpoint(ep)
+			ds.removeEndpoint(k, ep)
 		}
 		return true
 	})
@@ -418,7 +475,7 @@ func (ds *datastore) podResyncAll(ctx context.Context, reader client.Reader) err
 func extractActivePorts(pod *corev1.Pod, targetPorts []int) sets.Set[int] {
 	allPorts := sets.New(targetPorts...)
 	annotations := pod.GetAnnotations()
-	portsAnnotation, ok := annotations[activePortsAnnotation]
+	portsAnnotation, ok := annotations[ActivePortsAnnotation]
 	if !ok {
 		return allPorts
 	}
@@ -435,6 +492,19 @@ func extractActivePorts(pod *corev1.Pod, targetPorts []int) sets.Set[int] {
 	return activePorts
 }
 
+// joinTime returns the time the pod became ready, or the current time if it is unknown or in the
+// future because of clock skew.
+func joinTime(pod *corev1.Pod) time.Time {
+	now := time.Now()
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue &&
+			!condition.LastTransitionTime.IsZero() && condition.LastTransitionTime.Time.Before(now) {
+			return condition.LastTransitionTime.Time
+		}
+	}
+	return now
+}
+
 // createEndpointNamespacedName creates a namespaced name for an endpoint based on pod and rank index.
 // This ensures consistent naming between PodUpdateOrAddIfNotExist and podResyncAll.
 func createEndpointNamespacedName(pod *corev1.Pod, idx int) types.NamespacedName {
@@ -42,6 +42,7 @@ import (
 	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/mocks"
 	pooltuil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/pool"
 	testutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/testing"
@@ -474,6 +475,113 @@ func TestPods(t *testing.T) {
 	}
 }
 
+// endpointEvents records the endpoint lifecycle notifications of the datastore.
+type endpointEvents struct {
+	draining []string
+	removed  []string
+}
+
+func (e *endpointEvents) TypedName() fwkplugin.TypedName {
+	return fwkplugin.TypedName{Type: "endpoint-events", Name: "endpoint-events"}
+}
+
+func (e *endpointEvents) EndpointDraining(_ context.Context, endpoint *fwkdl.EndpointMetadata) {
+	e.draining = append(e.draining, endpoint.NamespacedName.Name)
+}
+
+func (e *endpointEvents) EndpointRemoved(_ context.Context, endpoint *fwkdl.EndpointMetadata) {
+	e.removed = append(e.removed, endpoint.NamespacedName.Name)
+}
+
+func TestPodDrain(t *testing.T) {
+	ctx := t.Context()
+	labels := map[string]string{"app": "vllm"}
+	readyPod := testutil.MakePod("pod1").Labels(labels).ReadyCondition().IP("10.0.0.1").ObjRef()
+	terminatingPod := testutil.MakePod("pod2").Labels(labels).ReadyCondition().IP("10.0.0.2").DeletionTimestamp().ObjRef()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(readyPod, terminatingPod).Build()
+
+	epf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second)
+	ds := NewDatastore(ctx, epf, 0)
+	events := &endpointEvents{}
+	ds.SetEndpointListeners(events)
+	if err := ds.PoolSet(ctx, fakeClient, pooltuil.InferencePoolToEndpointPool(inferencePool)); err != nil {
+		t.Fatalf("PoolSet() failed: %v", err)
+	}
+	ds.PodUpdateOrAddIfNotExist(ctx, pod1)
+	ds.PodUpdateOrAddIfNotExist(ctx, pod2)
+
+	schedulablePods := func() []string {
+		var names []string
+		for _, ep := range ds.PodList(SchedulablePodsPredicate) {
+			names = append(names, ep.GetMetadata().PodName)
+		}
+		return names
+	}
+
+	assert.True(t, ds.PodDrain(pod2.Name), "first drain of pod2")
+	assert.False(t, ds.PodDrain(pod2.Name), "second drain of pod2")
+	assert.False(t, ds.PodDrain("unknown"), "drain of unknown pod")
+	assert.Len(t, ds.PodList(AllPodsPredicate), 2, "draining pods stay in the datastore")
+	assert.Equal(t, []string{pod1.Name}, schedulablePods())
+	assert.Equal(t, []string{pod2NamespacedName.Name}, events.draining)
+
+	// A resync keeps the endpoints of terminating pods draining, and removes the others.
+	selectorPool := pooltuil.InferencePoolToEndpointPool(inferencePool)
+	selectorPool.Selector = labels
+	if err := ds.PoolSet(ctx, fakeClient, selectorPool); err != nil {
+		t.Fatalf("PoolSet() failed: %v", err)
+	}
+	assert.Len(t, ds.PodList(AllPodsPredicate), 2, "pods after resync")
+	assert.Equal(t, []string{pod1.Name}, schedulablePods())
+	assert.Equal(t, []string{pod2NamespacedName.Name}, events.draining)
+	assert.Empty(t, events.removed)
+
+	ds.PodDelete(pod2.Name)
+	assert.Equal(t, []string{pod1.Name}, schedulablePods())
+	assert.Len(t, ds.PodList(AllPodsPredicate), 1, "pods after delete")
+	assert.Equal(t, []string{pod2NamespacedName.Name}, events.removed)
+}
+
+func TestEndpointJoinTime(t *testing.T) {
+	ctx := t.Context()
+	readySince := metav1.NewTime(time.Now().Add(-10 * time.Minute).Truncate(time.Second))
+	readyPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "ready"},
+		Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+			{Type: corev1.PodReady, Status: corev1.ConditionTrue, LastTransitionTime: readySince},
+		}},
+	}
+	period := time.Second
+	factories := []datalayer.EndpointFactory{
+		backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, period),
+		datalayer.NewEndpointFactory([]fwkdl.DataSource{&mocks.MetricsDataSource{}}, period),
+	}
+	for _, epf := range factories {
+		ds := NewDatastore(ctx, epf, 0)
+		if err := ds.PoolSet(ctx, fake.NewFakeClient(), pooltuil.InferencePoolToEndpointPool(inferencePool)); err != nil {
+			t.Fatalf("PoolSet() failed: %v", err)
+		}
+		before := time.Now()
+		ds.PodUpdateOrAddIfNotExist(ctx, readyPod)
+		ds.PodUpdateOrAddIfNotExist(ctx, pod1)
+
+		for _, ep := range ds.PodList(AllPodsPredicate) {
+			attribute, ok := ep.GetAttributes().Get(fwkdl.JoinTimeKey)
+			if !ok {
+				t.Fatalf("Endpoint %s has no join time", ep.GetMetadata().PodName)
+			}
+			joinTime := attribute.(*fwkdl.JoinTime).Time()
+			if ep.GetMetadata().PodName == readyPod.Name {
+				assert.Equal(t, readySince.Time, joinTime, "join time of a pod with a known ready time")
+			} else {
+				assert.False(t, joinTime.Before(before), "join time of a pod with an unknown ready time")
+			}
+		}
+	}
+}
+
 func TestTargetPortsChange(t *testing.T) {
 	// Create pods that are ready
 	readyPod1 := &corev1.Pod{
@@ -1182,7 +1290,7 @@ func TestExtractActivePorts(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        "test-pod",
 					Namespace:   "default",
-					Annotations: map[string]string{activePortsAnnotation: ""},
+					Annotations: map[string]string{ActivePortsAnnotation: ""},
 				},
 			},
 			validPorts:    []int{8000, 8001, 8002},
@@ -1194,7 +1302,7 @@ func TestExtractActivePorts(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        "test-pod",
 					Namespace:   "default",
-					Annotations: map[string]string{activePortsAnnotation: "8000"},
+					Annotations: map[string]string{ActivePortsAnnotation: "8000"},
 				},
 			},
 			validPorts:    []int{8000, 8001, 8002},
@@ -1206,7 +1314,7 @@ func TestExtractActivePorts(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        "test-pod",
 					Namespace:   "default",
-					Annotations: map[string]string{activePortsAnnotation: "8000,8001,8002"},
+					Annotations: map[string]string{ActivePortsAnnotation: "8000,8001,8002"},
 				},
 			},
 			validPorts:    []int{8000, 8001, 8002},
@@ -1218,7 +1326,7 @@ func TestExtractActivePorts(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        "test-pod",
 					Namespace:   "default",
-					Annotations: map[string]string{activePortsAnnotation: "8000, 8001 , 8002"},
+					Annotations: map[string]string{ActivePortsAnnotation: "8000, 8001 , 8002"},
 				},
 			},
 			validPorts:    []int{8000, 8001, 8002},
@@ -1230,7 +1338,7 @@ func TestExtractActivePorts(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        "test-pod",
 					Namespace:   "default",
-					Annotations: map[string]string{activePortsAnnotation: "8000,invalid,8002"},
+					Annotations: map[string]string{ActivePortsAnnotation: "8000,invalid,8002"},
 				},
 			},
 			validPorts:    []int{8000, 8001, 8002},
@@ -1242,7 +1350,7 @@ func TestExtractActivePorts(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        "test-pod",
 					Namespace:   "default",
-					Annotations: map[string]string{activePortsAnnotation: "8000,-1,8002"},
+					Annotations: map[string]string{ActivePortsAnnotation: "8000,-1,8002"},
 				},
 			},
 			validPorts:    []int{8000, 8001, 8002},
@@ -1254,7 +1362,7 @@ func TestExtractActivePorts(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        "test-pod",
 					Namespace:   "default",
-					Annotations: map[string]string{activePortsAnnotation: "8000,8001,8000"},
+					Annotations: map[string]string{ActivePortsAnnotation: "8000,8001,8000"},
 				},
 			},
 			validPorts:    []int{8000, 8001, 8002},
@@ -1266,7 +1374,7 @@ func TestExtractActivePorts(t *testing.T) {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        "test-pod",
 					Namespace:   "default",
-					Annotations: map[string]string{activePortsAnnotation: "8000,9000"},
+					Annotations: map[string]string{ActivePortsAnnotation: "8000,9000"},
 				},
 			},
 			validPorts:    []int{8000, 8001, 8002},
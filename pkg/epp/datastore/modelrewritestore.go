@@ -17,69 +17,97 @@ limitations under the License.
 package datastore
 
 import (
+	"fmt"
+	"regexp"
 	"sort"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/sets"
 
 	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	requtil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
 )
 
 // modelRewriteStore encapsulates the logic for storing and retrieving
 // InferenceModelRewrite rules, handling precedence correctly. This struct is not
 // thread-safe; concurrency must be managed by its consumer.
+//
+// Each match of a rule is stored separately, in the group of its model match type,
+// so that a rule with several matches is found through any of them.
 type modelRewriteStore struct {
 	genericRules           []*rewriteRuleWithMetadata
 	rulesByExactModelMatch map[string][]*rewriteRuleWithMetadata
+	prefixRules            []*rewriteRuleWithMetadata
+	regexRules             []*rewriteRuleWithMetadata
 	allReWrites            map[string]*v1alpha2.InferenceModelRewrite
 }
 
 func newModelRewriteStore() *modelRewriteStore {
 	return &modelRewriteStore{
 		genericRules:           []*rewriteRuleWithMetadata{},
-		rulesByExactModelMatch: map[string][]*rewriteRuleWithMetadata{},      // Key is the exact model name.
+		rulesByExactModelMatch: map[string][]*rewriteRuleWithMetadata{}, // Key is the exact model name.
+		prefixRules:            []*rewriteRuleWithMetadata{},
+		regexRules:             []*rewriteRuleWithMetadata{},
 		allReWrites:            map[string]*v1alpha2.InferenceModelRewrite{}, // Key is the rewrites name.
 	}
 }
 
 // set adds or updates an InferenceModelRewrite in the store. It deconstructs the
 // object into individual rules and stores them in the appropriate data structures,
-// ensuring they remain sorted by precedence.
-func (ms *modelRewriteStore) set(infModelRewrite *v1alpha2.InferenceModelRewrite) {
+// ensuring they remain sorted by precedence. A rewrite with an invalid match, such as
+// an invalid regular expression, is rejected as a whole and leaves the store unchanged.
+func (ms *modelRewriteStore) set(infModelRewrite *v1alpha2.InferenceModelRewrite) error {
 	name := infModelRewrite.Name
+	// The rules are compiled before the store is changed, so that an invalid rewrite does not replace a valid one.
+	rules := []*rewriteRuleWithMetadata{}
+	for i := range infModelRewrite.Spec.Rules {
+		rule := &infModelRewrite.Spec.Rules[i]
+		if len(rule.Matches) == 0 {
+			rules = append(rules, &rewriteRuleWithMetadata{
+				rule:              rule,
+				createTimestamp:   infModelRewrite.CreationTimestamp.Time,
+				parentRewriteName: name,
+			})
+			continue
+		}
+		for j := range rule.Matches {
+			ruleWithMetadata, err := newRewriteRuleWithMetadata(rule, &rule.Matches[j], infModelRewrite)
+			if err != nil {
+				return fmt.Errorf("invalid match %d of rule %d of InferenceModelRewrite %s: %w", j, i, name, err)
+			}
+			rules = append(rules, ruleWithMetadata)
+		}
+	}
+
 	// If the rewrite object already exists, remove its old rules before adding new ones.
 	if _, ok := ms.allReWrites[name]; ok {
-		ms.deleteInternal(infModelRewrite.Name)
+		ms.deleteInternal(name)
 	}
 	ms.allReWrites[name] = infModelRewrite
 
-	for i := range infModelRewrite.Spec.Rules {
-		ruleWithMetadata := &rewriteRuleWithMetadata{
-			rule:              infModelRewrite.Spec.Rules[i],
-			createTimestamp:   infModelRewrite.CreationTimestamp.Time,
-			parentRewriteName: name,
-		}
-
-		if ruleWithMetadata.isGeneric() {
+	for _, ruleWithMetadata := range rules {
+		switch {
+		case ruleWithMetadata.model == nil:
 			ms.genericRules = append(ms.genericRules, ruleWithMetadata)
-		} else {
-			for model := range ruleWithMetadata.exactModels() {
-				ms.rulesByExactModelMatch[model] = append(ms.rulesByExactModelMatch[model], ruleWithMetadata)
-			}
+		case ruleWithMetadata.model.matchType == v1alpha2.MatchPrefix:
+			ms.prefixRules = append(ms.prefixRules, ruleWithMetadata)
+		case ruleWithMetadata.model.matchType == v1alpha2.MatchRegularExpression:
+			ms.regexRules = append(ms.regexRules, ruleWithMetadata)
+		default:
+			model := ruleWithMetadata.model.value
+			ms.rulesByExactModelMatch[model] = append(ms.rulesByExactModelMatch[model], ruleWithMetadata)
 		}
 	}
 
-	// Sort all rule lists by timestamp to maintain precedence.
-	sort.Slice(ms.genericRules, func(i, j int) bool {
-		return ms.genericRules[i].createTimestamp.Before(ms.genericRules[j].createTimestamp)
-	})
-
+	// Sort all rule lists to maintain precedence.
+	sortByPrecedence(ms.genericRules)
+	sortByPrecedence(ms.prefixRules)
+	sortByPrecedence(ms.regexRules)
 	for model := range ms.rulesByExactModelMatch {
-		sort.Slice(ms.rulesByExactModelMatch[model], func(i, j int) bool {
-			return ms.rulesByExactModelMatch[model][i].createTimestamp.Before(ms.rulesByExactModelMatch[model][j].createTimestamp)
-		})
+		sortByPrecedence(ms.rulesByExactModelMatch[model])
 	}
+	return nil
 }
 
 // delete removes an InferenceModelRewrite and all its associated rules from the store.
@@ -94,24 +122,12 @@ func (ms *modelRewriteStore) deleteInternal(n string) {
 	}
 	delete(ms.allReWrites, n)
 
-	// Filter out the generic rules associated with the deleted rewrite.
-	newGenericRules := make([]*rewriteRuleWithMetadata, 0, len(ms.genericRules))
-	for _, ruleWithMd := range ms.genericRules {
-		if ruleWithMd.parentName() != n {
-			newGenericRules = append(newGenericRules, ruleWithMd)
-		}
-	}
-	ms.genericRules = newGenericRules
-
-	// Filter out the exact-match rules associated with the deleted rewrite.
+	// Filter out the rules associated with the deleted rewrite.
+	ms.genericRules = withoutParent(ms.genericRules, n)
+	ms.prefixRules = withoutParent(ms.prefixRules, n)
+	ms.regexRules = withoutParent(ms.regexRules, n)
 	for modelName, rulesWithMd := range ms.rulesByExactModelMatch {
-		newRules := make([]*rewriteRuleWithMetadata, 0, len(rulesWithMd))
-		for _, r := range rulesWithMd {
-			if r.parentName() != n {
-				newRules = append(newRules, r)
-			}
-		}
-
+		newRules := withoutParent(rulesWithMd, n)
 		if len(newRules) == 0 {
 			delete(ms.rulesByExactModelMatch, modelName)
 		} else {
@@ -120,18 +136,23 @@ func (ms *modelRewriteStore) deleteInternal(n string) {
 	}
 }
 
-// getRule returns the single, highest-precedence rule for a given model name.
-// It prioritizes exact matches over generic ones, and among those, the oldest rule wins.
-// It also returns the name of the InferenceModelRewrite resource that provided the rule.
-func (ms *modelRewriteStore) getRule(modelName string) (*v1alpha2.InferenceModelRewriteRule, string) {
-	// Exact matches have the highest precedence.
-	if rulesWithMd, ok := ms.rulesByExactModelMatch[modelName]; ok && len(rulesWithMd) > 0 {
-		return &rulesWithMd[0].rule, rulesWithMd[0].parentName() // The list is pre-sorted, so the first element is the oldest.
-	}
-
-	// If no exact match, fall back to the oldest generic rule.
-	if len(ms.genericRules) > 0 {
-		return &ms.genericRules[0].rule, ms.genericRules[0].parentName() // The list is pre-sorted.
+// getRule returns the single, highest-precedence rule for a given model name and request headers.
+// It prioritizes exact model matches, then prefix, then regular expression model matches, and
+// finally matches without a model match and generic rules. See sortByPrecedence for the order
+// within each group. It also returns the name of the InferenceModelRewrite resource that provided
+// the rule.
+func (ms *modelRewriteStore) getRule(modelName string, headers map[string]string) (*v1alpha2.InferenceModelRewriteRule, string) {
+	for _, rulesWithMd := range [][]*rewriteRuleWithMetadata{
+		ms.rulesByExactModelMatch[modelName],
+		ms.prefixRules,
+		ms.regexRules,
+		ms.genericRules,
+	} {
+		for _, ruleWithMd := range rulesWithMd { // The lists are pre-sorted.
+			if ruleWithMd.matches(modelName, headers) {
+				return ruleWithMd.rule, ruleWithMd.parentName()
+			}
+		}
 	}
 	return nil, ""
 }
@@ -145,28 +166,127 @@ func (ms *modelRewriteStore) getAll() []*v1alpha2.InferenceModelRewrite {
 	return rewrites
 }
 
-// rewriteRuleWithMetadata decorates a rule with metadata from its parent object
-// to be used in precedence sorting.
+// sortByPrecedence sorts rules by the length of their prefix model match (longest first), then
+// puts rules with header matches before rules without them, and finally sorts by timestamp
+// (oldest first). The sort is stable, so that rules of the same resource keep their list order.
+func sortByPrecedence(rules []*rewriteRuleWithMetadata) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		if li, lj := rules[i].prefixLen(), rules[j].prefixLen(); li != lj {
+			return li > lj
+		}
+		if hi, hj := len(rules[i].headers) > 0, len(rules[j].headers) > 0; hi != hj {
+			return hi
+		}
+		return rules[i].createTimestamp.Before(rules[j].createTimestamp)
+	})
+}
+
+func withoutParent(rules []*rewriteRuleWithMetadata, n string) []*rewriteRuleWithMetadata {
+	newRules := make([]*rewriteRuleWithMetadata, 0, len(rules))
+	for _, ruleWithMd := range rules {
+		if ruleWithMd.parentName() != n {
+			newRules = append(newRules, ruleWithMd)
+		}
+	}
+	return newRules
+}
+
+// rewriteRuleWithMetadata decorates a rule with one of its matches, compiled, and with
+// metadata from its parent object to be used in precedence sorting. The model and headers
+// are empty for generic rules.
 type rewriteRuleWithMetadata struct {
-	rule              v1alpha2.InferenceModelRewriteRule
+	rule              *v1alpha2.InferenceModelRewriteRule
+	model             *stringMatcher
+	headers           []headerMatcher
 	createTimestamp   time.Time
 	parentRewriteName string
 }
 
-func (rr rewriteRuleWithMetadata) isGeneric() bool {
-	return len(rr.rule.Matches) == 0
+func newRewriteRuleWithMetadata(rule *v1alpha2.InferenceModelRewriteRule, match *v1alpha2.Match,
+	infModelRewrite *v1alpha2.InferenceModelRewrite) (*rewriteRuleWithMetadata, error) {
+	ruleWithMetadata := &rewriteRuleWithMetadata{
+		rule:              rule,
+		createTimestamp:   infModelRewrite.CreationTimestamp.Time,
+		parentRewriteName: infModelRewrite.Name,
+	}
+	if match.Model != nil {
+		model, err := newStringMatcher(match.Model.Type, match.Model.Value)
+		if err != nil {
+			return nil, err
+		}
+		ruleWithMetadata.model = model
+	}
+	for _, header := range match.Headers {
+		value, err := newStringMatcher(header.Type, header.Value)
+		if err != nil {
+			return nil, err
+		}
+		ruleWithMetadata.headers = append(ruleWithMetadata.headers, headerMatcher{name: header.Name, value: value})
+	}
+	return ruleWithMetadata, nil
 }
 
-func (rr rewriteRuleWithMetadata) exactModels() sets.Set[string] {
-	modelSet := sets.New[string]()
-	for _, match := range rr.rule.Matches {
-		if match.Model != nil {
-			modelSet.Insert(match.Model.Value)
+// matches reports whether the model name and the headers satisfy the match of the rule.
+func (rr rewriteRuleWithMetadata) matches(modelName string, headers map[string]string) bool {
+	if rr.model != nil && !rr.model.matches(modelName) {
+		return false
+	}
+	for _, header := range rr.headers {
+		value, ok := requtil.HeaderValue(headers, header.name)
+		if !ok || !header.value.matches(value) {
+			return false
 		}
 	}
-	return modelSet
+	return true
+}
+
+func (rr rewriteRuleWithMetadata) prefixLen() int {
+	if rr.model == nil || rr.model.matchType != v1alpha2.MatchPrefix {
+		return 0
+	}
+	return len(rr.model.value)
 }
 
 func (rr rewriteRuleWithMetadata) parentName() string {
 	return rr.parentRewriteName
 }
+
+// stringMatcher matches strings according to a MatchValidationType.
+type stringMatcher struct {
+	matchType v1alpha2.MatchValidationType
+	value     string
+	regex     *regexp.Regexp
+}
+
+func newStringMatcher(matchType *v1alpha2.MatchValidationType, value string) (*stringMatcher, error) {
+	m := &stringMatcher{matchType: v1alpha2.MatchExact, value: value}
+	if matchType != nil && *matchType != "" {
+		m.matchType = *matchType
+	}
+	if m.matchType == v1alpha2.MatchRegularExpression {
+		// The regular expression must match the whole string.
+		regex, err := regexp.Compile("^(?:" + value + ")$")
+		if err != nil {
+			return nil, err
+		}
+		m.regex = regex
+	}
+	return m, nil
+}
+
+func (m *stringMatcher) matches(s string) bool {
+	switch m.matchType {
+	case v1alpha2.MatchPrefix:
+		return strings.HasPrefix(s, m.value)
+	case v1alpha2.MatchRegularExpression:
+		return m.regex.MatchString(s)
+	default:
+		return s == m.value
+	}
+}
+
+// headerMatcher matches the value of a request header.
+type headerMatcher struct {
+	name  string
+	value *stringMatcher
+}
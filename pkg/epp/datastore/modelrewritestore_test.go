@@ -17,6 +17,7 @@ limitations under the License.
 package datastore
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -62,6 +63,47 @@ func TestModelRewriteStore(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "rewrite-generic-new", Namespace: "default", CreationTimestamp: metav1.NewTime(now)},
 		Spec:       v1alpha2.InferenceModelRewriteSpec{Rules: []v1alpha2.InferenceModelRewriteRule{{Targets: []v1alpha2.TargetModel{{ModelRewrite: "new-generic"}}}}},
 	}
+	prefix := v1alpha2.MatchPrefix
+	regex := v1alpha2.MatchRegularExpression
+	rulePrefix := v1alpha2.InferenceModelRewriteRule{
+		Matches: []v1alpha2.Match{{Model: &v1alpha2.ModelMatch{Type: &prefix, Value: "model"}}},
+		Targets: []v1alpha2.TargetModel{{ModelRewrite: "prefix"}},
+	}
+	ruleLongerPrefix := v1alpha2.InferenceModelRewriteRule{
+		Matches: []v1alpha2.Match{{Model: &v1alpha2.ModelMatch{Type: &prefix, Value: "model1"}}},
+		Targets: []v1alpha2.TargetModel{{ModelRewrite: "longer-prefix"}},
+	}
+	ruleRegex := v1alpha2.InferenceModelRewriteRule{
+		Matches: []v1alpha2.Match{{Model: &v1alpha2.ModelMatch{Type: &regex, Value: "llama-[0-9]+b"}}},
+		Targets: []v1alpha2.TargetModel{{ModelRewrite: "regex"}},
+	}
+	ruleModel1Cohort := v1alpha2.InferenceModelRewriteRule{
+		Matches: []v1alpha2.Match{{
+			Model:   &v1alpha2.ModelMatch{Value: "model1"},
+			Headers: []v1alpha2.HeaderMatch{{Name: "X-Cohort", Value: "b"}, {Name: "x-tenant", Type: &prefix, Value: "team-"}},
+		}},
+		Targets: []v1alpha2.TargetModel{{ModelRewrite: "model1-cohort-b"}},
+	}
+	ruleTenant := v1alpha2.InferenceModelRewriteRule{
+		Matches: []v1alpha2.Match{{Headers: []v1alpha2.HeaderMatch{{Name: "x-tenant", Type: &regex, Value: "team-(a|b)"}}}},
+		Targets: []v1alpha2.TargetModel{{ModelRewrite: "tenant"}},
+	}
+	rewritePrefix := &v1alpha2.InferenceModelRewrite{
+		ObjectMeta: metav1.ObjectMeta{Name: "rewrite-prefix", Namespace: "default", CreationTimestamp: metav1.NewTime(oneMinuteAgo)},
+		Spec:       v1alpha2.InferenceModelRewriteSpec{Rules: []v1alpha2.InferenceModelRewriteRule{rulePrefix}},
+	}
+	rewriteLongerPrefix := &v1alpha2.InferenceModelRewrite{
+		ObjectMeta: metav1.ObjectMeta{Name: "rewrite-longer-prefix", Namespace: "default", CreationTimestamp: metav1.NewTime(now)},
+		Spec:       v1alpha2.InferenceModelRewriteSpec{Rules: []v1alpha2.InferenceModelRewriteRule{ruleLongerPrefix}},
+	}
+	rewriteRegex := &v1alpha2.InferenceModelRewrite{
+		ObjectMeta: metav1.ObjectMeta{Name: "rewrite-regex", Namespace: "default", CreationTimestamp: metav1.NewTime(oneMinuteAgo)},
+		Spec:       v1alpha2.InferenceModelRewriteSpec{Rules: []v1alpha2.InferenceModelRewriteRule{ruleRegex}},
+	}
+	rewriteHeaders := &v1alpha2.InferenceModelRewrite{
+		ObjectMeta: metav1.ObjectMeta{Name: "rewrite-headers", Namespace: "default", CreationTimestamp: metav1.NewTime(now)},
+		Spec:       v1alpha2.InferenceModelRewriteSpec{Rules: []v1alpha2.InferenceModelRewriteRule{ruleModel1Cohort, ruleTenant}},
+	}
 	rewriteUpdated := &v1alpha2.InferenceModelRewrite{
 		ObjectMeta: metav1.ObjectMeta{Name: "rewrite-old", Namespace: "default", CreationTimestamp: metav1.NewTime(now)}, // Same name as rewriteOld
 		Spec:       v1alpha2.InferenceModelRewriteSpec{Rules: []v1alpha2.InferenceModelRewriteRule{ruleModel1V2}},
@@ -72,6 +114,7 @@ func TestModelRewriteStore(t *testing.T) {
 		initialState []*v1alpha2.InferenceModelRewrite
 		op           func(store *modelRewriteStore)
 		modelToGet   string
+		headers      map[string]string
 		wantRule     *v1alpha2.InferenceModelRewriteRule
 		wantName     string
 		wantGetAll   []*v1alpha2.InferenceModelRewrite
@@ -165,20 +208,93 @@ func TestModelRewriteStore(t *testing.T) {
 			wantName:   rewriteUpdated.Name,
 			wantGetAll: []*v1alpha2.InferenceModelRewrite{rewriteUpdated},
 		},
+		{
+			name:         "Precedence: Exact match wins over prefix and regex",
+			initialState: []*v1alpha2.InferenceModelRewrite{rewritePrefix, rewriteRegex, rewriteOld},
+			modelToGet:   "model1",
+			wantRule:     &ruleModel1V1,
+			wantName:     rewriteOld.Name,
+		},
+		{
+			name:         "Precedence: Longest prefix wins",
+			initialState: []*v1alpha2.InferenceModelRewrite{rewritePrefix, rewriteLongerPrefix},
+			modelToGet:   "model1-instruct",
+			wantRule:     &ruleLongerPrefix,
+			wantName:     rewriteLongerPrefix.Name,
+		},
+		{
+			name:         "Prefix match",
+			initialState: []*v1alpha2.InferenceModelRewrite{rewritePrefix, rewriteLongerPrefix, rewriteGenericOld},
+			modelToGet:   "model2",
+			wantRule:     &rulePrefix,
+			wantName:     rewritePrefix.Name,
+		},
+		{
+			name:         "Regex match wins over generic",
+			initialState: []*v1alpha2.InferenceModelRewrite{rewriteRegex, rewriteGenericOld},
+			modelToGet:   "llama-70b",
+			wantRule:     &ruleRegex,
+			wantName:     rewriteRegex.Name,
+		},
+		{
+			name:         "Regex must match the whole model name",
+			initialState: []*v1alpha2.InferenceModelRewrite{rewriteRegex, rewriteGenericOld},
+			modelToGet:   "llama-70b-instruct",
+			wantRule:     &ruleGeneric,
+			wantName:     rewriteGenericOld.Name,
+		},
+		{
+			name:         "Precedence: Header match wins over an older exact match",
+			initialState: []*v1alpha2.InferenceModelRewrite{rewriteOld, rewriteHeaders},
+			modelToGet:   "model1",
+			headers:      map[string]string{"x-cohort": "b", "X-Tenant": "team-a"},
+			wantRule:     &ruleModel1Cohort,
+			wantName:     rewriteHeaders.Name,
+		},
+		{
+			name:         "All header matches must be satisfied",
+			initialState: []*v1alpha2.InferenceModelRewrite{rewriteOld, rewriteHeaders},
+			modelToGet:   "model1",
+			headers:      map[string]string{"x-cohort": "b"},
+			wantRule:     &ruleModel1V1,
+			wantName:     rewriteOld.Name,
+		},
+		{
+			name:         "Precedence: Header-only match wins over an older generic match",
+			initialState: []*v1alpha2.InferenceModelRewrite{rewriteGenericOld, rewriteHeaders},
+			modelToGet:   "model2",
+			headers:      map[string]string{"x-tenant": "team-b"},
+			wantRule:     &ruleTenant,
+			wantName:     rewriteHeaders.Name,
+		},
+		{
+			name:         "Delete: removes prefix and regex rules",
+			initialState: []*v1alpha2.InferenceModelRewrite{rewritePrefix, rewriteRegex},
+			op: func(store *modelRewriteStore) {
+				store.delete(types.NamespacedName{Namespace: rewritePrefix.Namespace, Name: rewritePrefix.Name})
+				store.delete(types.NamespacedName{Namespace: rewriteRegex.Namespace, Name: rewriteRegex.Name})
+			},
+			modelToGet: "model1",
+			wantRule:   nil,
+			wantName:   "",
+			wantGetAll: []*v1alpha2.InferenceModelRewrite{},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			store := newModelRewriteStore()
 			for _, r := range tc.initialState {
-				store.set(r)
+				if err := store.set(r); err != nil {
+					t.Fatalf("set() returned an unexpected error: %v", err)
+				}
 			}
 
 			if tc.op != nil {
 				tc.op(store)
 			}
 
-			gotRule, gotName := store.getRule(tc.modelToGet)
+			gotRule, gotName := store.getRule(tc.modelToGet, tc.headers)
 			if diff := cmp.Diff(tc.wantRule, gotRule); diff != "" {
 				t.Errorf("GetRule() mismatch (-want +got):\n%s", diff)
 			}
@@ -197,3 +313,60 @@ func TestModelRewriteStore(t *testing.T) {
 		})
 	}
 }
+
+func TestModelRewriteStoreRejectsInvalidMatches(t *testing.T) {
+	regex := v1alpha2.MatchRegularExpression
+	valid := &v1alpha2.InferenceModelRewrite{
+		ObjectMeta: metav1.ObjectMeta{Name: "rewrite", Namespace: "default"},
+		Spec: v1alpha2.InferenceModelRewriteSpec{Rules: []v1alpha2.InferenceModelRewriteRule{{
+			Matches: []v1alpha2.Match{{Model: &v1alpha2.ModelMatch{Type: &regex, Value: "llama-[0-9]+b"}}},
+			Targets: []v1alpha2.TargetModel{{ModelRewrite: "valid"}},
+		}}},
+	}
+	tests := []struct {
+		name    string
+		matches []v1alpha2.Match
+		wantErr string
+	}{
+		{
+			name: "invalid model regular expression",
+			matches: []v1alpha2.Match{
+				{Model: &v1alpha2.ModelMatch{Value: "model1"}},
+				{Model: &v1alpha2.ModelMatch{Type: &regex, Value: "llama-(["}},
+			},
+			wantErr: "invalid match 1 of rule 0 of InferenceModelRewrite rewrite",
+		},
+		{
+			name:    "invalid header regular expression",
+			matches: []v1alpha2.Match{{Headers: []v1alpha2.HeaderMatch{{Name: "x-tenant", Type: &regex, Value: "team-("}}}},
+			wantErr: "invalid match 0 of rule 0 of InferenceModelRewrite rewrite",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := newModelRewriteStore()
+			if err := store.set(valid); err != nil {
+				t.Fatalf("set() returned an unexpected error: %v", err)
+			}
+			invalid := valid.DeepCopy()
+			invalid.Spec.Rules[0].Matches = tc.matches
+			invalid.Spec.Rules[0].Targets = []v1alpha2.TargetModel{{ModelRewrite: "invalid"}}
+
+			err := store.set(invalid)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("set() error = %v, want an error containing %q", err, tc.wantErr)
+			}
+			// The invalid update leaves the previous version of the rewrite in place.
+			if diff := cmp.Diff([]*v1alpha2.InferenceModelRewrite{valid}, store.getAll()); diff != "" {
+				t.Errorf("GetAll() mismatch (-want +got):\n%s", diff)
+			}
+			if gotRule, _ := store.getRule("model1", nil); gotRule != nil {
+				t.Errorf("GetRule() returned a rule of the rejected rewrite: %v", gotRule)
+			}
+			if gotRule, _ := store.getRule("llama-70b", nil); gotRule == nil || gotRule.Targets[0].ModelRewrite != "valid" {
+				t.Errorf("GetRule() = %v, want the rule of the previous version of the rewrite", gotRule)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+)
+
+// Resolver resolves DNS records. It is implemented by net.Resolver.
+type Resolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// DNSSource discovers the endpoints from the DNS SRV records of a name, which it resolves again
+// at a fixed interval. Each SRV record with the lowest priority is an endpoint, whose address is
+// the first IP address of the target of the record. Records with a higher priority are backups
+// and are not used. Weights are ignored, as the Endpoint Picker balances the load across the
+// endpoints itself.
+type DNSSource struct {
+	name     string
+	interval time.Duration
+	resolver Resolver
+}
+
+var _ Source = &DNSSource{}
+
+// NewDNSSource creates a Source resolving the SRV records of the given name, such as
+// "_http._tcp.vllm.example.com", every interval.
+func NewDNSSource(name string, interval time.Duration, resolver Resolver) *DNSSource {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	return &DNSSource{
+		name:     name,
+		interval: interval,
+		resolver: resolver,
+	}
+}
+
+// Endpoints implements Source.
+func (s *DNSSource) Endpoints(ctx context.Context) ([]Endpoint, error) {
+	_, records, err := s.resolver.LookupSRV(ctx, "", "", s.name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up SRV records of %q: %w", s.name, err)
+	}
+	var priority uint16
+	for i, record := range records {
+		if i == 0 || record.Priority < priority {
+			priority = record.Priority
+		}
+	}
+	endpoints := make([]Endpoint, 0, len(records))
+	for _, record := range records {
+		if record.Priority != priority {
+			continue
+		}
+		addrs, err := s.resolver.LookupIPAddr(ctx, record.Target)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up the address of %q: %w", record.Target, err)
+		}
+		if len(addrs) == 0 {
+			return nil, fmt.Errorf("no address found for %q", record.Target)
+		}
+		port := int32(record.Port)
+		endpoints = append(endpoints, Endpoint{
+			Name:    endpointName(record.Target, port),
+			Address: addrs[0].IP.String(),
+			Port:    port,
+		})
+	}
+	if err := validateEndpoints(endpoints); err != nil {
+		return nil, fmt.Errorf("invalid SRV records of %q: %w", s.name, err)
+	}
+	return endpoints, nil
+}
+
+// Changes implements Source.
+func (s *DNSSource) Changes(ctx context.Context) (<-chan struct{}, error) {
+	changes := make(chan struct{})
+	go func() {
+		defer close(changes)
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				select {
+				case changes <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return changes, nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type fakeResolver struct {
+	srv   map[string][]*net.SRV
+	hosts map[string][]net.IPAddr
+}
+
+func (r *fakeResolver) LookupSRV(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+	records, ok := r.srv[name]
+	if !ok {
+		return "", nil, errors.New("no such host")
+	}
+	return name, records, nil
+}
+
+func (r *fakeResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	addrs, ok := r.hosts[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return addrs, nil
+}
+
+func TestDNSSourceEndpoints(t *testing.T) {
+	resolver := &fakeResolver{
+		srv: map[string][]*net.SRV{
+			"_http._tcp.vllm.example.com": {
+				{Target: "gpu-0.example.com.", Port: 8000},
+				{Target: "gpu-1.example.com.", Port: 8001},
+			},
+			"_http._tcp.backup.example.com": {
+				{Target: "gpu-1.example.com.", Port: 8001, Priority: 20},
+				{Target: "gpu-0.example.com.", Port: 8000, Priority: 10, Weight: 1},
+				{Target: "gpu-0.example.com.", Port: 8002, Priority: 10, Weight: 5},
+			},
+			"_http._tcp.missing.example.com": {{Target: "missing.example.com.", Port: 8000}},
+			"_http._tcp.empty.example.com":   {},
+		},
+		hosts: map[string][]net.IPAddr{
+			"gpu-0.example.com.": {{IP: net.ParseIP("10.0.0.1")}, {IP: net.ParseIP("10.0.0.9")}},
+			"gpu-1.example.com.": {{IP: net.ParseIP("10.0.0.2")}},
+		},
+	}
+	tests := []struct {
+		name    string
+		srvName string
+		want    []Endpoint
+		wantErr bool
+	}{
+		{
+			name:    "records",
+			srvName: "_http._tcp.vllm.example.com",
+			want: []Endpoint{
+				{Name: "gpu-0-example-com-8000", Address: "10.0.0.1", Port: 8000},
+				{Name: "gpu-1-example-com-8001", Address: "10.0.0.2", Port: 8001},
+			},
+		},
+		{
+			name:    "backup records ignored",
+			srvName: "_http._tcp.backup.example.com",
+			want: []Endpoint{
+				{Name: "gpu-0-example-com-8000", Address: "10.0.0.1", Port: 8000},
+				{Name: "gpu-0-example-com-8002", Address: "10.0.0.1", Port: 8002},
+			},
+		},
+		{
+			name:    "no records",
+			srvName: "_http._tcp.empty.example.com",
+			want:    []Endpoint{},
+		},
+		{
+			name:    "unknown name",
+			srvName: "_http._tcp.unknown.example.com",
+			wantErr: true,
+		},
+		{
+			name:    "unresolvable target",
+			srvName: "_http._tcp.missing.example.com",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NewDNSSource(test.srvName, 0, resolver).Endpoints(context.Background())
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Endpoints() failed: %v", err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Endpoints() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package discovery discovers the model server endpoints of an Endpoint Picker that runs without
+// Kubernetes, from a static endpoint file or from DNS SRV records.
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+// Endpoint is a model server endpoint.
+type Endpoint struct {
+	// Name identifies the endpoint. It defaults to the address and port of the endpoint.
+	Name string `json:"name,omitempty"`
+	// Address is the IP address of the endpoint.
+	Address string `json:"address"`
+	// Port is the port the model server listens on.
+	Port int32 `json:"port"`
+	// Labels are the labels of the endpoint, as the labels of a Pod.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// EndpointFile is the format of the static endpoint file, in YAML or JSON.
+type EndpointFile struct {
+	Endpoints []Endpoint `json:"endpoints"`
+}
+
+// Source discovers the endpoints of the model servers.
+type Source interface {
+	// Endpoints returns the current endpoints.
+	Endpoints(ctx context.Context) ([]Endpoint, error)
+	// Changes returns a channel that receives a value whenever the endpoints may have changed. The
+	// channel is closed when the context is done.
+	Changes(ctx context.Context) (<-chan struct{}, error)
+}
+
+// LoadEndpointFile reads and validates the endpoints of a static endpoint file.
+func LoadEndpointFile(path string) ([]Endpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read endpoint file %q: %w", path, err)
+	}
+	file := &EndpointFile{}
+	if err := yaml.UnmarshalStrict(data, file); err != nil {
+		return nil, fmt.Errorf("failed to parse endpoint file %q: %w", path, err)
+	}
+	if err := validateEndpoints(file.Endpoints); err != nil {
+		return nil, fmt.Errorf("invalid endpoint file %q: %w", path, err)
+	}
+	return file.Endpoints, nil
+}
+
+// validateEndpoints validates the endpoints and defaults their names.
+func validateEndpoints(endpoints []Endpoint) error {
+	names := make(map[string]bool, len(endpoints))
+	for i := range endpoints {
+		ep := &endpoints[i]
+		if net.ParseIP(ep.Address) == nil {
+			return fmt.Errorf("endpoint %d: address %q is not an IP address", i, ep.Address)
+		}
+		if ep.Port < 1 || ep.Port > 65535 {
+			return fmt.Errorf("endpoint %d: invalid port %d", i, ep.Port)
+		}
+		if ep.Name == "" {
+			ep.Name = endpointName(ep.Address, ep.Port)
+		}
+		if errs := validation.IsDNS1123Subdomain(ep.Name); len(errs) > 0 {
+			return fmt.Errorf("endpoint %d: invalid name %q: %s", i, ep.Name, strings.Join(errs, ", "))
+		}
+		if names[ep.Name] {
+			return fmt.Errorf("endpoint %d: duplicate name %q", i, ep.Name)
+		}
+		names[ep.Name] = true
+		if errs := metav1validation.ValidateLabels(ep.Labels, field.NewPath("labels")); len(errs) > 0 {
+			return fmt.Errorf("endpoint %d: invalid labels: %w", i, errs.ToAggregate())
+		}
+	}
+	return nil
+}
+
+// endpointName returns the default name of the endpoint with the given host and port, such as
+// "10-0-0-1-8000".
+func endpointName(host string, port int32) string {
+	name := strings.NewReplacer(".", "-", ":", "-").Replace(strings.ToLower(strings.TrimSuffix(host, ".")))
+	return strings.Trim(name, "-") + "-" + strconv.Itoa(int(port))
+}
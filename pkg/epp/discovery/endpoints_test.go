@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoadEndpointFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []Endpoint
+		wantErr bool
+	}{
+		{
+			name: "YAML",
+			content: `
+endpoints:
+- name: gpu-0
+  address: 10.0.0.1
+  port: 8000
+  labels:
+    model: llama
+- address: 10.0.0.2
+  port: 8001
+`,
+			want: []Endpoint{
+				{Name: "gpu-0", Address: "10.0.0.1", Port: 8000, Labels: map[string]string{"model": "llama"}},
+				{Name: "10-0-0-2-8001", Address: "10.0.0.2", Port: 8001},
+			},
+		},
+		{
+			name:    "JSON",
+			content: `{"endpoints": [{"address": "fd00::1", "port": 8000}]}`,
+			want:    []Endpoint{{Name: "fd00--1-8000", Address: "fd00::1", Port: 8000}},
+		},
+		{
+			name:    "no endpoints",
+			content: `endpoints: []`,
+			want:    []Endpoint{},
+		},
+		{
+			name:    "unknown field",
+			content: `{"endpoints": [{"address": "10.0.0.1", "port": 8000, "weight": 1}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "host name address",
+			content: `{"endpoints": [{"address": "vllm.example.com", "port": 8000}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid port",
+			content: `{"endpoints": [{"address": "10.0.0.1", "port": 70000}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid name",
+			content: `{"endpoints": [{"name": "GPU_0", "address": "10.0.0.1", "port": 8000}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate name",
+			content: `{"endpoints": [{"address": "10.0.0.1", "port": 8000}, {"address": "10.0.0.1", "port": 8000}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid labels",
+			content: `{"endpoints": [{"address": "10.0.0.1", "port": 8000, "labels": {"model": "llama 3"}}]}`,
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "endpoints.yaml")
+			if err := os.WriteFile(path, []byte(test.content), 0o600); err != nil {
+				t.Fatalf("Failed to write endpoint file: %v", err)
+			}
+			got, err := LoadEndpointFile(path)
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("Expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadEndpointFile() failed: %v", err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("LoadEndpointFile() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoadEndpointFileMissing(t *testing.T) {
+	if _, err := LoadEndpointFile(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+)
+
+// debounceDelay is the time to wait for file events to settle before reloading the file.
+const debounceDelay = 250 * time.Millisecond
+
+// FileSource reads the endpoints from a static endpoint file, and watches the file for changes.
+type FileSource struct {
+	path string
+}
+
+var _ Source = &FileSource{}
+
+// NewFileSource creates a Source reading the endpoints from the given file.
+func NewFileSource(path string) *FileSource {
+	return &FileSource{path: path}
+}
+
+// Endpoints implements Source.
+func (s *FileSource) Endpoints(_ context.Context) ([]Endpoint, error) {
+	return LoadEndpointFile(s.path)
+}
+
+// Changes implements Source. The directory of the file is watched, rather than the file itself,
+// so that files replaced by a rename, as editors and ConfigMap volumes do, keep being watched.
+func (s *FileSource) Changes(ctx context.Context) (<-chan struct{}, error) {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create endpoint file watcher: %w", err)
+	}
+	if err := w.Add(filepath.Dir(s.path)); err != nil {
+		_ = w.Close()
+		return nil, fmt.Errorf("failed to watch %q: %w", s.path, err)
+	}
+
+	logger := log.FromContext(ctx).WithValues("path", s.path)
+	changes := make(chan struct{}, 1)
+	go func() {
+		defer close(changes)
+		defer w.Close()
+
+		debounce := time.NewTimer(debounceDelay)
+		debounce.Stop()
+		for {
+			select {
+			case ev := <-w.Events:
+				logger.V(logutil.TRACE).Info("Endpoint file directory changed", "event", ev)
+				// Reset the timer so that a burst of events triggers a single reload.
+				debounce.Reset(debounceDelay)
+			case <-debounce.C:
+				select {
+				case changes <- struct{}{}:
+				default: // A reload is already pending.
+				}
+			case err := <-w.Errors:
+				if err != nil {
+					logger.Error(err, "Endpoint file watcher failed")
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return changes, nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+)
+
+// Syncer feeds the endpoints discovered by a Source into a datastore. Each endpoint is stored as a
+// ready Pod of the pool, so that it goes through the same endpoint lifecycle as the Pods watched
+// through Kubernetes. The target ports of the pool are the ports of the endpoints.
+type Syncer struct {
+	datastore datastore.Datastore
+	source    Source
+	pool      types.NamespacedName
+	// names are the names of the endpoints currently in the datastore.
+	names sets.Set[string]
+}
+
+var _ manager.LeaderElectionRunnable = &Syncer{}
+
+// NewSyncer creates a Syncer storing the endpoints of the source as the given pool of the datastore.
+func NewSyncer(ds datastore.Datastore, source Source, pool types.NamespacedName) *Syncer {
+	return &Syncer{
+		datastore: ds,
+		source:    source,
+		pool:      pool,
+		names:     sets.New[string](),
+	}
+}
+
+// Start implements manager.Runnable. It fails if the endpoints can not be discovered initially,
+// while later failures are logged and the last discovered endpoints are kept.
+func (s *Syncer) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("endpoint-discovery")
+	ctx = log.IntoContext(ctx, logger)
+
+	changes, err := s.source.Changes(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.Sync(ctx); err != nil {
+		return err
+	}
+	for range changes {
+		if err := s.Sync(ctx); err != nil {
+			logger.Error(err, "Failed to discover endpoints, keeping the current endpoints")
+		}
+	}
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Every replica serves requests, so
+// every replica discovers the endpoints.
+func (s *Syncer) NeedLeaderElection() bool {
+	return false
+}
+
+// Sync discovers the endpoints and updates the datastore.
+func (s *Syncer) Sync(ctx context.Context) error {
+	endpoints, err := s.source.Endpoints(ctx)
+	if err != nil {
+		return err
+	}
+
+	pods := make([]corev1.Pod, 0, len(endpoints))
+	ports := sets.New[int]()
+	for _, ep := range endpoints {
+		pods = append(pods, s.pod(ep))
+		ports.Insert(int(ep.Port))
+	}
+	pool := datalayer.NewEndpointPool(s.pool.Namespace, s.pool.Name)
+	pool.TargetPorts = sets.List(ports)
+	// Setting the pool resyncs all the endpoints when the target ports change.
+	if err := s.datastore.PoolSet(ctx, podLister(pods), pool); err != nil {
+		return fmt.Errorf("failed to set the endpoint pool: %w", err)
+	}
+
+	logger := log.FromContext(ctx)
+	names := sets.New[string]()
+	for i := range pods {
+		names.Insert(pods[i].Name)
+		if !s.datastore.PodUpdateOrAddIfNotExist(ctx, &pods[i]) {
+			logger.V(logutil.DEFAULT).Info("Endpoint added", "name", pods[i].Name, "address", pods[i].Status.PodIP)
+		}
+	}
+	for name := range s.names.Difference(names) {
+		s.datastore.PodDelete(name)
+		logger.V(logutil.DEFAULT).Info("Endpoint removed", "name", name)
+	}
+	s.names = names
+	return nil
+}
+
+// pod returns the ready Pod standing for the endpoint. Only the port of the endpoint is active
+// among the target ports of the pool.
+func (s *Syncer) pod(ep Endpoint) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ep.Name,
+			Namespace:   s.pool.Namespace,
+			Labels:      ep.Labels,
+			Annotations: map[string]string{datastore.ActivePortsAnnotation: strconv.Itoa(int(ep.Port))},
+		},
+		Status: corev1.PodStatus{
+			PodIP:      ep.Address,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+// podLister is a client.Reader serving the Pods standing for the discovered endpoints, with which
+// the datastore resyncs the pool.
+type podLister []corev1.Pod
+
+var _ client.Reader = podLister(nil)
+
+func (l podLister) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return fmt.Errorf("unexpected object type %T", obj)
+	}
+	for i := range l {
+		if l[i].Name == key.Name && l[i].Namespace == key.Namespace {
+			l[i].DeepCopyInto(pod)
+			return nil
+		}
+	}
+	return apierrors.NewNotFound(corev1.Resource("pods"), key.Name)
+}
+
+func (l podLister) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	podList, ok := list.(*corev1.PodList)
+	if !ok {
+		return fmt.Errorf("unexpected list type %T", list)
+	}
+	podList.Items = make([]corev1.Pod, 0, len(l))
+	for i := range l {
+		podList.Items = append(podList.Items, *l[i].DeepCopy())
+	}
+	return nil
+}
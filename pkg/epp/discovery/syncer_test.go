@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/types"
+
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+)
+
+type fakeSource struct {
+	endpoints []Endpoint
+	err       error
+}
+
+func (s *fakeSource) Endpoints(_ context.Context) ([]Endpoint, error) {
+	return s.endpoints, s.err
+}
+
+func (s *fakeSource) Changes(_ context.Context) (<-chan struct{}, error) {
+	return make(chan struct{}), nil
+}
+
+// storedEndpoint is the part of a datastore endpoint set by the discovery.
+type storedEndpoint struct {
+	Name    string
+	PodName string
+	Address string
+	Port    string
+	Labels  map[string]string
+}
+
+func storedEndpoints(ds datastore.Datastore) []storedEndpoint {
+	var endpoints []storedEndpoint
+	for _, ep := range ds.PodList(datastore.AllPodsPredicate) {
+		metadata := ep.GetMetadata()
+		endpoints = append(endpoints, storedEndpoint{
+			Name:    metadata.NamespacedName.Name,
+			PodName: metadata.PodName,
+			Address: metadata.Address,
+			Port:    metadata.Port,
+			Labels:  metadata.Labels,
+		})
+	}
+	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].Name < endpoints[j].Name })
+	return endpoints
+}
+
+func TestSyncer(t *testing.T) {
+	ctx := t.Context()
+	epf := backendmetrics.NewPodMetricsFactory(&backendmetrics.FakePodMetricsClient{}, time.Second)
+	ds := datastore.NewDatastore(ctx, epf, 0)
+	source := &fakeSource{}
+	syncer := NewSyncer(ds, source, types.NamespacedName{Namespace: "default", Name: "epp"})
+
+	steps := []struct {
+		name      string
+		endpoints []Endpoint
+		err       error
+		want      []storedEndpoint
+		wantErr   bool
+	}{
+		{
+			name: "initial endpoints",
+			endpoints: []Endpoint{
+				{Name: "gpu-0", Address: "10.0.0.1", Port: 8000, Labels: map[string]string{"model": "llama"}},
+				{Name: "gpu-1", Address: "10.0.0.2", Port: 8000},
+			},
+			want: []storedEndpoint{
+				{Name: "gpu-0-rank-0", PodName: "gpu-0", Address: "10.0.0.1", Port: "8000", Labels: map[string]string{"model": "llama"}},
+				{Name: "gpu-1-rank-0", PodName: "gpu-1", Address: "10.0.0.2", Port: "8000", Labels: map[string]string{}},
+			},
+		},
+		{
+			name: "endpoint added on another port and endpoint removed",
+			endpoints: []Endpoint{
+				{Name: "gpu-0", Address: "10.0.0.1", Port: 8000, Labels: map[string]string{"model": "llama"}},
+				{Name: "gpu-2", Address: "10.0.0.3", Port: 9000},
+			},
+			want: []storedEndpoint{
+				{Name: "gpu-0-rank-0", PodName: "gpu-0", Address: "10.0.0.1", Port: "8000", Labels: map[string]string{"model": "llama"}},
+				{Name: "gpu-2-rank-1", PodName: "gpu-2", Address: "10.0.0.3", Port: "9000", Labels: map[string]string{}},
+			},
+		},
+		{
+			name: "endpoint updated",
+			endpoints: []Endpoint{
+				{Name: "gpu-0", Address: "10.0.0.1", Port: 8000, Labels: map[string]string{"model": "qwen"}},
+				{Name: "gpu-2", Address: "10.0.0.3", Port: 9000},
+			},
+			want: []storedEndpoint{
+				{Name: "gpu-0-rank-0", PodName: "gpu-0", Address: "10.0.0.1", Port: "8000", Labels: map[string]string{"model": "qwen"}},
+				{Name: "gpu-2-rank-1", PodName: "gpu-2", Address: "10.0.0.3", Port: "9000", Labels: map[string]string{}},
+			},
+		},
+		{
+			name:    "discovery failure keeps the endpoints",
+			err:     errors.New("no such host"),
+			wantErr: true,
+			want: []storedEndpoint{
+				{Name: "gpu-0-rank-0", PodName: "gpu-0", Address: "10.0.0.1", Port: "8000", Labels: map[string]string{"model": "qwen"}},
+				{Name: "gpu-2-rank-1", PodName: "gpu-2", Address: "10.0.0.3", Port: "9000", Labels: map[string]string{}},
+			},
+		},
+		{
+			name:      "all endpoints removed",
+			endpoints: []Endpoint{},
+		},
+	}
+	for _, step := range steps {
+		source.endpoints, source.err = step.endpoints, step.err
+		err := syncer.Sync(ctx)
+		if step.wantErr != (err != nil) {
+			t.Fatalf("%s: Sync() error = %v, want error %v", step.name, err, step.wantErr)
+		}
+		if !ds.PoolHasSynced() {
+			t.Fatalf("%s: expected the pool to be synced", step.name)
+		}
+		if diff := cmp.Diff(step.want, storedEndpoints(ds)); diff != "" {
+			t.Errorf("%s: endpoints mismatch (-want +got):\n%s", step.name, diff)
+		}
+	}
+}
+
+func TestFileSourceChanges(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	path := filepath.Join(t.TempDir(), "endpoints.yaml")
+	if err := os.WriteFile(path, []byte("endpoints: []"), 0o600); err != nil {
+		t.Fatalf("Failed to write endpoint file: %v", err)
+	}
+
+	changes, err := NewFileSource(path).Changes(ctx)
+	if err != nil {
+		t.Fatalf("Changes() failed: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(`{"endpoints": [{"address": "10.0.0.1", "port": 8000}]}`), 0o600); err != nil {
+		t.Fatalf("Failed to write endpoint file: %v", err)
+	}
+	select {
+	case <-changes:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected a change after the endpoint file was written")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-changes:
+		if ok {
+			t.Error("Expected the changes channel to be closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the changes channel to be closed after the context is done")
+	}
+}
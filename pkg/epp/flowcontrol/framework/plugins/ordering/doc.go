@@ -53,7 +53,8 @@ limitations under the License.
 //     This maximizes the number of requests served before their deadlines expire.
 //
 //   - SLO Deadline ("slo-deadline-ordering-policy"): Orders requests by an SLO-based (service level objective) deadline
-//     computed as ReceivedTimestamp + x-slo-ttft-ms header (interpreted as milliseconds).
-//     Requests without a valid header are scheduled after SLO-bound requests.
+//     computed as ReceivedTimestamp + the TTFT target of the request, or its E2E target if it has no TTFT target.
+//     The targets come from the InferenceObjective of the request, tightened by its x-slo-*-ms headers.
+//     Requests without a target are scheduled after SLO-bound requests.
 //     This maximizes the number of requests served before the deadlines computed on the defined SLO expire.
 package ordering
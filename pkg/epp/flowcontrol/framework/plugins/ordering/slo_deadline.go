@@ -18,11 +18,8 @@ package ordering
 
 import (
 	"encoding/json"
-	"strconv"
-	"strings"
 	"time"
 
-	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/flowcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 )
@@ -30,13 +27,11 @@ import (
 const (
 	// SLODeadlineOrderingPolicyType orders requests by an SLO-based deadline
 	//
-	// It selects the request with the earliest SLO-based deadline, computed as `ReceivedTimestamp() + x-slo-ttft-ms header (interpreted as milliseconds)`.
-	// Requests without a valid x-slo-ttft-ms header are treated as having no deadline and are scheduled after SLO-bound requests,
-	// with FCFS as a tie-breaker.
+	// It selects the request with the earliest SLO-based deadline, computed as `ReceivedTimestamp()` plus the TTFT target of the
+	// request, or its E2E target if it has no TTFT target. The targets are resolved from the InferenceObjective of the request,
+	// tightened by its x-slo-ttft-ms and x-slo-e2e-ms headers. Requests without a target are treated as having no deadline and
+	// are scheduled after SLO-bound requests, with FCFS as a tie-breaker.
 	SLODeadlineOrderingPolicyType = "slo-deadline-ordering-policy"
-
-	// sloTtftHeader is the request header name for SLO time-to-first-token in milliseconds.
-	sloTtftHeader = "x-slo-ttft-ms"
 )
 
 func SLODeadlineOrderingPolicyFactory(name string, _ json.RawMessage, _ plugin.Handle) (plugin.Plugin, error) {
@@ -80,27 +75,27 @@ func (p *sloDeadlinePolicy) TypedName() plugin.TypedName {
 
 var sloMaxDeadlineTime = time.Unix(0, 1<<63-1)
 
-// calculateSLODeadline computes the SLO-based deadline for a request: ReceivedTimestamp + x-slo-ttft-ms (ms).
-// The header is read from the InferenceRequest()'s headers. If the header is missing, empty, or invalid,
-// the request is assigned a far-future deadline so it sorts after SLO-bound requests.
+// calculateSLODeadline computes the SLO-based deadline for a request: ReceivedTimestamp + TTFT target, or
+// ReceivedTimestamp + E2E target if the request has no TTFT target. The targets are read from the objectives of
+// the InferenceRequest(). If the request has neither target, it is assigned a far-future deadline so it sorts after
+// SLO-bound requests.
 func calculateSLODeadline(item flowcontrol.QueueItemAccessor) time.Time {
 	req := item.OriginalRequest()
 	if req == nil {
 		return sloMaxDeadlineTime
 	}
 	infReq := req.InferenceRequest()
-	if infReq == nil || infReq.Headers == nil {
+	if infReq == nil {
 		return sloMaxDeadlineTime
 	}
-	sloTtft := request.GetHeader(infReq.Headers, sloTtftHeader)
-	if sloTtft == "" {
-		return sloMaxDeadlineTime
+	target := infReq.Objectives.TTFT
+	if target <= 0 {
+		target = infReq.Objectives.E2E
 	}
-	ms, err := strconv.ParseInt(strings.TrimSpace(sloTtft), 10, 64)
-	if err != nil || ms < 0 {
+	if target <= 0 {
 		return sloMaxDeadlineTime
 	}
-	return req.ReceivedTimestamp().Add(time.Duration(ms) * time.Millisecond)
+	return req.ReceivedTimestamp().Add(target)
 }
 
 // Less returns true if item 'a' should be dispatched before item 'b'.
@@ -48,11 +48,11 @@ func TestSLODeadlinePolicy_RequiredQueueCapabilities(t *testing.T) {
 	assert.Equal(t, flowcontrol.CapabilityPriorityConfigurable, caps[0])
 }
 
-// makeSLOItem builds a QueueItemAccessor with the given SLO header and received time.
-func makeSLOItem(id string, received time.Time, sloTTFTMs string) flowcontrol.QueueItemAccessor {
+// makeSLOItem builds a QueueItemAccessor with the given TTFT target and received time.
+func makeSLOItem(id string, received time.Time, ttft time.Duration) flowcontrol.QueueItemAccessor {
 	req := mocks.NewMockFlowControlRequest(10, id, testFlowKey)
 	req.ReceivedTimestampV = received
-	req.InferenceRequestV = &scheduling.LLMRequest{Headers: map[string]string{sloTtftHeader: sloTTFTMs}}
+	req.InferenceRequestV = &scheduling.LLMRequest{Objectives: scheduling.RequestObjectives{TTFT: ttft}}
 	return &mocks.MockQueueItemAccessor{
 		EffectiveTTLV:    0,
 		OriginalRequestV: req,
@@ -66,18 +66,15 @@ func TestSLODeadline_Less(t *testing.T) {
 	now := time.Now()
 
 	// A: received now, 100ms SLO → deadline now+100ms
-	itemA := makeSLOItem("a", now, "100")
+	itemA := makeSLOItem("a", now, 100*time.Millisecond)
 	// B: received now, 50ms SLO → deadline now+50ms (earlier)
-	itemB := makeSLOItem("b", now, "50")
+	itemB := makeSLOItem("b", now, 50*time.Millisecond)
 	// C: received now+20ms, 50ms SLO → deadline now+20ms+50ms = now+70ms (after B but earlier than A)
-	itemC := makeSLOItem("c", now.Add(20*time.Millisecond), "50")
-	// D: no header → far-future deadline
-	reqD := mocks.NewMockFlowControlRequest(10, "d", testFlowKey)
-	reqD.ReceivedTimestampV = now
-	reqD.InferenceRequestV = &scheduling.LLMRequest{Headers: map[string]string{}}
-	itemD := &mocks.MockQueueItemAccessor{EffectiveTTLV: 0, OriginalRequestV: reqD}
+	itemC := makeSLOItem("c", now.Add(20*time.Millisecond), 50*time.Millisecond)
+	// D: no target → far-future deadline
+	itemD := makeSLOItem("d", now, 0)
 	// E: same deadline as B (received 1s earlier + 1050ms SLO = now+50ms), earlier ReceivedTimestamp → wins tie-breaker
-	itemE := makeSLOItem("e", now.Add(-time.Second), "1050")
+	itemE := makeSLOItem("e", now.Add(-time.Second), 1050*time.Millisecond)
 
 	testCases := []struct {
 		name     string
@@ -88,8 +85,8 @@ func TestSLODeadline_Less(t *testing.T) {
 		{"earlier SLO deadline first (B before A)", itemB, itemA, true},
 		{"later SLO deadline after (A after B)", itemA, itemB, false},
 		{"received later but earlier deadline (C before A)", itemC, itemA, true},
-		{"SLO-bound before no-header (A before D)", itemA, itemD, true},
-		{"no-header after SLO-bound (D after A)", itemD, itemA, false},
+		{"SLO-bound before no target (A before D)", itemA, itemD, true},
+		{"no target after SLO-bound (D after A)", itemD, itemA, false},
 		{"same deadline: earlier ReceivedTimestamp first (E before B)", itemE, itemB, true},
 		{"same deadline: later ReceivedTimestamp after (B after E)", itemB, itemE, false},
 		{"a is nil → b wins", nil, itemA, false},
@@ -110,29 +107,25 @@ func TestCalculateSLODeadline(t *testing.T) {
 
 	now := time.Now()
 
-	// Valid header
-	reqValid := mocks.NewMockFlowControlRequest(1, "valid", testFlowKey)
-	reqValid.ReceivedTimestampV = now
-	reqValid.InferenceRequestV = &scheduling.LLMRequest{Headers: map[string]string{sloTtftHeader: "200"}}
-	accValid := &mocks.MockQueueItemAccessor{OriginalRequestV: reqValid}
-	deadline := calculateSLODeadline(accValid)
-	assert.Equal(t, now.Add(200*time.Millisecond), deadline)
-
-	// Missing header
-	reqNoHeader := mocks.NewMockFlowControlRequest(2, "no", testFlowKey)
-	reqNoHeader.InferenceRequestV = &scheduling.LLMRequest{Headers: map[string]string{}}
-	accNoHeader := &mocks.MockQueueItemAccessor{OriginalRequestV: reqNoHeader}
-	assert.Equal(t, sloMaxDeadlineTime, calculateSLODeadline(accNoHeader))
-
-	reqNoHeader.InferenceRequestV = &scheduling.LLMRequest{Headers: map[string]string{"x-some-header": "200"}}
-	accNoHeader = &mocks.MockQueueItemAccessor{OriginalRequestV: reqNoHeader}
-	assert.Equal(t, sloMaxDeadlineTime, calculateSLODeadline(accNoHeader))
-
-	// Invalid value
-	reqInvalid := mocks.NewMockFlowControlRequest(3, "inv", testFlowKey)
-	reqInvalid.InferenceRequestV = &scheduling.LLMRequest{Headers: map[string]string{sloTtftHeader: "x"}}
-	accInvalid := &mocks.MockQueueItemAccessor{OriginalRequestV: reqInvalid}
-	assert.Equal(t, sloMaxDeadlineTime, calculateSLODeadline(accInvalid))
+	// TTFT target
+	reqTTFT := mocks.NewMockFlowControlRequest(1, "ttft", testFlowKey)
+	reqTTFT.ReceivedTimestampV = now
+	reqTTFT.InferenceRequestV = &scheduling.LLMRequest{Objectives: scheduling.RequestObjectives{TTFT: 200 * time.Millisecond, E2E: time.Second}}
+	accTTFT := &mocks.MockQueueItemAccessor{OriginalRequestV: reqTTFT}
+	assert.Equal(t, now.Add(200*time.Millisecond), calculateSLODeadline(accTTFT))
+
+	// E2E target without a TTFT target
+	reqE2E := mocks.NewMockFlowControlRequest(2, "e2e", testFlowKey)
+	reqE2E.ReceivedTimestampV = now
+	reqE2E.InferenceRequestV = &scheduling.LLMRequest{Objectives: scheduling.RequestObjectives{E2E: time.Second}}
+	accE2E := &mocks.MockQueueItemAccessor{OriginalRequestV: reqE2E}
+	assert.Equal(t, now.Add(time.Second), calculateSLODeadline(accE2E))
+
+	// No target
+	reqNoTarget := mocks.NewMockFlowControlRequest(3, "no", testFlowKey)
+	reqNoTarget.InferenceRequestV = &scheduling.LLMRequest{Objectives: scheduling.RequestObjectives{TPOT: time.Millisecond}}
+	accNoTarget := &mocks.MockQueueItemAccessor{OriginalRequestV: reqNoTarget}
+	assert.Equal(t, sloMaxDeadlineTime, calculateSLODeadline(accNoTarget))
 
 	// Nil OriginalRequest
 	accNilReq := &mocks.MockQueueItemAccessor{OriginalRequestV: nil}
@@ -35,6 +35,12 @@ type AttributeMap interface {
 	Clone() AttributeMap
 }
 
+// AttributeDeleter is an optional interface for attribute maps, and the endpoints holding them,
+// supporting the removal of an attribute.
+type AttributeDeleter interface {
+	Delete(string)
+}
+
 // Attributes provides a goroutine-safe implementation of AttributeMap.
 type Attributes struct {
 	data sync.Map // key: attribute name (string), value: attribute value (opaque, Cloneable)
@@ -64,6 +70,11 @@ func (a *Attributes) Get(key string) (Cloneable, bool) {
 	return nil, false
 }
 
+// Delete removes an attribute from the map.
+func (a *Attributes) Delete(key string) {
+	a.data.Delete(key)
+}
+
 // Keys returns all keys in the attribute map.
 func (a *Attributes) Keys() []string {
 	var keys []string
@@ -58,6 +58,19 @@ func TestExpectKeysToMatchAdded(t *testing.T) {
 	assert.ElementsMatch(t, keys, []string{"x", "y"})
 }
 
+func TestDeleteRemovesKey(t *testing.T) {
+	attrs := &Attributes{}
+	attrs.Put("x", &dummy{"1"})
+	attrs.Put("y", &dummy{"2"})
+
+	attrs.Delete("x")
+	attrs.Delete("z")
+
+	_, ok := attrs.Get("x")
+	assert.False(t, ok, "expected deleted key not to exist")
+	assert.ElementsMatch(t, attrs.Keys(), []string{"y"})
+}
+
 func TestCloneReturnsCopy(t *testing.T) {
 	original := NewAttributes()
 	original.Put("k", &dummy{"value"})
@@ -34,6 +34,26 @@ type EndpointMetricsState interface {
 	UpdateMetrics(*Metrics)
 }
 
+// MetricsMerger is implemented by the endpoints whose metrics can be updated atomically, so that the
+// extractors updating the metrics of an endpoint concurrently do not overwrite each other's updates.
+type MetricsMerger interface {
+	// MergeMetrics replaces the metrics with a copy updated by update, unless update returns false.
+	MergeMetrics(update func(*Metrics) bool)
+}
+
+// MergeMetrics updates the metrics of the endpoint with update, atomically if the endpoint is a
+// MetricsMerger. The metrics are left unchanged if update returns false.
+func MergeMetrics(ep EndpointMetricsState, update func(*Metrics) bool) {
+	if merger, ok := ep.(MetricsMerger); ok {
+		merger.MergeMetrics(update)
+		return
+	}
+	clone := ep.GetMetrics().Clone()
+	if update(clone) {
+		ep.UpdateMetrics(clone)
+	}
+}
+
 // Endpoint represents an inference serving endpoint and its related attributes.
 type Endpoint interface {
 	fmt.Stringer
@@ -86,6 +106,18 @@ func (srv *ModelServer) UpdateMetrics(metrics *Metrics) {
 	srv.metrics.Store(metrics)
 }
 
+// MergeMetrics atomically replaces the metrics with a copy updated by update, unless update returns
+// false. The update is applied again to the new metrics if they changed concurrently.
+func (srv *ModelServer) MergeMetrics(update func(*Metrics) bool) {
+	for {
+		current := srv.metrics.Load()
+		clone := current.Clone()
+		if !update(clone) || srv.metrics.CompareAndSwap(current, clone) {
+			return
+		}
+	}
+}
+
 func (srv *ModelServer) GetAttributes() AttributeMap {
 	return srv.attributes
 }
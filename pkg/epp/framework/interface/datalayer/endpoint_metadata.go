@@ -30,6 +30,9 @@ type EndpointMetadata struct {
 	Port           string
 	MetricsHost    string
 	Labels         map[string]string
+	// Draining is set once the pod of the endpoint started terminating. A draining endpoint
+	// receives no new requests, while the requests already sent to it run to completion.
+	Draining bool
 }
 
 // String returns a string representation of the endpoint.
@@ -60,6 +63,7 @@ func (p *EndpointMetadata) Clone() *EndpointMetadata {
 		Port:        p.Port,
 		MetricsHost: p.MetricsHost,
 		Labels:      clonedLabels,
+		Draining:    p.Draining,
 	}
 }
 
@@ -52,6 +52,7 @@ var (
 		NamespacedName: types.NamespacedName{Name: name, Namespace: namespace},
 		Address:        podip,
 		Labels:         labels,
+		Draining:       true,
 	}
 )
 
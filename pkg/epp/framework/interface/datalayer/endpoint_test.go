@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelServerMergeMetrics(t *testing.T) {
+	ep := NewEndpoint(nil, nil)
+	const updates = 1000
+
+	// Two writers updating different fields concurrently, as a polling and a stream extractor do,
+	// keep each other's updates.
+	var wg sync.WaitGroup
+	for _, update := range []func(*Metrics){
+		func(m *Metrics) { m.WaitingQueueSize++ },
+		func(m *Metrics) { m.MaxActiveModels++ },
+	} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range updates {
+				MergeMetrics(ep, func(m *Metrics) bool {
+					update(m)
+					return true
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, updates, ep.GetMetrics().WaitingQueueSize)
+	assert.Equal(t, updates, ep.GetMetrics().MaxActiveModels)
+
+	before := ep.GetMetrics()
+	MergeMetrics(ep, func(m *Metrics) bool {
+		m.RunningRequestsSize = 1
+		return false
+	})
+	assert.Same(t, before, ep.GetMetrics(), "metrics replaced by an update returning false")
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datalayer
+
+import (
+	"time"
+)
+
+const (
+	JoinTimeKey = "JoinTimeKey"
+)
+
+// JoinTime records when an endpoint joined the pool. It is set by the datastore when the endpoint
+// is added, to the time its pod became ready when known, so that restarting the EPP does not make
+// every endpoint look new.
+type JoinTime struct {
+	time time.Time
+}
+
+func NewJoinTime(t time.Time) *JoinTime {
+	return &JoinTime{time: t}
+}
+
+// Time returns the time the endpoint joined the pool.
+func (j *JoinTime) Time() time.Time {
+	return j.time
+}
+
+func (j *JoinTime) Clone() Cloneable {
+	return &JoinTime{time: j.time}
+}
@@ -32,6 +32,7 @@ var (
 // DataSource provides raw data to registered Extractors.
 // For poll-based sources, use PollingDataSource.
 // For event-driven sources, use NotificationSource.
+// For push-based endpoint sources, use StreamingDataSource.
 type DataSource interface {
 	plugin.Plugin
 	// Extractors returns a list of registered Extractor names.
@@ -61,6 +62,18 @@ type PollingDataSource interface {
 	Poll(ctx context.Context, ep Endpoint) error
 }
 
+// StreamingDataSource is a push-based DataSource that holds a long-lived
+// connection to each endpoint and dispatches events as they arrive.
+type StreamingDataSource interface {
+	DataSource
+	// Stream is triggered by the data layer framework when an endpoint is
+	// added. It blocks, reading events from the endpoint and calling the
+	// registered Extractors for each, until the connection ends or ctx is
+	// cancelled. The framework reconnects with backoff when Stream returns
+	// while the endpoint is still active.
+	Stream(ctx context.Context, ep Endpoint) error
+}
+
 // Extractor transforms raw data into structured attributes.
 type Extractor interface {
 	plugin.Plugin
@@ -78,3 +91,28 @@ type ValidatingDataSource interface {
 	// beyond the standard type compatibility checks. Return an error if validation fails.
 	ValidateExtractor(extractor Extractor) error
 }
+
+// EndpointListener is an optional interface for plugins keeping per-endpoint state, such as
+// a prefix cache index, to be notified of the endpoint lifecycle so that they can migrate or
+// drop that state. Notifications are delivered synchronously by the datastore, so listeners
+// must return quickly and must not call back into the datastore.
+type EndpointListener interface {
+	plugin.Plugin
+	// EndpointDraining is called when the pod of an endpoint starts terminating. The endpoint
+	// receives no new requests from then on, but stays in the pool until the pod is deleted.
+	EndpointDraining(ctx context.Context, endpoint *EndpointMetadata)
+	// EndpointRemoved is called when an endpoint is removed from the pool.
+	EndpointRemoved(ctx context.Context, endpoint *EndpointMetadata)
+}
+
+// EndpointListFunc lists the live endpoints of the pools served by the EPP.
+type EndpointListFunc func() []Endpoint
+
+// EndpointListConsumer is an optional interface for plugins working on the live state of the
+// endpoints outside of the request path, rather than on the per-request snapshots they are given.
+type EndpointListConsumer interface {
+	plugin.Plugin
+	// SetEndpointList is called once the plugins are configured with a function listing the live
+	// endpoints. Plugins must not modify the listed endpoints.
+	SetEndpointList(list EndpointListFunc)
+}
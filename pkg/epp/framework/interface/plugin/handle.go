@@ -19,6 +19,7 @@ package plugin
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -32,8 +33,16 @@ type Handle interface {
 
 	// PodList lists pods.
 	PodList() []types.NamespacedName
+
+	// MetricsStalenessThreshold returns the age after which the metrics of an endpoint are
+	// considered stale.
+	MetricsStalenessThreshold() time.Duration
 }
 
+// DefaultMetricsStalenessThreshold is the default age after which the metrics of an endpoint are
+// considered stale.
+const DefaultMetricsStalenessThreshold = 2 * time.Second
+
 // HandlePlugins defines a set of APIs to work with instantiated plugins
 type HandlePlugins interface {
 	// Plugin returns the named plugin instance
@@ -57,6 +66,8 @@ type eppHandle struct {
 	ctx context.Context
 	HandlePlugins
 	podList PodListFunc
+
+	metricsStalenessThreshold time.Duration
 }
 
 // Context returns a context the plugins can use, if they need one
@@ -98,14 +109,36 @@ func (h *eppHandle) PodList() []types.NamespacedName {
 	return h.podList()
 }
 
-func NewEppHandle(ctx context.Context, podList PodListFunc) Handle {
-	return &eppHandle{
+// MetricsStalenessThreshold returns the age after which the metrics of an endpoint are
+// considered stale.
+func (h *eppHandle) MetricsStalenessThreshold() time.Duration {
+	return h.metricsStalenessThreshold
+}
+
+// HandleOption configures optional parts of the handle created by NewEppHandle.
+type HandleOption func(*eppHandle)
+
+// WithMetricsStalenessThreshold sets the age after which the metrics of an endpoint are considered
+// stale. It defaults to DefaultMetricsStalenessThreshold.
+func WithMetricsStalenessThreshold(threshold time.Duration) HandleOption {
+	return func(h *eppHandle) {
+		h.metricsStalenessThreshold = threshold
+	}
+}
+
+func NewEppHandle(ctx context.Context, podList PodListFunc, opts ...HandleOption) Handle {
+	h := &eppHandle{
 		ctx: ctx,
 		HandlePlugins: &eppHandlePlugins{
 			plugins: map[string]Plugin{},
 		},
-		podList: podList,
+		podList:                   podList,
+		metricsStalenessThreshold: DefaultMetricsStalenessThreshold,
+	}
+	for _, opt := range opts {
+		opt(h)
 	}
+	return h
 }
 
 // PluginByType retrieves the specified plugin by name and verifies its type
@@ -0,0 +1,27 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// MetricsCollector is implemented by plugins that report their own metrics. Their collectors are
+// registered with the EPP metrics registry once all the plugins are instantiated.
+type MetricsCollector interface {
+	// Collectors returns the Prometheus collectors of the plugin. Instances of the same plugin type
+	// may return the same collectors, which are then registered once.
+	Collectors() []prometheus.Collector
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrictUnmarshal(t *testing.T) {
+	type parameters struct {
+		Threshold int    `json:"threshold"`
+		Mode      string `json:"mode"`
+	}
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    parameters
+		wantErr bool
+	}{
+		{name: "known fields", raw: `{"threshold": 3, "mode": "fast"}`, want: parameters{Threshold: 3, Mode: "fast"}},
+		{name: "omitted fields keep their values", raw: `{"mode": "fast"}`, want: parameters{Threshold: 1, Mode: "fast"}},
+		{name: "unknown field", raw: `{"treshold": 3}`, wantErr: true},
+		{name: "wrong type", raw: `{"threshold": "3"}`, wantErr: true},
+		{name: "trailing data", raw: `{"threshold": 3} {"mode": "fast"}`, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parameters{Threshold: 1}
+			err := StrictUnmarshal(json.RawMessage(test.raw), &got)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
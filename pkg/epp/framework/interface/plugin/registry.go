@@ -27,7 +27,20 @@ type FactoryFunc func(name string, parameters json.RawMessage, handle Handle) (P
 // Register is a static function that can be called to register plugin factory functions.
 func Register(pluginType string, factory FactoryFunc) {
 	Registry[pluginType] = factory
+	delete(ParametersRegistry, pluginType)
+}
+
+// RegisterWithParameters registers a plugin factory function together with the parameters of the
+// plugins it creates without parameters, as a value of the struct the factory decodes the parameters
+// block into. The JSON Schema of the parameters is generated from it, without creating a plugin.
+func RegisterWithParameters(pluginType string, factory FactoryFunc, defaultParameters any) {
+	Register(pluginType, factory)
+	ParametersRegistry[pluginType] = defaultParameters
 }
 
 // Registry is a mapping from plugin name to Factory function
 var Registry map[string]FactoryFunc = map[string]FactoryFunc{}
+
+// ParametersRegistry is a mapping from plugin type to the default parameters of the plugins
+// registered with RegisterWithParameters.
+var ParametersRegistry = map[string]any{}
@@ -25,12 +25,25 @@ import (
 )
 
 const (
-	PreRequestExtensionPoint        = "PreRequest"
-	ResponseReceivedExtensionPoint  = "ResponseReceived"
-	ResponseStreamingExtensionPoint = "ResponseStreaming"
-	ResponseCompleteExtensionPoint  = "ResponseComplete"
+	PrepareDataExtensionPoint         = "PrepareData"
+	RequestBodyMutationExtensionPoint = "RequestBodyMutation"
+	PreRequestExtensionPoint          = "PreRequest"
+	ResponseReceivedExtensionPoint    = "ResponseReceived"
+	ResponseStreamingExtensionPoint   = "ResponseStreaming"
+	ResponseCompleteExtensionPoint    = "ResponseComplete"
 )
 
+// RequestBodyMutation is called by the director after getting a result from the scheduling layer and
+// before the PreRequest plugins, so that the body of the request can be adapted to the selected endpoints,
+// e.g. to add engine specific hints or to cap the number of generated tokens.
+// The plugins mutate the given parsed body in place. The director serializes the body and updates its
+// Content-Length once after all the plugins ran. Bodies which are not JSON objects are not mutated.
+type RequestBodyMutation interface {
+	plugin.Plugin
+	// MutateRequestBody returns an error, which fails the request, if the body cannot be mutated.
+	MutateRequestBody(ctx context.Context, request *types.LLMRequest, schedulingResult *types.SchedulingResult, body map[string]any) error
+}
+
 // PreRequest is called by the director after a getting result from scheduling layer and
 // before a request is sent to the selected model server.
 type PreRequest interface {
@@ -55,18 +68,21 @@ type ResponseStreaming interface {
 // ResponseComplete is called by the director when the request lifecycle terminates.
 // This occurs after a response is fully sent, OR if the request fails/disconnects after a pod was scheduled.
 //
-// Plugins should assume this is the final cleanup hook for a request.
-//
-// TODO(https://github.com/kubernetes-sigs/gateway-api-inference-extension/issues/2079):
-// Update signature to pass error/termination state. This is a breaking change required for plugins to distinguish
-// between success, errors, and disconnects.
+// Plugins should assume this is the final cleanup hook for a request. The termination tells a successful response
+// apart from a client disconnect, an error status of the model server or an EPP failure.
 type ResponseComplete interface {
 	plugin.Plugin
-	ResponseComplete(ctx context.Context, request *types.LLMRequest, response *Response, targetEndpoint *datalayer.EndpointMetadata)
+	ResponseComplete(ctx context.Context, request *types.LLMRequest, response *Response, termination Termination,
+		targetEndpoint *datalayer.EndpointMetadata)
 }
 
 // PrepareRequestData is called by the director before scheduling requests.
 // PrepareDataPlugin plugin is implemented by data producers which produce data from different sources.
+//
+// The endpoints given to PrepareRequestData are wrappers recording the attributes the plugin writes, which
+// are applied to the endpoints of the request only once the plugin returns in time. Plugins must not keep
+// these endpoints, nor write to them, after returning. Plugins remove attributes through the optional
+// datalayer.AttributeDeleter interface of the endpoints.
 type PrepareDataPlugin interface {
 	plugin.ProducerPlugin
 	plugin.ConsumerPlugin
@@ -39,6 +39,35 @@ type Response struct {
 	DynamicMetadata *structpb.Struct
 }
 
+// TerminationReason describes how the lifecycle of a request ended.
+type TerminationReason string
+
+const (
+	// TerminationCompleted indicates that the response was fully received with a successful status.
+	TerminationCompleted TerminationReason = "Completed"
+	// TerminationClientCancelled indicates that the client disconnected before the response was complete.
+	TerminationClientCancelled TerminationReason = "ClientCancelled"
+	// TerminationUpstreamError indicates that the model server responded with a non-2xx status.
+	TerminationUpstreamError TerminationReason = "UpstreamError"
+	// TerminationEPPError indicates that the EPP failed to process the request or its response.
+	TerminationEPPError TerminationReason = "EPPError"
+)
+
+// Termination describes the terminal state of a request, passed to the ResponseComplete plugins.
+type Termination struct {
+	// Reason is how the request terminated.
+	Reason TerminationReason
+	// StatusCode is the HTTP status code returned by the model server, or 0 if no response headers were received.
+	StatusCode int
+	// Err is the error which terminated the request, if any.
+	Err error
+}
+
+// Succeeded reports whether the response of the request was fully received with a successful status.
+func (t Termination) Succeeded() bool {
+	return t.Reason == TerminationCompleted
+}
+
 type Usage struct {
 	PromptTokens       int                 `json:"prompt_tokens"`
 	CompletionTokens   int                 `json:"completion_tokens"`
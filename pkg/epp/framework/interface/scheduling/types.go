@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 )
@@ -32,6 +33,12 @@ const nilString = "<nil>"
 // RequestObjectives represents the scheduling objectives parsed from the InferenceObjectiveSpec, to be used in scheduling decisions.
 type RequestObjectives struct {
 	Priority int
+	// TTFT is the time to first token target of the request, or zero if it has none.
+	TTFT time.Duration
+	// TPOT is the average time per output token target of the request, or zero if it has none.
+	TPOT time.Duration
+	// E2E is the end-to-end latency target of the request, or zero if it has none.
+	E2E time.Duration
 }
 
 // LLMRequest is a structured representation of the fields we parse out of the LLMRequest body.
@@ -332,6 +339,13 @@ func (ep *endpoint) GetMetrics() *fwkdl.Metrics {
 	return ep.Metrics
 }
 
+// Delete removes an attribute of the endpoint, when its attribute map supports it.
+func (ep *endpoint) Delete(key string) {
+	if deleter, ok := ep.AttributeMap.(fwkdl.AttributeDeleter); ok {
+		deleter.Delete(key)
+	}
+}
+
 type endpoint struct {
 	*fwkdl.EndpointMetadata
 	*fwkdl.Metrics
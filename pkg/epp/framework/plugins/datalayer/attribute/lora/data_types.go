@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lora
+
+import (
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+const (
+	LoraPlacementKey = "LoraPlacementKey"
+)
+
+// LoraPlacement tells whether the adapter placement plan assigns the adapter targeted by the
+// current request to an endpoint. It is only set when the plan covers that adapter.
+type LoraPlacement struct {
+	// whether the plan places the adapter on the endpoint
+	planned bool
+}
+
+func NewLoraPlacement(planned bool) *LoraPlacement {
+	return &LoraPlacement{planned: planned}
+}
+
+func (p *LoraPlacement) Planned() bool {
+	return p.planned
+}
+
+func (p *LoraPlacement) Clone() fwkdl.Cloneable {
+	return &LoraPlacement{planned: p.planned}
+}
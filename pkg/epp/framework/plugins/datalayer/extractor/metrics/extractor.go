@@ -120,63 +120,68 @@ func (ext *Extractor) Extract(ctx context.Context, data any, ep fwkdl.Endpoint)
 		return fmt.Errorf("no mapping found for engine type %q and no default mapping registered", engineType)
 	}
 
+	// The metrics are merged atomically, so that the concurrent updates of other extractors, such as
+	// a stream extractor, are kept. The update is applied again if they changed meanwhile.
 	var errs []error
-	current := ep.GetMetrics()
-	clone := current.Clone()
 	updated := false
-
-	if spec := mapping.TotalQueuedRequests; spec != nil { // extract queued requests
-		if metric, err := spec.getLatestMetric(families); err != nil {
-			errs = append(errs, err)
-		} else {
-			clone.WaitingQueueSize = int(extractValue(metric))
-			updated = true
+	fwkdl.MergeMetrics(ep, func(metrics *fwkdl.Metrics) bool {
+		errs, updated = nil, false
+
+		if spec := mapping.TotalQueuedRequests; spec != nil { // extract queued requests
+			if metric, err := spec.getLatestMetric(families); err != nil {
+				errs = append(errs, err)
+			} else {
+				metrics.WaitingQueueSize = int(extractValue(metric))
+				updated = true
+			}
 		}
-	}
 
-	if spec := mapping.TotalRunningRequests; spec != nil { // extract running requests
-		if metric, err := spec.getLatestMetric(families); err != nil {
-			errs = append(errs, err)
-		} else {
-			clone.RunningRequestsSize = int(extractValue(metric))
-			updated = true
+		if spec := mapping.TotalRunningRequests; spec != nil { // extract running requests
+			if metric, err := spec.getLatestMetric(families); err != nil {
+				errs = append(errs, err)
+			} else {
+				metrics.RunningRequestsSize = int(extractValue(metric))
+				updated = true
+			}
 		}
-	}
 
-	if spec := mapping.KVCacheUtilization; spec != nil { // extract KV cache usage
-		if metric, err := spec.getLatestMetric(families); err != nil {
-			errs = append(errs, err)
-		} else {
-			clone.KVCacheUsagePercent = extractValue(metric)
-			updated = true
+		if spec := mapping.KVCacheUtilization; spec != nil { // extract KV cache usage
+			if metric, err := spec.getLatestMetric(families); err != nil {
+				errs = append(errs, err)
+			} else {
+				metrics.KVCacheUsagePercent = extractValue(metric)
+				updated = true
+			}
 		}
-	}
 
-	if spec := mapping.LoraRequestInfo; spec != nil { // extract LoRA-specific metrics
-		metric, err := spec.getLatestMetric(families)
-		if err != nil {
-			errs = append(errs, err)
-		} else if metric != nil {
-			populateLoRAMetrics(clone, metric, &errs)
-			updated = true
+		if spec := mapping.LoraRequestInfo; spec != nil { // extract LoRA-specific metrics
+			metric, err := spec.getLatestMetric(families)
+			if err != nil {
+				errs = append(errs, err)
+			} else if metric != nil {
+				populateLoRAMetrics(metrics, metric, &errs)
+				updated = true
+			}
 		}
-	}
 
-	if spec := mapping.CacheInfo; spec != nil { // extract CacheInfo-specific metrics
-		metric, err := spec.getLatestMetric(families)
-		if err != nil {
-			errs = append(errs, err)
-		} else if metric != nil {
-			populateCacheInfoMetrics(clone, metric, &errs)
-			updated = true
+		if spec := mapping.CacheInfo; spec != nil { // extract CacheInfo-specific metrics
+			metric, err := spec.getLatestMetric(families)
+			if err != nil {
+				errs = append(errs, err)
+			} else if metric != nil {
+				populateCacheInfoMetrics(metrics, metric, &errs)
+				updated = true
+			}
 		}
-	}
 
-	logger := log.FromContext(ctx).WithValues("endpoint", ep.GetMetadata().NamespacedName)
+		if updated {
+			metrics.UpdateTime = time.Now()
+		}
+		return updated
+	})
 	if updated {
-		clone.UpdateTime = time.Now()
-		logger.V(logutil.TRACE).Info("Refreshed metrics", "updated", clone)
-		ep.UpdateMetrics(clone)
+		log.FromContext(ctx).V(logutil.TRACE).Info("Refreshed metrics", "endpoint", ep.GetMetadata().NamespacedName,
+			"updated", ep.GetMetrics())
 	}
 
 	if len(errs) != 0 {
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streammetrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	sourcestream "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/stream"
+)
+
+const StreamMetricsExtractorType = "stream-metrics-extractor"
+
+// Default JSON field paths of the load fields in a stream event payload.
+const (
+	defaultQueuedRequestsField  = "num_requests_waiting"
+	defaultRunningRequestsField = "num_requests_running"
+	defaultKVUsageField         = "kv_cache_usage_perc"
+)
+
+// streamMetricsExtractorParams holds the configuration parameters for the stream metrics extractor.
+type streamMetricsExtractorParams struct {
+	// EventType restricts extraction to SSE events of the given type. When empty,
+	// all events are processed.
+	EventType string `json:"eventType"`
+	// QueuedRequestsField is the dot separated JSON path of the queued requests count.
+	QueuedRequestsField string `json:"queuedRequestsField"`
+	// RunningRequestsField is the dot separated JSON path of the running requests count.
+	RunningRequestsField string `json:"runningRequestsField"`
+	// KVUsageField is the dot separated JSON path of the KV cache usage, in the range [0, 1].
+	KVUsageField string `json:"kvUsageField"`
+}
+
+// Extractor updates endpoint metrics from JSON load updates pushed by a
+// model server over an event stream. Fields missing from an event leave
+// the corresponding metric unchanged.
+type Extractor struct {
+	typedName   fwkplugin.TypedName
+	eventType   string
+	queuedPath  []string
+	runningPath []string
+	kvUsagePath []string
+}
+
+// StreamMetricsExtractorFactory is a factory function used to instantiate stream
+// metrics extractor plugins specified in a configuration.
+func StreamMetricsExtractorFactory(name string, parameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	cfg := &streamMetricsExtractorParams{
+		QueuedRequestsField:  defaultQueuedRequestsField,
+		RunningRequestsField: defaultRunningRequestsField,
+		KVUsageField:         defaultKVUsageField,
+	}
+	if parameters != nil {
+		if err := json.Unmarshal(parameters, cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse %s parameters: %w", StreamMetricsExtractorType, err)
+		}
+	}
+	return NewStreamMetricsExtractor(cfg.EventType, cfg.QueuedRequestsField, cfg.RunningRequestsField,
+		cfg.KVUsageField).WithName(name), nil
+}
+
+// NewStreamMetricsExtractor returns a new extractor reading the given JSON field
+// paths. An empty path disables extraction of the corresponding metric.
+func NewStreamMetricsExtractor(eventType, queuedField, runningField, kvUsageField string) *Extractor {
+	return &Extractor{
+		typedName: fwkplugin.TypedName{
+			Type: StreamMetricsExtractorType,
+			Name: StreamMetricsExtractorType,
+		},
+		eventType:   eventType,
+		queuedPath:  splitPath(queuedField),
+		runningPath: splitPath(runningField),
+		kvUsagePath: splitPath(kvUsageField),
+	}
+}
+
+// WithName sets the name of the extractor.
+func (ext *Extractor) WithName(name string) *Extractor {
+	if name != "" {
+		ext.typedName.Name = name
+	}
+	return ext
+}
+
+// TypedName returns the type and name of the extractor.
+func (ext *Extractor) TypedName() fwkplugin.TypedName {
+	return ext.typedName
+}
+
+// ExpectedInputType defines the type expected by the extractor - an event
+// received from a stream data source.
+func (ext *Extractor) ExpectedInputType() reflect.Type {
+	return sourcestream.StreamEventType
+}
+
+// Extract decodes the event payload and stores the load fields found in it
+// on the endpoint's metrics.
+func (ext *Extractor) Extract(ctx context.Context, data any, ep fwkdl.Endpoint) error {
+	event, ok := data.(sourcestream.StreamEvent)
+	if !ok {
+		return fmt.Errorf("unexpected input in Extract: %T", data)
+	}
+	if ext.eventType != "" && event.Type != ext.eventType {
+		return nil
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(event.Data, &payload); err != nil {
+		return fmt.Errorf("failed to decode stream event: %w", err)
+	}
+
+	queued, hasQueued := lookupNumber(payload, ext.queuedPath)
+	running, hasRunning := lookupNumber(payload, ext.runningPath)
+	kvUsage, hasKVUsage := lookupNumber(payload, ext.kvUsagePath)
+	if !hasQueued && !hasRunning && !hasKVUsage {
+		return nil
+	}
+
+	// Only the fields found in the event are merged, so that the concurrent updates of the other
+	// fields, such as by a polling extractor, are kept.
+	fwkdl.MergeMetrics(ep, func(metrics *fwkdl.Metrics) bool {
+		if hasQueued {
+			metrics.WaitingQueueSize = int(queued)
+		}
+		if hasRunning {
+			metrics.RunningRequestsSize = int(running)
+		}
+		if hasKVUsage {
+			metrics.KVCacheUsagePercent = kvUsage
+		}
+		metrics.UpdateTime = time.Now()
+		return true
+	})
+	log.FromContext(ctx).V(logutil.TRACE).Info("Refreshed metrics from stream",
+		"endpoint", ep.GetMetadata().NamespacedName, "updated", ep.GetMetrics())
+	return nil
+}
+
+// lookupNumber walks the JSON object along path and returns the numeric value
+// found at its end.
+func lookupNumber(obj map[string]any, path []string) (float64, bool) {
+	if len(path) == 0 {
+		return 0, false
+	}
+	var cur any = obj
+	for _, key := range path {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return 0, false
+		}
+		if cur, ok = m[key]; !ok {
+			return 0, false
+		}
+	}
+	val, ok := cur.(float64)
+	return val, ok
+}
+
+func splitPath(field string) []string {
+	if field == "" {
+		return nil
+	}
+	return strings.Split(field, ".")
+}
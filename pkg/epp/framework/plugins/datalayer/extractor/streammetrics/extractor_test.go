@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streammetrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	sourcestream "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/stream"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name      string
+		extractor *Extractor
+		event     any
+		initial   *fwkdl.Metrics
+		want      *fwkdl.Metrics
+		wantErr   bool
+	}{
+		{
+			name:      "default fields",
+			extractor: NewStreamMetricsExtractor("", defaultQueuedRequestsField, defaultRunningRequestsField, defaultKVUsageField),
+			event: sourcestream.StreamEvent{
+				Data: []byte(`{"num_requests_waiting": 3, "num_requests_running": 5, "kv_cache_usage_perc": 0.4}`),
+			},
+			initial: &fwkdl.Metrics{},
+			want:    &fwkdl.Metrics{WaitingQueueSize: 3, RunningRequestsSize: 5, KVCacheUsagePercent: 0.4},
+		},
+		{
+			name:      "nested fields and partial update",
+			extractor: NewStreamMetricsExtractor("", "load.queued", "load.running", ""),
+			event: sourcestream.StreamEvent{
+				Data: []byte(`{"load": {"queued": 7}}`),
+			},
+			initial: &fwkdl.Metrics{RunningRequestsSize: 2, KVCacheUsagePercent: 0.9},
+			want:    &fwkdl.Metrics{WaitingQueueSize: 7, RunningRequestsSize: 2, KVCacheUsagePercent: 0.9},
+		},
+		{
+			name:      "other event types are ignored",
+			extractor: NewStreamMetricsExtractor("load", defaultQueuedRequestsField, "", ""),
+			event: sourcestream.StreamEvent{
+				Type: "heartbeat",
+				Data: []byte(`{"num_requests_waiting": 3}`),
+			},
+			initial: &fwkdl.Metrics{WaitingQueueSize: 1},
+			want:    &fwkdl.Metrics{WaitingQueueSize: 1},
+		},
+		{
+			name:      "malformed payload",
+			extractor: NewStreamMetricsExtractor("", defaultQueuedRequestsField, "", ""),
+			event:     sourcestream.StreamEvent{Data: []byte(`not json`)},
+			initial:   &fwkdl.Metrics{},
+			want:      &fwkdl.Metrics{},
+			wantErr:   true,
+		},
+		{
+			name:      "unexpected input type",
+			extractor: NewStreamMetricsExtractor("", defaultQueuedRequestsField, "", ""),
+			event:     "string",
+			initial:   &fwkdl.Metrics{},
+			want:      &fwkdl.Metrics{},
+			wantErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ep := fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{}, test.initial)
+			err := test.extractor.Extract(context.Background(), test.event, ep)
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			got := ep.GetMetrics()
+			assert.Equal(t, test.want.WaitingQueueSize, got.WaitingQueueSize)
+			assert.Equal(t, test.want.RunningRequestsSize, got.RunningRequestsSize)
+			assert.Equal(t, test.want.KVCacheUsagePercent, got.KVCacheUsagePercent)
+		})
+	}
+}
+
+func TestStreamMetricsExtractorFactory(t *testing.T) {
+	plugin, err := StreamMetricsExtractorFactory("load", []byte(`{"eventType": "load", "kvUsageField": "kv"}`), nil)
+	require.NoError(t, err)
+	ext := plugin.(*Extractor)
+	assert.Equal(t, "load", ext.TypedName().Name)
+	assert.Equal(t, "load", ext.eventType)
+	assert.Equal(t, []string{"kv"}, ext.kvUsagePath)
+	assert.Equal(t, []string{defaultQueuedRequestsField}, ext.queuedPath)
+
+	_, err = StreamMetricsExtractorFactory("load", []byte(`{`), nil)
+	assert.Error(t, err)
+}
@@ -18,6 +18,7 @@ package mocks
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"sync/atomic"
 
@@ -66,6 +67,44 @@ func (fds *MetricsDataSource) Poll(ctx context.Context, ep fwkdl.Endpoint) error
 	return nil
 }
 
+var _ fwkdl.StreamingDataSource = (*StreamingDataSource)(nil)
+
+// StreamingDataSource is a streaming source whose streams terminate immediately
+// unless Block is set, in which case they wait for context cancellation.
+type StreamingDataSource struct {
+	typedName plugin.TypedName
+	CallCount int64
+	Block     bool
+}
+
+func NewStreamingDataSource(typedName plugin.TypedName) *StreamingDataSource {
+	return &StreamingDataSource{typedName: typedName}
+}
+
+func (sds *StreamingDataSource) TypedName() plugin.TypedName {
+	return sds.typedName
+}
+
+func (sds *StreamingDataSource) OutputType() reflect.Type {
+	return reflect.TypeOf(fwkdl.Metrics{})
+}
+
+func (sds *StreamingDataSource) ExtractorType() reflect.Type {
+	return reflect.TypeOf((*fwkdl.Extractor)(nil)).Elem()
+}
+
+func (sds *StreamingDataSource) Extractors() []string                 { return []string{} }
+func (sds *StreamingDataSource) AddExtractor(_ fwkdl.Extractor) error { return nil }
+
+func (sds *StreamingDataSource) Stream(ctx context.Context, _ fwkdl.Endpoint) error {
+	atomic.AddInt64(&sds.CallCount, 1)
+	if sds.Block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return errors.New("stream closed")
+}
+
 // NotificationSource implements both DataSource and NotificationSource for testing.
 type NotificationSource struct {
 	typedName plugin.TypedName
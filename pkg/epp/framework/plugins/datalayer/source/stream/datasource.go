@@ -0,0 +1,259 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// Format identifies the wire format of an endpoint's event stream.
+type Format string
+
+const (
+	// FormatSSE is a Server-Sent Events (text/event-stream) stream.
+	FormatSSE Format = "sse"
+	// FormatNDJSON is a newline-delimited JSON stream, one event per line.
+	FormatNDJSON Format = "ndjson"
+)
+
+const (
+	// maxEventSize bounds the size of a single line read from a stream.
+	maxEventSize = 1 << 20
+)
+
+var (
+	_ fwkdl.DataSource          = (*StreamDataSource)(nil)
+	_ fwkdl.StreamingDataSource = (*StreamDataSource)(nil)
+)
+
+// StreamDataSource is a data source that holds a long-lived HTTP connection
+// to each endpoint and dispatches every received event to its extractors.
+type StreamDataSource struct {
+	typedName fwkplugin.TypedName
+	scheme    string // scheme to use
+	path      string // path to use
+	format    Format // wire format of the stream
+
+	client     *http.Client
+	extractors sync.Map // key: name, value: extractor
+}
+
+// NewStreamDataSource returns a new streaming data source, configured with
+// the provided scheme, path, stream format and certificate verification parameters.
+func NewStreamDataSource(scheme string, path string, format Format, skipCertVerification bool,
+	pluginType string, pluginName string) (*StreamDataSource, error) {
+	if scheme != "http" && scheme != "https" {
+		return nil, fmt.Errorf("unsupported scheme: %s", scheme)
+	}
+	if format != FormatSSE && format != FormatNDJSON {
+		return nil, fmt.Errorf("unsupported stream format: %s", format)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if scheme == "https" {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: skipCertVerification,
+		}
+	}
+
+	return &StreamDataSource{
+		typedName: fwkplugin.TypedName{
+			Type: pluginType,
+			Name: pluginName,
+		},
+		scheme: scheme,
+		path:   path,
+		format: format,
+		// no client timeout: streams are long-lived and bound by the endpoint's context.
+		client: &http.Client{Transport: transport},
+	}, nil
+}
+
+// TypedName returns the data source type and name.
+func (src *StreamDataSource) TypedName() fwkplugin.TypedName {
+	return src.typedName
+}
+
+// OutputType returns the type of data this DataSource produces.
+func (src *StreamDataSource) OutputType() reflect.Type {
+	return StreamEventType
+}
+
+// ExtractorType returns the type of Extractor this DataSource expects.
+func (src *StreamDataSource) ExtractorType() reflect.Type {
+	return fwkdl.ExtractorType
+}
+
+// Extractors returns a list of registered Extractor names.
+func (src *StreamDataSource) Extractors() []string {
+	extractors := []string{}
+	src.extractors.Range(func(_, val any) bool {
+		if ex, ok := val.(fwkdl.Extractor); ok {
+			extractors = append(extractors, ex.TypedName().String())
+		}
+		return true // continue iteration
+	})
+	return extractors
+}
+
+// AddExtractor adds an extractor to the data source.
+// Validation of extractor compatibility is done by the runtime via datalayer.WithConfig.
+func (src *StreamDataSource) AddExtractor(extractor fwkdl.Extractor) error {
+	if _, loaded := src.extractors.LoadOrStore(extractor.TypedName().Name, extractor); loaded {
+		return fmt.Errorf("attempt to add duplicate extractor %s to %s", extractor.TypedName(), src.TypedName())
+	}
+	return nil
+}
+
+// Stream connects to the endpoint's event stream and dispatches events to the
+// registered extractors until the stream ends or ctx is cancelled. Reconnection
+// is handled by the data layer framework.
+func (src *StreamDataSource) Stream(ctx context.Context, ep fwkdl.Endpoint) error {
+	target := src.getEndpoint(ep.GetMetadata())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	if src.format == FormatSSE {
+		req.Header.Set("Accept", "text/event-stream")
+	} else {
+		req.Header.Set("Accept", "application/x-ndjson")
+	}
+
+	resp, err := src.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to connect to stream of %s: %w", ep.GetMetadata().GetNamespacedName(), err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code from %s: %v", ep.GetMetadata().GetNamespacedName(), resp.StatusCode)
+	}
+
+	dispatch := func(event StreamEvent) {
+		if err := src.dispatch(ctx, event, ep); err != nil {
+			log.FromContext(ctx).V(logutil.DEBUG).Info("failed to extract stream event",
+				"endpoint", ep.GetMetadata().GetNamespacedName(), "source", src.typedName.String(), "error", err)
+		}
+	}
+
+	if src.format == FormatSSE {
+		err = readSSE(resp.Body, dispatch)
+	} else {
+		err = readNDJSON(resp.Body, dispatch)
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// dispatch calls all registered extractors with the given event.
+func (src *StreamDataSource) dispatch(ctx context.Context, event StreamEvent, ep fwkdl.Endpoint) error {
+	var errs []error
+	src.extractors.Range(func(_, val any) bool {
+		if ex, ok := val.(fwkdl.Extractor); ok {
+			if err := ex.Extract(ctx, event, ep); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return true // continue iteration
+	})
+	return errors.Join(errs...)
+}
+
+func (src *StreamDataSource) getEndpoint(ep *fwkdl.EndpointMetadata) *url.URL {
+	return &url.URL{
+		Scheme: src.scheme,
+		Host:   ep.GetMetricsHost(),
+		Path:   src.path,
+	}
+}
+
+// readNDJSON reads newline-delimited events from r, calling emit for each
+// non-empty line. It returns nil when r is exhausted.
+func readNDJSON(r io.Reader, emit func(StreamEvent)) error {
+	scanner := newScanner(r)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		emit(StreamEvent{Data: bytes.Clone(line)})
+	}
+	return scanner.Err()
+}
+
+// readSSE reads Server-Sent Events from r, calling emit for each complete
+// event. It returns nil when r is exhausted. See
+// https://html.spec.whatwg.org/multipage/server-sent-events.html#event-stream-interpretation
+func readSSE(r io.Reader, emit func(StreamEvent)) error {
+	scanner := newScanner(r)
+	var event StreamEvent
+	var data [][]byte
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 { // blank line terminates an event
+			if len(data) > 0 {
+				event.Data = bytes.Join(data, []byte("\n"))
+				emit(event)
+			}
+			event, data = StreamEvent{}, nil
+			continue
+		}
+		if line[0] == ':' { // comment, often used as keep-alive
+			continue
+		}
+
+		field, value, _ := bytes.Cut(line, []byte(":"))
+		value = bytes.TrimPrefix(value, []byte(" "))
+		switch string(field) {
+		case "event":
+			event.Type = string(value)
+		case "id":
+			event.ID = string(value)
+		case "data":
+			data = append(data, bytes.Clone(value))
+		}
+	}
+	return scanner.Err()
+}
+
+func newScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxEventSize)
+	return scanner
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// recordingExtractor records all events it receives.
+type recordingExtractor struct {
+	mu     sync.Mutex
+	events []StreamEvent
+}
+
+func (r *recordingExtractor) TypedName() fwkplugin.TypedName {
+	return fwkplugin.TypedName{Type: "recorder", Name: "recorder"}
+}
+
+func (r *recordingExtractor) ExpectedInputType() reflect.Type {
+	return StreamEventType
+}
+
+func (r *recordingExtractor) Extract(_ context.Context, data any, _ fwkdl.Endpoint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, data.(StreamEvent))
+	return nil
+}
+
+func newTestEndpoint(host string) fwkdl.Endpoint {
+	return fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: types.NamespacedName{Name: "pod1", Namespace: "default"},
+		MetricsHost:    host,
+	}, nil)
+}
+
+func TestNewStreamDataSource(t *testing.T) {
+	_, err := NewStreamDataSource("invalid", "/events", FormatSSE, true, StreamDataSourceType, "stream")
+	assert.Error(t, err, "expected to fail with invalid scheme")
+
+	_, err = NewStreamDataSource("http", "/events", Format("xml"), true, StreamDataSourceType, "stream")
+	assert.Error(t, err, "expected to fail with invalid format")
+
+	src, err := NewStreamDataSource("https", "/events", FormatNDJSON, true, StreamDataSourceType, "stream")
+	require.NoError(t, err)
+	assert.Equal(t, StreamDataSourceType, src.TypedName().Type)
+	assert.Equal(t, StreamEventType, src.OutputType())
+
+	ext := &recordingExtractor{}
+	require.NoError(t, src.AddExtractor(ext))
+	assert.Error(t, src.AddExtractor(ext), "expected duplicate extractor to fail")
+	assert.Equal(t, []string{ext.TypedName().String()}, src.Extractors())
+}
+
+func TestStream(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		body   string
+		want   []StreamEvent
+	}{
+		{
+			name:   "sse",
+			format: FormatSSE,
+			body: ": keep-alive\n\n" +
+				"event: load\nid: 1\ndata: {\"a\":1}\n\n" +
+				"data: line1\ndata: line2\n\n" +
+				"event: empty\n\n",
+			want: []StreamEvent{
+				{Type: "load", ID: "1", Data: []byte(`{"a":1}`)},
+				{Data: []byte("line1\nline2")},
+			},
+		},
+		{
+			name:   "ndjson",
+			format: FormatNDJSON,
+			body:   "{\"a\":1}\n\n  {\"b\":2}  \n",
+			want: []StreamEvent{
+				{Data: []byte(`{"a":1}`)},
+				{Data: []byte(`{"b":2}`)},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/events", r.URL.Path)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			src, err := NewStreamDataSource("http", "/events", test.format, true, StreamDataSourceType, "stream")
+			require.NoError(t, err)
+			ext := &recordingExtractor{}
+			require.NoError(t, src.AddExtractor(ext))
+
+			err = src.Stream(context.Background(), newTestEndpoint(strings.TrimPrefix(server.URL, "http://")))
+			assert.NoError(t, err, "stream should end cleanly when the server closes it")
+			assert.Equal(t, test.want, ext.events)
+		})
+	}
+}
+
+func TestStreamUnexpectedStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	src, err := NewStreamDataSource("http", "/events", FormatSSE, true, StreamDataSourceType, "stream")
+	require.NoError(t, err)
+
+	err = src.Stream(context.Background(), newTestEndpoint(strings.TrimPrefix(server.URL, "http://")))
+	assert.Error(t, err)
+}
+
+func TestStreamDataSourceFactory(t *testing.T) {
+	_, err := StreamDataSourceFactory("stream", nil, nil)
+	assert.Error(t, err, "expected to fail without a path")
+
+	plugin, err := StreamDataSourceFactory("stream", []byte(`{"path": "/events", "format": "ndjson"}`), nil)
+	require.NoError(t, err)
+	src := plugin.(*StreamDataSource)
+	assert.Equal(t, "stream", src.TypedName().Name)
+	assert.Equal(t, FormatNDJSON, src.format)
+	assert.Equal(t, defaultStreamScheme, src.scheme)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const StreamDataSourceType = "stream-data-source"
+
+// Default values for the stream data source configuration.
+const (
+	defaultStreamScheme             = "http"
+	defaultStreamFormat             = FormatSSE
+	defaultStreamInsecureSkipVerify = true
+)
+
+// streamDatasourceParams holds the configuration parameters for the stream data source plugin.
+// These values can be specified in the EndpointPickerConfig under the plugin's `parameters` field.
+type streamDatasourceParams struct {
+	// Scheme defines the protocol scheme used to connect to the stream (e.g., "http").
+	Scheme string `json:"scheme"`
+	// Path defines the URL path of the model server's event stream (e.g., "/events").
+	Path string `json:"path"`
+	// Format defines the wire format of the stream, either "sse" or "ndjson".
+	Format Format `json:"format"`
+	// InsecureSkipVerify defines whether model server certificate should be verified or not.
+	InsecureSkipVerify bool `json:"insecureSkipVerify"`
+}
+
+// StreamDataSourceFactory is a factory function used to instantiate data layer's
+// stream data source plugins specified in a configuration.
+func StreamDataSourceFactory(name string, parameters json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+	cfg := &streamDatasourceParams{
+		Scheme:             defaultStreamScheme,
+		Format:             defaultStreamFormat,
+		InsecureSkipVerify: defaultStreamInsecureSkipVerify,
+	}
+
+	if parameters != nil { // overlay the defaults with configured values
+		if err := json.Unmarshal(parameters, cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse %s parameters: %w", StreamDataSourceType, err)
+		}
+	}
+	if cfg.Path == "" {
+		return nil, errors.New("path is required for " + StreamDataSourceType)
+	}
+
+	return NewStreamDataSource(cfg.Scheme, cfg.Path, cfg.Format, cfg.InsecureSkipVerify, StreamDataSourceType, name)
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"reflect"
+)
+
+// StreamEvent is a single event received from an endpoint's event stream.
+type StreamEvent struct {
+	// Type is the SSE event type (the "event:" field). It is empty for
+	// newline-delimited JSON streams and for SSE events without a type.
+	Type string
+	// ID is the SSE event identifier (the "id:" field), if any.
+	ID string
+	// Data is the raw event payload. For SSE, multiple "data:" lines are
+	// joined with a newline; for newline-delimited JSON, it is a single line.
+	Data []byte
+}
+
+var (
+	StreamEventType = reflect.TypeOf(StreamEvent{})
+)
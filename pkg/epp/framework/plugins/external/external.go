@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package external holds the configuration and the failure handling shared by the plugins running
+// logic that is not part of the EPP, such as a gRPC service or a WebAssembly module.
+package external
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+// Config holds the parameters shared by the external plugins. It is embedded in their configuration.
+type Config struct {
+	// FailOpen decides the outcome of the filter and admission calls failing or timing out: when
+	// true, all the candidate endpoints are kept and the request is admitted, otherwise no endpoint
+	// is kept and the request is denied. Failed score calls score all endpoints 0 either way.
+	FailOpen bool `json:"failOpen"`
+	// ScorerCategory is the category of the scores given by the external logic.
+	ScorerCategory framework.ScorerCategory `json:"scorerCategory"`
+}
+
+// DefaultConfig holds the default parameters shared by the external plugins.
+var DefaultConfig = Config{
+	FailOpen:       true,
+	ScorerCategory: framework.Balance,
+}
+
+// Validate returns an error when the parameters are invalid.
+func (c Config) Validate() error {
+	switch c.ScorerCategory {
+	case framework.Affinity, framework.Distribution, framework.Balance:
+		return nil
+	default:
+		return fmt.Errorf("unknown scorerCategory '%s'", c.ScorerCategory)
+	}
+}
+
+// FailedFilter returns the candidate endpoints kept when a filter call fails.
+func (c Config) FailedFilter(endpoints []framework.Endpoint) []framework.Endpoint {
+	if c.FailOpen {
+		return endpoints
+	}
+	return []framework.Endpoint{}
+}
+
+// LogFailure logs a failed call of the plugin to its external logic.
+func (c Config) LogFailure(ctx context.Context, err error, typedName plugin.TypedName, call string, request *framework.LLMRequest) {
+	var requestID string
+	if request != nil {
+		requestID = request.RequestId
+	}
+	log.FromContext(ctx).V(logutil.DEFAULT).Error(err, "External plugin call failed", "plugin", typedName,
+		"call", call, "requestID", requestID, "failOpen", c.FailOpen)
+}
+
+// ClampScore bounds a score given by the external logic to [0, 1], the range of scorer scores. NaN
+// is scored 0.
+func ClampScore(score float64) float64 {
+	if math.IsNaN(score) || score < 0 {
+		return 0
+	}
+	return math.Min(score, 1)
+}
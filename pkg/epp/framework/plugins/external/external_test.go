@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, DefaultConfig.Validate())
+	assert.NoError(t, Config{ScorerCategory: framework.Affinity}.Validate())
+	assert.Error(t, Config{ScorerCategory: "Random"}.Validate())
+	assert.Error(t, Config{}.Validate())
+}
+
+func TestFailedFilter(t *testing.T) {
+	endpoints := []framework.Endpoint{framework.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: "pod-1"},
+	}, &fwkdl.Metrics{}, nil)}
+	assert.Equal(t, endpoints, Config{FailOpen: true}.FailedFilter(endpoints))
+	assert.Empty(t, Config{FailOpen: false}.FailedFilter(endpoints))
+}
+
+func TestClampScore(t *testing.T) {
+	tests := []struct {
+		score float64
+		want  float64
+	}{
+		{score: -0.5, want: 0},
+		{score: 0.5, want: 0.5},
+		{score: 1, want: 1},
+		{score: 7, want: 1},
+		{score: math.Inf(1), want: 1},
+		{score: math.NaN(), want: 0},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, ClampScore(test.score), "score %v", test.score)
+	}
+}
@@ -0,0 +1,1223 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: pkg/epp/framework/plugins/remote/api/v1alpha1/remote.proto
+
+// Package v1alpha1 defines the contract between the EPP and the services implementing plugins
+// out of process, which the remote plugin calls.
+
+package v1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Request is an inference request handled by the EPP.
+type Request struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the request.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The model the request targets, after traffic splitting.
+	TargetModel string `protobuf:"bytes,2,opt,name=target_model,json=targetModel,proto3" json:"target_model,omitempty"`
+	// The headers of the request.
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The parsed body of the request, encoded in JSON.
+	Body []byte `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// The priority of the request.
+	Priority int32 `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	// The time to first token objective of the request in milliseconds, or 0 if it has none.
+	TtftObjectiveMs int64 `protobuf:"varint,6,opt,name=ttft_objective_ms,json=ttftObjectiveMs,proto3" json:"ttft_objective_ms,omitempty"`
+	// The time per output token objective of the request in milliseconds, or 0 if it has none.
+	TpotObjectiveMs int64 `protobuf:"varint,7,opt,name=tpot_objective_ms,json=tpotObjectiveMs,proto3" json:"tpot_objective_ms,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Request) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *Request) GetTargetModel() string {
+	if x != nil {
+		return x.TargetModel
+	}
+	return ""
+}
+
+func (x *Request) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Request) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Request) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Request) GetTtftObjectiveMs() int64 {
+	if x != nil {
+		return x.TtftObjectiveMs
+	}
+	return 0
+}
+
+func (x *Request) GetTpotObjectiveMs() int64 {
+	if x != nil {
+		return x.TpotObjectiveMs
+	}
+	return 0
+}
+
+// Endpoint is a model server endpoint.
+type Endpoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the endpoint, as namespace/name, which identifies it in responses.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The IP address of the endpoint.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The port of the endpoint.
+	Port string `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	// The labels of the pod of the endpoint.
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The last metrics scraped from the endpoint, if known.
+	Metrics       *Metrics `protobuf:"bytes,5,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Endpoint) Reset() {
+	*x = Endpoint{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Endpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Endpoint) ProtoMessage() {}
+
+func (x *Endpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Endpoint.ProtoReflect.Descriptor instead.
+func (*Endpoint) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Endpoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Endpoint) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Endpoint) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *Endpoint) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Endpoint) GetMetrics() *Metrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// Metrics are the metrics of a model server endpoint.
+type Metrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of requests waiting to be served.
+	WaitingQueueSize int64 `protobuf:"varint,1,opt,name=waiting_queue_size,json=waitingQueueSize,proto3" json:"waiting_queue_size,omitempty"`
+	// The number of requests being served.
+	RunningRequestsSize int64 `protobuf:"varint,2,opt,name=running_requests_size,json=runningRequestsSize,proto3" json:"running_requests_size,omitempty"`
+	// The fraction of the KV cache in use, in [0, 1].
+	KvCacheUsagePercent float64 `protobuf:"fixed64,3,opt,name=kv_cache_usage_percent,json=kvCacheUsagePercent,proto3" json:"kv_cache_usage_percent,omitempty"`
+	// The models, including LoRA adapters, loaded on the endpoint.
+	ActiveModels map[string]int64 `protobuf:"bytes,4,rep,name=active_models,json=activeModels,proto3" json:"active_models,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The models, including LoRA adapters, waiting to be loaded on the endpoint.
+	WaitingModels map[string]int64 `protobuf:"bytes,5,rep,name=waiting_models,json=waitingModels,proto3" json:"waiting_models,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The maximum number of models that can be loaded on the endpoint.
+	MaxActiveModels int64 `protobuf:"varint,6,opt,name=max_active_models,json=maxActiveModels,proto3" json:"max_active_models,omitempty"`
+	// The time of the scrape, in milliseconds since the Unix epoch.
+	UpdateTimeUnixMs int64 `protobuf:"varint,7,opt,name=update_time_unix_ms,json=updateTimeUnixMs,proto3" json:"update_time_unix_ms,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Metrics) Reset() {
+	*x = Metrics{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Metrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metrics) ProtoMessage() {}
+
+func (x *Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
+func (*Metrics) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Metrics) GetWaitingQueueSize() int64 {
+	if x != nil {
+		return x.WaitingQueueSize
+	}
+	return 0
+}
+
+func (x *Metrics) GetRunningRequestsSize() int64 {
+	if x != nil {
+		return x.RunningRequestsSize
+	}
+	return 0
+}
+
+func (x *Metrics) GetKvCacheUsagePercent() float64 {
+	if x != nil {
+		return x.KvCacheUsagePercent
+	}
+	return 0
+}
+
+func (x *Metrics) GetActiveModels() map[string]int64 {
+	if x != nil {
+		return x.ActiveModels
+	}
+	return nil
+}
+
+func (x *Metrics) GetWaitingModels() map[string]int64 {
+	if x != nil {
+		return x.WaitingModels
+	}
+	return nil
+}
+
+func (x *Metrics) GetMaxActiveModels() int64 {
+	if x != nil {
+		return x.MaxActiveModels
+	}
+	return 0
+}
+
+func (x *Metrics) GetUpdateTimeUnixMs() int64 {
+	if x != nil {
+		return x.UpdateTimeUnixMs
+	}
+	return 0
+}
+
+type FilterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the remote plugin calling the service.
+	PluginName string   `protobuf:"bytes,1,opt,name=plugin_name,json=pluginName,proto3" json:"plugin_name,omitempty"`
+	Request    *Request `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// The candidate endpoints.
+	Endpoints     []*Endpoint `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterRequest) Reset() {
+	*x = FilterRequest{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterRequest) ProtoMessage() {}
+
+func (x *FilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterRequest.ProtoReflect.Descriptor instead.
+func (*FilterRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FilterRequest) GetPluginName() string {
+	if x != nil {
+		return x.PluginName
+	}
+	return ""
+}
+
+func (x *FilterRequest) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *FilterRequest) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type FilterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The names of the candidate endpoints the request may be sent to.
+	Endpoints     []string `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterResponse) Reset() {
+	*x = FilterResponse{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterResponse) ProtoMessage() {}
+
+func (x *FilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterResponse.ProtoReflect.Descriptor instead.
+func (*FilterResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FilterResponse) GetEndpoints() []string {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type ScoreRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the remote plugin calling the service.
+	PluginName string   `protobuf:"bytes,1,opt,name=plugin_name,json=pluginName,proto3" json:"plugin_name,omitempty"`
+	Request    *Request `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// The candidate endpoints.
+	Endpoints     []*Endpoint `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreRequest) Reset() {
+	*x = ScoreRequest{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreRequest) ProtoMessage() {}
+
+func (x *ScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreRequest.ProtoReflect.Descriptor instead.
+func (*ScoreRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ScoreRequest) GetPluginName() string {
+	if x != nil {
+		return x.PluginName
+	}
+	return ""
+}
+
+func (x *ScoreRequest) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ScoreRequest) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type ScoreResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The scores of the candidate endpoints in [0, 1], by endpoint name. Endpoints without a score
+	// are scored 0, and scores out of [0, 1] are clamped to it.
+	Scores        map[string]float64 `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreResponse) Reset() {
+	*x = ScoreResponse{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreResponse) ProtoMessage() {}
+
+func (x *ScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreResponse.ProtoReflect.Descriptor instead.
+func (*ScoreResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScoreResponse) GetScores() map[string]float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type AdmitRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the remote plugin calling the service.
+	PluginName string   `protobuf:"bytes,1,opt,name=plugin_name,json=pluginName,proto3" json:"plugin_name,omitempty"`
+	Request    *Request `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// The endpoints the request may be scheduled to.
+	Endpoints     []*Endpoint `protobuf:"bytes,3,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdmitRequestRequest) Reset() {
+	*x = AdmitRequestRequest{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdmitRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdmitRequestRequest) ProtoMessage() {}
+
+func (x *AdmitRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdmitRequestRequest.ProtoReflect.Descriptor instead.
+func (*AdmitRequestRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AdmitRequestRequest) GetPluginName() string {
+	if x != nil {
+		return x.PluginName
+	}
+	return ""
+}
+
+func (x *AdmitRequestRequest) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *AdmitRequestRequest) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type AdmitRequestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the request is admitted.
+	Admitted bool `protobuf:"varint,1,opt,name=admitted,proto3" json:"admitted,omitempty"`
+	// The reason why the request is denied.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdmitRequestResponse) Reset() {
+	*x = AdmitRequestResponse{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdmitRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdmitRequestResponse) ProtoMessage() {}
+
+func (x *AdmitRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdmitRequestResponse.ProtoReflect.Descriptor instead.
+func (*AdmitRequestResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AdmitRequestResponse) GetAdmitted() bool {
+	if x != nil {
+		return x.Admitted
+	}
+	return false
+}
+
+func (x *AdmitRequestResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Endpoints are the endpoints selected by a scheduling profile.
+type Endpoints struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoints     []*Endpoint            `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Endpoints) Reset() {
+	*x = Endpoints{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Endpoints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Endpoints) ProtoMessage() {}
+
+func (x *Endpoints) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Endpoints.ProtoReflect.Descriptor instead.
+func (*Endpoints) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Endpoints) GetEndpoints() []*Endpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type PreRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the remote plugin calling the service.
+	PluginName string   `protobuf:"bytes,1,opt,name=plugin_name,json=pluginName,proto3" json:"plugin_name,omitempty"`
+	Request    *Request `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// The endpoints selected by each scheduling profile which ran, by profile name.
+	ProfileResults map[string]*Endpoints `protobuf:"bytes,3,rep,name=profile_results,json=profileResults,proto3" json:"profile_results,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The name of the profile whose endpoints serve the request.
+	PrimaryProfileName string `protobuf:"bytes,4,opt,name=primary_profile_name,json=primaryProfileName,proto3" json:"primary_profile_name,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PreRequestRequest) Reset() {
+	*x = PreRequestRequest{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRequestRequest) ProtoMessage() {}
+
+func (x *PreRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRequestRequest.ProtoReflect.Descriptor instead.
+func (*PreRequestRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PreRequestRequest) GetPluginName() string {
+	if x != nil {
+		return x.PluginName
+	}
+	return ""
+}
+
+func (x *PreRequestRequest) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *PreRequestRequest) GetProfileResults() map[string]*Endpoints {
+	if x != nil {
+		return x.ProfileResults
+	}
+	return nil
+}
+
+func (x *PreRequestRequest) GetPrimaryProfileName() string {
+	if x != nil {
+		return x.PrimaryProfileName
+	}
+	return ""
+}
+
+type PreRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreRequestResponse) Reset() {
+	*x = PreRequestResponse{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreRequestResponse) ProtoMessage() {}
+
+func (x *PreRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreRequestResponse.ProtoReflect.Descriptor instead.
+func (*PreRequestResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{11}
+}
+
+// Usage is the token usage of a response.
+type Usage struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PromptTokens       int64                  `protobuf:"varint,1,opt,name=prompt_tokens,json=promptTokens,proto3" json:"prompt_tokens,omitempty"`
+	CompletionTokens   int64                  `protobuf:"varint,2,opt,name=completion_tokens,json=completionTokens,proto3" json:"completion_tokens,omitempty"`
+	TotalTokens        int64                  `protobuf:"varint,3,opt,name=total_tokens,json=totalTokens,proto3" json:"total_tokens,omitempty"`
+	CachedPromptTokens int64                  `protobuf:"varint,4,opt,name=cached_prompt_tokens,json=cachedPromptTokens,proto3" json:"cached_prompt_tokens,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Usage) Reset() {
+	*x = Usage{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Usage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Usage) ProtoMessage() {}
+
+func (x *Usage) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Usage.ProtoReflect.Descriptor instead.
+func (*Usage) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Usage) GetPromptTokens() int64 {
+	if x != nil {
+		return x.PromptTokens
+	}
+	return 0
+}
+
+func (x *Usage) GetCompletionTokens() int64 {
+	if x != nil {
+		return x.CompletionTokens
+	}
+	return 0
+}
+
+func (x *Usage) GetTotalTokens() int64 {
+	if x != nil {
+		return x.TotalTokens
+	}
+	return 0
+}
+
+func (x *Usage) GetCachedPromptTokens() int64 {
+	if x != nil {
+		return x.CachedPromptTokens
+	}
+	return 0
+}
+
+type ResponseCompleteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the remote plugin calling the service.
+	PluginName string   `protobuf:"bytes,1,opt,name=plugin_name,json=pluginName,proto3" json:"plugin_name,omitempty"`
+	Request    *Request `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// The endpoint which served the request, without metrics.
+	TargetEndpoint *Endpoint `protobuf:"bytes,3,opt,name=target_endpoint,json=targetEndpoint,proto3" json:"target_endpoint,omitempty"`
+	// The headers of the response.
+	ResponseHeaders map[string]string `protobuf:"bytes,4,rep,name=response_headers,json=responseHeaders,proto3" json:"response_headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The token usage of the response.
+	Usage *Usage `protobuf:"bytes,5,opt,name=usage,proto3" json:"usage,omitempty"`
+	// The reason for which the request terminated: Completed, ClientCancelled, UpstreamError or
+	// EPPError.
+	TerminationReason string `protobuf:"bytes,6,opt,name=termination_reason,json=terminationReason,proto3" json:"termination_reason,omitempty"`
+	// The HTTP status code returned by the model server, or 0 if no response headers were received.
+	StatusCode int32 `protobuf:"varint,7,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// The error which terminated the request, if any.
+	Error         string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResponseCompleteRequest) Reset() {
+	*x = ResponseCompleteRequest{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResponseCompleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseCompleteRequest) ProtoMessage() {}
+
+func (x *ResponseCompleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseCompleteRequest.ProtoReflect.Descriptor instead.
+func (*ResponseCompleteRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResponseCompleteRequest) GetPluginName() string {
+	if x != nil {
+		return x.PluginName
+	}
+	return ""
+}
+
+func (x *ResponseCompleteRequest) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ResponseCompleteRequest) GetTargetEndpoint() *Endpoint {
+	if x != nil {
+		return x.TargetEndpoint
+	}
+	return nil
+}
+
+func (x *ResponseCompleteRequest) GetResponseHeaders() map[string]string {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
+}
+
+func (x *ResponseCompleteRequest) GetUsage() *Usage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *ResponseCompleteRequest) GetTerminationReason() string {
+	if x != nil {
+		return x.TerminationReason
+	}
+	return ""
+}
+
+func (x *ResponseCompleteRequest) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ResponseCompleteRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ResponseCompleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResponseCompleteResponse) Reset() {
+	*x = ResponseCompleteResponse{}
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResponseCompleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseCompleteResponse) ProtoMessage() {}
+
+func (x *ResponseCompleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseCompleteResponse.ProtoReflect.Descriptor instead.
+func (*ResponseCompleteResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP(), []int{14}
+}
+
+var File_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto protoreflect.FileDescriptor
+
+const file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDesc = "" +
+	"\n" +
+	":pkg/epp/framework/plugins/remote/api/v1alpha1/remote.proto\x12\x1dinference.epp.remote.v1alpha1\"\xde\x02\n" +
+	"\aRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12!\n" +
+	"\ftarget_model\x18\x02 \x01(\tR\vtargetModel\x12M\n" +
+	"\aheaders\x18\x03 \x03(\v23.inference.epp.remote.v1alpha1.Request.HeadersEntryR\aheaders\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\fR\x04body\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x05R\bpriority\x12*\n" +
+	"\x11ttft_objective_ms\x18\x06 \x01(\x03R\x0fttftObjectiveMs\x12*\n" +
+	"\x11tpot_objective_ms\x18\a \x01(\x03R\x0ftpotObjectiveMs\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x96\x02\n" +
+	"\bEndpoint\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\tR\x04port\x12K\n" +
+	"\x06labels\x18\x04 \x03(\v23.inference.epp.remote.v1alpha1.Endpoint.LabelsEntryR\x06labels\x12@\n" +
+	"\ametrics\x18\x05 \x01(\v2&.inference.epp.remote.v1alpha1.MetricsR\ametrics\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbf\x04\n" +
+	"\aMetrics\x12,\n" +
+	"\x12waiting_queue_size\x18\x01 \x01(\x03R\x10waitingQueueSize\x122\n" +
+	"\x15running_requests_size\x18\x02 \x01(\x03R\x13runningRequestsSize\x123\n" +
+	"\x16kv_cache_usage_percent\x18\x03 \x01(\x01R\x13kvCacheUsagePercent\x12]\n" +
+	"\ractive_models\x18\x04 \x03(\v28.inference.epp.remote.v1alpha1.Metrics.ActiveModelsEntryR\factiveModels\x12`\n" +
+	"\x0ewaiting_models\x18\x05 \x03(\v29.inference.epp.remote.v1alpha1.Metrics.WaitingModelsEntryR\rwaitingModels\x12*\n" +
+	"\x11max_active_models\x18\x06 \x01(\x03R\x0fmaxActiveModels\x12-\n" +
+	"\x13update_time_unix_ms\x18\a \x01(\x03R\x10updateTimeUnixMs\x1a?\n" +
+	"\x11ActiveModelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a@\n" +
+	"\x12WaitingModelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xb9\x01\n" +
+	"\rFilterRequest\x12\x1f\n" +
+	"\vplugin_name\x18\x01 \x01(\tR\n" +
+	"pluginName\x12@\n" +
+	"\arequest\x18\x02 \x01(\v2&.inference.epp.remote.v1alpha1.RequestR\arequest\x12E\n" +
+	"\tendpoints\x18\x03 \x03(\v2'.inference.epp.remote.v1alpha1.EndpointR\tendpoints\".\n" +
+	"\x0eFilterResponse\x12\x1c\n" +
+	"\tendpoints\x18\x01 \x03(\tR\tendpoints\"\xb8\x01\n" +
+	"\fScoreRequest\x12\x1f\n" +
+	"\vplugin_name\x18\x01 \x01(\tR\n" +
+	"pluginName\x12@\n" +
+	"\arequest\x18\x02 \x01(\v2&.inference.epp.remote.v1alpha1.RequestR\arequest\x12E\n" +
+	"\tendpoints\x18\x03 \x03(\v2'.inference.epp.remote.v1alpha1.EndpointR\tendpoints\"\x9c\x01\n" +
+	"\rScoreResponse\x12P\n" +
+	"\x06scores\x18\x01 \x03(\v28.inference.epp.remote.v1alpha1.ScoreResponse.ScoresEntryR\x06scores\x1a9\n" +
+	"\vScoresEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xbf\x01\n" +
+	"\x13AdmitRequestRequest\x12\x1f\n" +
+	"\vplugin_name\x18\x01 \x01(\tR\n" +
+	"pluginName\x12@\n" +
+	"\arequest\x18\x02 \x01(\v2&.inference.epp.remote.v1alpha1.RequestR\arequest\x12E\n" +
+	"\tendpoints\x18\x03 \x03(\v2'.inference.epp.remote.v1alpha1.EndpointR\tendpoints\"J\n" +
+	"\x14AdmitRequestResponse\x12\x1a\n" +
+	"\badmitted\x18\x01 \x01(\bR\badmitted\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"R\n" +
+	"\tEndpoints\x12E\n" +
+	"\tendpoints\x18\x01 \x03(\v2'.inference.epp.remote.v1alpha1.EndpointR\tendpoints\"\x84\x03\n" +
+	"\x11PreRequestRequest\x12\x1f\n" +
+	"\vplugin_name\x18\x01 \x01(\tR\n" +
+	"pluginName\x12@\n" +
+	"\arequest\x18\x02 \x01(\v2&.inference.epp.remote.v1alpha1.RequestR\arequest\x12m\n" +
+	"\x0fprofile_results\x18\x03 \x03(\v2D.inference.epp.remote.v1alpha1.PreRequestRequest.ProfileResultsEntryR\x0eprofileResults\x120\n" +
+	"\x14primary_profile_name\x18\x04 \x01(\tR\x12primaryProfileName\x1ak\n" +
+	"\x13ProfileResultsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12>\n" +
+	"\x05value\x18\x02 \x01(\v2(.inference.epp.remote.v1alpha1.EndpointsR\x05value:\x028\x01\"\x14\n" +
+	"\x12PreRequestResponse\"\xae\x01\n" +
+	"\x05Usage\x12#\n" +
+	"\rprompt_tokens\x18\x01 \x01(\x03R\fpromptTokens\x12+\n" +
+	"\x11completion_tokens\x18\x02 \x01(\x03R\x10completionTokens\x12!\n" +
+	"\ftotal_tokens\x18\x03 \x01(\x03R\vtotalTokens\x120\n" +
+	"\x14cached_prompt_tokens\x18\x04 \x01(\x03R\x12cachedPromptTokens\"\xac\x04\n" +
+	"\x17ResponseCompleteRequest\x12\x1f\n" +
+	"\vplugin_name\x18\x01 \x01(\tR\n" +
+	"pluginName\x12@\n" +
+	"\arequest\x18\x02 \x01(\v2&.inference.epp.remote.v1alpha1.RequestR\arequest\x12P\n" +
+	"\x0ftarget_endpoint\x18\x03 \x01(\v2'.inference.epp.remote.v1alpha1.EndpointR\x0etargetEndpoint\x12v\n" +
+	"\x10response_headers\x18\x04 \x03(\v2K.inference.epp.remote.v1alpha1.ResponseCompleteRequest.ResponseHeadersEntryR\x0fresponseHeaders\x12:\n" +
+	"\x05usage\x18\x05 \x01(\v2$.inference.epp.remote.v1alpha1.UsageR\x05usage\x12-\n" +
+	"\x12termination_reason\x18\x06 \x01(\tR\x11terminationReason\x12\x1f\n" +
+	"\vstatus_code\x18\a \x01(\x05R\n" +
+	"statusCode\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x1aB\n" +
+	"\x14ResponseHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x1a\n" +
+	"\x18ResponseCompleteResponse2\xcb\x04\n" +
+	"\fRemotePlugin\x12e\n" +
+	"\x06Filter\x12,.inference.epp.remote.v1alpha1.FilterRequest\x1a-.inference.epp.remote.v1alpha1.FilterResponse\x12b\n" +
+	"\x05Score\x12+.inference.epp.remote.v1alpha1.ScoreRequest\x1a,.inference.epp.remote.v1alpha1.ScoreResponse\x12w\n" +
+	"\fAdmitRequest\x122.inference.epp.remote.v1alpha1.AdmitRequestRequest\x1a3.inference.epp.remote.v1alpha1.AdmitRequestResponse\x12q\n" +
+	"\n" +
+	"PreRequest\x120.inference.epp.remote.v1alpha1.PreRequestRequest\x1a1.inference.epp.remote.v1alpha1.PreRequestResponse\x12\x83\x01\n" +
+	"\x10ResponseComplete\x126.inference.epp.remote.v1alpha1.ResponseCompleteRequest\x1a7.inference.epp.remote.v1alpha1.ResponseCompleteResponseB[ZYsigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/remote/api/v1alpha1b\x06proto3"
+
+var (
+	file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescOnce sync.Once
+	file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescData []byte
+)
+
+func file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescGZIP() []byte {
+	file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescOnce.Do(func() {
+		file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDesc), len(file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDesc)))
+	})
+	return file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDescData
+}
+
+var file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_goTypes = []any{
+	(*Request)(nil),                  // 0: inference.epp.remote.v1alpha1.Request
+	(*Endpoint)(nil),                 // 1: inference.epp.remote.v1alpha1.Endpoint
+	(*Metrics)(nil),                  // 2: inference.epp.remote.v1alpha1.Metrics
+	(*FilterRequest)(nil),            // 3: inference.epp.remote.v1alpha1.FilterRequest
+	(*FilterResponse)(nil),           // 4: inference.epp.remote.v1alpha1.FilterResponse
+	(*ScoreRequest)(nil),             // 5: inference.epp.remote.v1alpha1.ScoreRequest
+	(*ScoreResponse)(nil),            // 6: inference.epp.remote.v1alpha1.ScoreResponse
+	(*AdmitRequestRequest)(nil),      // 7: inference.epp.remote.v1alpha1.AdmitRequestRequest
+	(*AdmitRequestResponse)(nil),     // 8: inference.epp.remote.v1alpha1.AdmitRequestResponse
+	(*Endpoints)(nil),                // 9: inference.epp.remote.v1alpha1.Endpoints
+	(*PreRequestRequest)(nil),        // 10: inference.epp.remote.v1alpha1.PreRequestRequest
+	(*PreRequestResponse)(nil),       // 11: inference.epp.remote.v1alpha1.PreRequestResponse
+	(*Usage)(nil),                    // 12: inference.epp.remote.v1alpha1.Usage
+	(*ResponseCompleteRequest)(nil),  // 13: inference.epp.remote.v1alpha1.ResponseCompleteRequest
+	(*ResponseCompleteResponse)(nil), // 14: inference.epp.remote.v1alpha1.ResponseCompleteResponse
+	nil,                              // 15: inference.epp.remote.v1alpha1.Request.HeadersEntry
+	nil,                              // 16: inference.epp.remote.v1alpha1.Endpoint.LabelsEntry
+	nil,                              // 17: inference.epp.remote.v1alpha1.Metrics.ActiveModelsEntry
+	nil,                              // 18: inference.epp.remote.v1alpha1.Metrics.WaitingModelsEntry
+	nil,                              // 19: inference.epp.remote.v1alpha1.ScoreResponse.ScoresEntry
+	nil,                              // 20: inference.epp.remote.v1alpha1.PreRequestRequest.ProfileResultsEntry
+	nil,                              // 21: inference.epp.remote.v1alpha1.ResponseCompleteRequest.ResponseHeadersEntry
+}
+var file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_depIdxs = []int32{
+	15, // 0: inference.epp.remote.v1alpha1.Request.headers:type_name -> inference.epp.remote.v1alpha1.Request.HeadersEntry
+	16, // 1: inference.epp.remote.v1alpha1.Endpoint.labels:type_name -> inference.epp.remote.v1alpha1.Endpoint.LabelsEntry
+	2,  // 2: inference.epp.remote.v1alpha1.Endpoint.metrics:type_name -> inference.epp.remote.v1alpha1.Metrics
+	17, // 3: inference.epp.remote.v1alpha1.Metrics.active_models:type_name -> inference.epp.remote.v1alpha1.Metrics.ActiveModelsEntry
+	18, // 4: inference.epp.remote.v1alpha1.Metrics.waiting_models:type_name -> inference.epp.remote.v1alpha1.Metrics.WaitingModelsEntry
+	0,  // 5: inference.epp.remote.v1alpha1.FilterRequest.request:type_name -> inference.epp.remote.v1alpha1.Request
+	1,  // 6: inference.epp.remote.v1alpha1.FilterRequest.endpoints:type_name -> inference.epp.remote.v1alpha1.Endpoint
+	0,  // 7: inference.epp.remote.v1alpha1.ScoreRequest.request:type_name -> inference.epp.remote.v1alpha1.Request
+	1,  // 8: inference.epp.remote.v1alpha1.ScoreRequest.endpoints:type_name -> inference.epp.remote.v1alpha1.Endpoint
+	19, // 9: inference.epp.remote.v1alpha1.ScoreResponse.scores:type_name -> inference.epp.remote.v1alpha1.ScoreResponse.ScoresEntry
+	0,  // 10: inference.epp.remote.v1alpha1.AdmitRequestRequest.request:type_name -> inference.epp.remote.v1alpha1.Request
+	1,  // 11: inference.epp.remote.v1alpha1.AdmitRequestRequest.endpoints:type_name -> inference.epp.remote.v1alpha1.Endpoint
+	1,  // 12: inference.epp.remote.v1alpha1.Endpoints.endpoints:type_name -> inference.epp.remote.v1alpha1.Endpoint
+	0,  // 13: inference.epp.remote.v1alpha1.PreRequestRequest.request:type_name -> inference.epp.remote.v1alpha1.Request
+	20, // 14: inference.epp.remote.v1alpha1.PreRequestRequest.profile_results:type_name -> inference.epp.remote.v1alpha1.PreRequestRequest.ProfileResultsEntry
+	0,  // 15: inference.epp.remote.v1alpha1.ResponseCompleteRequest.request:type_name -> inference.epp.remote.v1alpha1.Request
+	1,  // 16: inference.epp.remote.v1alpha1.ResponseCompleteRequest.target_endpoint:type_name -> inference.epp.remote.v1alpha1.Endpoint
+	21, // 17: inference.epp.remote.v1alpha1.ResponseCompleteRequest.response_headers:type_name -> inference.epp.remote.v1alpha1.ResponseCompleteRequest.ResponseHeadersEntry
+	12, // 18: inference.epp.remote.v1alpha1.ResponseCompleteRequest.usage:type_name -> inference.epp.remote.v1alpha1.Usage
+	9,  // 19: inference.epp.remote.v1alpha1.PreRequestRequest.ProfileResultsEntry.value:type_name -> inference.epp.remote.v1alpha1.Endpoints
+	3,  // 20: inference.epp.remote.v1alpha1.RemotePlugin.Filter:input_type -> inference.epp.remote.v1alpha1.FilterRequest
+	5,  // 21: inference.epp.remote.v1alpha1.RemotePlugin.Score:input_type -> inference.epp.remote.v1alpha1.ScoreRequest
+	7,  // 22: inference.epp.remote.v1alpha1.RemotePlugin.AdmitRequest:input_type -> inference.epp.remote.v1alpha1.AdmitRequestRequest
+	10, // 23: inference.epp.remote.v1alpha1.RemotePlugin.PreRequest:input_type -> inference.epp.remote.v1alpha1.PreRequestRequest
+	13, // 24: inference.epp.remote.v1alpha1.RemotePlugin.ResponseComplete:input_type -> inference.epp.remote.v1alpha1.ResponseCompleteRequest
+	4,  // 25: inference.epp.remote.v1alpha1.RemotePlugin.Filter:output_type -> inference.epp.remote.v1alpha1.FilterResponse
+	6,  // 26: inference.epp.remote.v1alpha1.RemotePlugin.Score:output_type -> inference.epp.remote.v1alpha1.ScoreResponse
+	8,  // 27: inference.epp.remote.v1alpha1.RemotePlugin.AdmitRequest:output_type -> inference.epp.remote.v1alpha1.AdmitRequestResponse
+	11, // 28: inference.epp.remote.v1alpha1.RemotePlugin.PreRequest:output_type -> inference.epp.remote.v1alpha1.PreRequestResponse
+	14, // 29: inference.epp.remote.v1alpha1.RemotePlugin.ResponseComplete:output_type -> inference.epp.remote.v1alpha1.ResponseCompleteResponse
+	25, // [25:30] is the sub-list for method output_type
+	20, // [20:25] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_init() }
+func file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_init() {
+	if File_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDesc), len(file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_goTypes,
+		DependencyIndexes: file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_depIdxs,
+		MessageInfos:      file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_msgTypes,
+	}.Build()
+	File_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto = out.File
+	file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_goTypes = nil
+	file_pkg_epp_framework_plugins_remote_api_v1alpha1_remote_proto_depIdxs = nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	"encoding/json"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	pb "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/remote/api/v1alpha1"
+)
+
+// endpointName returns the name identifying the endpoint in the messages exchanged with the service.
+func endpointName(metadata *fwkdl.EndpointMetadata) string {
+	if metadata == nil {
+		return ""
+	}
+	return metadata.NamespacedName.String()
+}
+
+func toRequest(request *framework.LLMRequest) *pb.Request {
+	if request == nil {
+		return nil
+	}
+	message := &pb.Request{
+		RequestId:       request.RequestId,
+		TargetModel:     request.TargetModel,
+		Headers:         request.Headers,
+		Priority:        int32(request.Objectives.Priority),
+		TtftObjectiveMs: request.Objectives.TTFT.Milliseconds(),
+		TpotObjectiveMs: request.Objectives.TPOT.Milliseconds(),
+	}
+	if request.Body != nil {
+		// The body only holds JSON decoded fields, so encoding it cannot fail.
+		message.Body, _ = json.Marshal(request.Body)
+	}
+	return message
+}
+
+func toEndpoints(endpoints []framework.Endpoint) []*pb.Endpoint {
+	messages := make([]*pb.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		messages = append(messages, toEndpoint(endpoint.GetMetadata(), endpoint.GetMetrics()))
+	}
+	return messages
+}
+
+func toEndpoint(metadata *fwkdl.EndpointMetadata, metrics *fwkdl.Metrics) *pb.Endpoint {
+	if metadata == nil {
+		return nil
+	}
+	message := &pb.Endpoint{
+		Name:    endpointName(metadata),
+		Address: metadata.Address,
+		Port:    metadata.Port,
+		Labels:  metadata.Labels,
+	}
+	if metrics != nil {
+		message.Metrics = &pb.Metrics{
+			WaitingQueueSize:    int64(metrics.WaitingQueueSize),
+			RunningRequestsSize: int64(metrics.RunningRequestsSize),
+			KvCacheUsagePercent: metrics.KVCacheUsagePercent,
+			ActiveModels:        toCounts(metrics.ActiveModels),
+			WaitingModels:       toCounts(metrics.WaitingModels),
+			MaxActiveModels:     int64(metrics.MaxActiveModels),
+		}
+		if !metrics.UpdateTime.IsZero() {
+			message.Metrics.UpdateTimeUnixMs = metrics.UpdateTime.UnixMilli()
+		}
+	}
+	return message
+}
+
+func toCounts(counts map[string]int) map[string]int64 {
+	if len(counts) == 0 {
+		return nil
+	}
+	messages := make(map[string]int64, len(counts))
+	for key, count := range counts {
+		messages[key] = int64(count)
+	}
+	return messages
+}
+
+func toUsage(usage requestcontrol.Usage) *pb.Usage {
+	message := &pb.Usage{
+		PromptTokens:     int64(usage.PromptTokens),
+		CompletionTokens: int64(usage.CompletionTokens),
+		TotalTokens:      int64(usage.TotalTokens),
+	}
+	if usage.PromptTokenDetails != nil {
+		message.CachedPromptTokens = int64(usage.PromptTokenDetails.CachedTokens)
+	}
+	return message
+}
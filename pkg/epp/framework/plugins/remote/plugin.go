@@ -0,0 +1,322 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package remote provides a plugin proxying scheduling and request control extension points to an
+// external gRPC service, so that plugins can be implemented out of process, in any language.
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/external"
+	pb "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/remote/api/v1alpha1"
+)
+
+const (
+	// RemotePluginType is the type of the remote plugin.
+	RemotePluginType = "remote"
+
+	// The extension points a remote plugin can serve.
+	FilterExtensionPoint           = "Filter"
+	ScorerExtensionPoint           = "Scorer"
+	AdmitRequestExtensionPoint     = "AdmitRequest"
+	PreRequestExtensionPoint       = requestcontrol.PreRequestExtensionPoint
+	ResponseCompleteExtensionPoint = requestcontrol.ResponseCompleteExtensionPoint
+)
+
+// extensionPoints are the extension points a remote plugin can serve.
+var extensionPoints = []string{
+	FilterExtensionPoint,
+	ScorerExtensionPoint,
+	AdmitRequestExtensionPoint,
+	PreRequestExtensionPoint,
+	ResponseCompleteExtensionPoint,
+}
+
+// Config holds the remote plugin parameters.
+type Config struct {
+	// Address is the target of the gRPC service, such as "localhost:9000" or "dns:///service:9000".
+	Address string `json:"address"`
+	// ExtensionPoints are the extension points forwarded to the service. The plugin does nothing at
+	// the other extension points.
+	ExtensionPoints []string `json:"extensionPoints"`
+	// Timeout bounds each call to the service.
+	Timeout metav1.Duration `json:"timeout"`
+	external.Config
+	// TLS connects to the service over TLS, verified with the system root certificates.
+	TLS bool `json:"tls"`
+}
+
+// DefaultConfig holds the default remote plugin parameters.
+var DefaultConfig = Config{
+	Timeout: metav1.Duration{Duration: 100 * time.Millisecond},
+	Config:  external.DefaultConfig,
+}
+
+// compile-time type assertion
+var (
+	_ framework.Filter                = &Plugin{}
+	_ framework.Scorer                = &Plugin{}
+	_ requestcontrol.AdmissionPlugin  = &Plugin{}
+	_ requestcontrol.PreRequest       = &Plugin{}
+	_ requestcontrol.ResponseComplete = &Plugin{}
+)
+
+// RemotePluginFactory defines the factory function for the remote plugin. The connection to the
+// service is established lazily and closed when the context of the handle is done.
+func RemotePluginFactory(name string, rawParameters json.RawMessage, handle plugin.Handle) (plugin.Plugin, error) {
+	parameters := DefaultConfig
+	if rawParameters != nil {
+		if err := plugin.StrictUnmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the %s plugin. Error: %s", RemotePluginType, err)
+		}
+	}
+	if err := parameters.validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s configuration: %w", RemotePluginType, err)
+	}
+
+	creds := insecure.NewCredentials()
+	if parameters.TLS {
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	conn, err := grpc.NewClient(parameters.Address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the client of the %s plugin - %w", RemotePluginType, err)
+	}
+	go func() {
+		<-handle.Context().Done()
+		_ = conn.Close()
+	}()
+
+	log.FromContext(handle.Context()).V(logutil.DEFAULT).Info("Remote plugin initialized", "name", name, "config", parameters)
+	return New(parameters, pb.NewRemotePluginClient(conn)).WithName(name), nil
+}
+
+func (c Config) validate() error {
+	var errs []error
+	if c.Address == "" {
+		errs = append(errs, errors.New("address is required"))
+	}
+	if len(c.ExtensionPoints) == 0 {
+		errs = append(errs, errors.New("extensionPoints must not be empty"))
+	}
+	for _, extensionPoint := range c.ExtensionPoints {
+		if !slices.Contains(extensionPoints, extensionPoint) {
+			errs = append(errs, fmt.Errorf("unknown extension point '%s', expected one of %v", extensionPoint, extensionPoints))
+		}
+	}
+	if c.Timeout.Duration <= 0 {
+		errs = append(errs, errors.New("timeout must be positive"))
+	}
+	if err := c.Config.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// New initializes a new remote plugin calling the given client and returns its pointer.
+func New(config Config, client pb.RemotePluginClient) *Plugin {
+	enabled := map[string]bool{}
+	for _, extensionPoint := range config.ExtensionPoints {
+		enabled[extensionPoint] = true
+	}
+	return &Plugin{
+		typedName: plugin.TypedName{Type: RemotePluginType, Name: RemotePluginType},
+		config:    config,
+		enabled:   enabled,
+		client:    client,
+	}
+}
+
+// Plugin forwards the extension points enabled in its configuration to a gRPC service, sending all
+// the candidate endpoints of a request in a single call.
+type Plugin struct {
+	typedName plugin.TypedName
+	config    Config
+	enabled   map[string]bool
+	client    pb.RemotePluginClient
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *Plugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin.
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+// Category returns the category of the scores returned by the service.
+func (p *Plugin) Category() framework.ScorerCategory {
+	return p.config.ScorerCategory
+}
+
+// Filter returns the candidate endpoints kept by the service.
+func (p *Plugin) Filter(ctx context.Context, _ *framework.CycleState, request *framework.LLMRequest, endpoints []framework.Endpoint) []framework.Endpoint {
+	if !p.enabled[FilterExtensionPoint] {
+		return endpoints
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.config.Timeout.Duration)
+	defer cancel()
+	response, err := p.client.Filter(ctx, &pb.FilterRequest{
+		PluginName: p.typedName.Name,
+		Request:    toRequest(request),
+		Endpoints:  toEndpoints(endpoints),
+	})
+	if err != nil {
+		p.config.LogFailure(ctx, err, p.typedName, FilterExtensionPoint, request)
+		return p.config.FailedFilter(endpoints)
+	}
+
+	kept := make(map[string]bool, len(response.GetEndpoints()))
+	for _, name := range response.GetEndpoints() {
+		kept[name] = true
+	}
+	filtered := make([]framework.Endpoint, 0, len(kept))
+	for _, endpoint := range endpoints {
+		if kept[endpointName(endpoint.GetMetadata())] {
+			filtered = append(filtered, endpoint)
+		}
+	}
+	return filtered
+}
+
+// Score returns the scores of the candidate endpoints given by the service.
+func (p *Plugin) Score(ctx context.Context, _ *framework.CycleState, request *framework.LLMRequest, endpoints []framework.Endpoint) map[framework.Endpoint]float64 {
+	scores := make(map[framework.Endpoint]float64, len(endpoints))
+	if !p.enabled[ScorerExtensionPoint] {
+		return scores
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.config.Timeout.Duration)
+	defer cancel()
+	response, err := p.client.Score(ctx, &pb.ScoreRequest{
+		PluginName: p.typedName.Name,
+		Request:    toRequest(request),
+		Endpoints:  toEndpoints(endpoints),
+	})
+	if err != nil {
+		p.config.LogFailure(ctx, err, p.typedName, ScorerExtensionPoint, request)
+		return scores
+	}
+
+	for _, endpoint := range endpoints {
+		scores[endpoint] = external.ClampScore(response.GetScores()[endpointName(endpoint.GetMetadata())])
+	}
+	return scores
+}
+
+// AdmitRequest returns the reason given by the service for denying the request, if it does.
+func (p *Plugin) AdmitRequest(ctx context.Context, request *framework.LLMRequest, endpoints []framework.Endpoint) error {
+	if !p.enabled[AdmitRequestExtensionPoint] {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.config.Timeout.Duration)
+	defer cancel()
+	response, err := p.client.AdmitRequest(ctx, &pb.AdmitRequestRequest{
+		PluginName: p.typedName.Name,
+		Request:    toRequest(request),
+		Endpoints:  toEndpoints(endpoints),
+	})
+	if err != nil {
+		p.config.LogFailure(ctx, err, p.typedName, AdmitRequestExtensionPoint, request)
+		if p.config.FailOpen {
+			return nil
+		}
+		return fmt.Errorf("remote plugin '%s' failed - %w", p.typedName.Name, err)
+	}
+	if !response.GetAdmitted() {
+		if response.GetReason() == "" {
+			return fmt.Errorf("denied by remote plugin '%s'", p.typedName.Name)
+		}
+		return errors.New(response.GetReason())
+	}
+	return nil
+}
+
+// PreRequest notifies the service of the endpoints selected for the request. The request is sent
+// once the service answers or the call times out.
+func (p *Plugin) PreRequest(ctx context.Context, request *framework.LLMRequest, schedulingResult *framework.SchedulingResult) {
+	if !p.enabled[PreRequestExtensionPoint] || schedulingResult == nil {
+		return
+	}
+	profileResults := make(map[string]*pb.Endpoints, len(schedulingResult.ProfileResults))
+	for name, result := range schedulingResult.ProfileResults {
+		if result != nil {
+			profileResults[name] = &pb.Endpoints{Endpoints: toEndpoints(result.TargetEndpoints)}
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.config.Timeout.Duration)
+	defer cancel()
+	if _, err := p.client.PreRequest(ctx, &pb.PreRequestRequest{
+		PluginName:         p.typedName.Name,
+		Request:            toRequest(request),
+		ProfileResults:     profileResults,
+		PrimaryProfileName: schedulingResult.PrimaryProfileName,
+	}); err != nil {
+		p.config.LogFailure(ctx, err, p.typedName, PreRequestExtensionPoint, request)
+	}
+}
+
+// ResponseComplete notifies the service of the end of the request and of its termination. The
+// call is made in the background, so that the service does not delay the end of the response.
+func (p *Plugin) ResponseComplete(ctx context.Context, request *framework.LLMRequest, response *requestcontrol.Response,
+	termination requestcontrol.Termination, targetEndpoint *fwkdl.EndpointMetadata) {
+	if !p.enabled[ResponseCompleteExtensionPoint] {
+		return
+	}
+	completeRequest := &pb.ResponseCompleteRequest{
+		PluginName:        p.typedName.Name,
+		Request:           toRequest(request),
+		TargetEndpoint:    toEndpoint(targetEndpoint, nil),
+		TerminationReason: string(termination.Reason),
+		StatusCode:        int32(termination.StatusCode),
+	}
+	if termination.Err != nil {
+		completeRequest.Error = termination.Err.Error()
+	}
+	if response != nil {
+		completeRequest.ResponseHeaders = response.Headers
+		completeRequest.Usage = toUsage(response.Usage)
+	}
+	logger := log.FromContext(ctx)
+	go func() {
+		// The request context may end with the request, the call is bounded by the timeout only.
+		ctx, cancel := context.WithTimeout(log.IntoContext(context.Background(), logger), p.config.Timeout.Duration)
+		defer cancel()
+		if _, err := p.client.ResponseComplete(ctx, completeRequest); err != nil {
+			p.config.LogFailure(ctx, err, p.typedName, ResponseCompleteExtensionPoint, request)
+		}
+	}()
+}
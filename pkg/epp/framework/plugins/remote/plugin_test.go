@@ -0,0 +1,324 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/external"
+	pb "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/remote/api/v1alpha1"
+)
+
+// fakeService keeps the endpoints labeled "keep", scores endpoints by their queue, admits the
+// requests of non-negative priority and records the notifications it receives.
+type fakeService struct {
+	pb.UnimplementedRemotePluginServer
+	delay time.Duration
+
+	mu       sync.Mutex
+	calls    map[string]int
+	notified []string
+}
+
+func (s *fakeService) record(ctx context.Context, method string) error {
+	s.mu.Lock()
+	s.calls[method]++
+	s.mu.Unlock()
+	select {
+	case <-time.After(s.delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *fakeService) callCount(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+func (s *fakeService) Filter(ctx context.Context, request *pb.FilterRequest) (*pb.FilterResponse, error) {
+	if err := s.record(ctx, "Filter"); err != nil {
+		return nil, err
+	}
+	response := &pb.FilterResponse{}
+	for _, endpoint := range request.GetEndpoints() {
+		if endpoint.GetLabels()["keep"] == "true" {
+			response.Endpoints = append(response.Endpoints, endpoint.GetName())
+		}
+	}
+	return response, nil
+}
+
+func (s *fakeService) Score(ctx context.Context, request *pb.ScoreRequest) (*pb.ScoreResponse, error) {
+	if err := s.record(ctx, "Score"); err != nil {
+		return nil, err
+	}
+	response := &pb.ScoreResponse{Scores: map[string]float64{}}
+	for _, endpoint := range request.GetEndpoints() {
+		response.Scores[endpoint.GetName()] = 1 / float64(1+endpoint.GetMetrics().GetWaitingQueueSize())
+	}
+	return response, nil
+}
+
+func (s *fakeService) AdmitRequest(ctx context.Context, request *pb.AdmitRequestRequest) (*pb.AdmitRequestResponse, error) {
+	if err := s.record(ctx, "AdmitRequest"); err != nil {
+		return nil, err
+	}
+	if request.GetRequest().GetPriority() < 0 {
+		return &pb.AdmitRequestResponse{Reason: "sheddable requests are shed"}, nil
+	}
+	return &pb.AdmitRequestResponse{Admitted: true}, nil
+}
+
+func (s *fakeService) PreRequest(ctx context.Context, request *pb.PreRequestRequest) (*pb.PreRequestResponse, error) {
+	if err := s.record(ctx, "PreRequest"); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	primary := request.GetProfileResults()[request.GetPrimaryProfileName()]
+	s.notified = append(s.notified, "pre-request "+request.GetRequest().GetRequestId()+" "+primary.GetEndpoints()[0].GetName())
+	return &pb.PreRequestResponse{}, nil
+}
+
+func (s *fakeService) ResponseComplete(ctx context.Context, request *pb.ResponseCompleteRequest) (*pb.ResponseCompleteResponse, error) {
+	if err := s.record(ctx, "ResponseComplete"); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.notified = append(s.notified, "complete "+request.GetRequest().GetRequestId()+" "+request.GetTargetEndpoint().GetName()+
+		" "+request.GetResponseHeaders()[":status"]+" "+request.GetTerminationReason()+" "+strconv.Itoa(int(request.GetStatusCode())))
+	return &pb.ResponseCompleteResponse{}, nil
+}
+
+// startService serves the service in process and returns a client connected to it.
+func startService(t *testing.T, service pb.RemotePluginServer) pb.RemotePluginClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	pb.RegisterRemotePluginServer(server, service)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return pb.NewRemotePluginClient(conn)
+}
+
+func newTestPlugin(t *testing.T, service *fakeService, failOpen bool, extensionPoints ...string) *Plugin {
+	t.Helper()
+	config := DefaultConfig
+	config.Address = "bufnet"
+	config.FailOpen = failOpen
+	config.ExtensionPoints = extensionPoints
+	require.NoError(t, config.validate())
+	return New(config, startService(t, service)).WithName("remote-test")
+}
+
+func newEndpoint(name string, keep bool, queue int) fwksched.Endpoint {
+	labels := map[string]string{}
+	if keep {
+		labels["keep"] = "true"
+	}
+	return fwksched.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: name},
+		PodName:        name,
+		Port:           "8000",
+		Labels:         labels,
+	}, &fwkdl.Metrics{WaitingQueueSize: queue}, nil)
+}
+
+func names(endpoints []fwksched.Endpoint) []string {
+	result := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		result = append(result, endpoint.GetMetadata().PodName)
+	}
+	return result
+}
+
+func newService() *fakeService {
+	return &fakeService{calls: map[string]int{}}
+}
+
+func TestRemotePluginFactory(t *testing.T) {
+	handle := plugin.NewEppHandle(t.Context(), nil)
+	p, err := RemotePluginFactory("my-remote", json.RawMessage(`{"address": "localhost:9000", "extensionPoints": ["Filter", "Scorer"], "timeout": "50ms", "failOpen": false, "scorerCategory": "Affinity"}`), handle)
+	require.NoError(t, err)
+	remote := p.(*Plugin)
+	assert.Equal(t, "my-remote", remote.TypedName().Name)
+	assert.Equal(t, Config{
+		Address:         "localhost:9000",
+		ExtensionPoints: []string{FilterExtensionPoint, ScorerExtensionPoint},
+		Timeout:         metav1.Duration{Duration: 50 * time.Millisecond},
+		Config:          external.Config{ScorerCategory: fwksched.Affinity},
+	}, remote.config)
+	assert.Equal(t, fwksched.Affinity, remote.Category())
+
+	for _, params := range []string{
+		`{"extensionPoints": ["Filter"]}`,
+		`{"address": "localhost:9000"}`,
+		`{"address": "localhost:9000", "extensionPoints": ["Picker"]}`,
+		`{"address": "localhost:9000", "extensionPoints": ["Filter"], "timeout": "0s"}`,
+		`{"address": "localhost:9000", "extensionPoints": ["Filter"], "scorerCategory": "Random"}`,
+		`{"address": "localhost:9000", "extensionPoints": ["Filter"], "failurePolicy": "Open"}`,
+	} {
+		_, err := RemotePluginFactory("my-remote", json.RawMessage(params), handle)
+		assert.Error(t, err, params)
+	}
+}
+
+func TestFilterAndScore(t *testing.T) {
+	ctx := context.Background()
+	service := newService()
+	p := newTestPlugin(t, service, true, FilterExtensionPoint, ScorerExtensionPoint)
+	endpoints := []fwksched.Endpoint{newEndpoint("a", true, 0), newEndpoint("b", false, 0), newEndpoint("c", true, 3)}
+	request := &fwksched.LLMRequest{RequestId: "1", TargetModel: "m"}
+
+	assert.Equal(t, []string{"a", "c"}, names(p.Filter(ctx, nil, request, endpoints)))
+
+	scores := p.Score(ctx, nil, request, endpoints)
+	assert.Equal(t, map[fwksched.Endpoint]float64{endpoints[0]: 1, endpoints[1]: 1, endpoints[2]: 0.25}, scores)
+	assert.Equal(t, 1, service.callCount("Filter"))
+	assert.Equal(t, 1, service.callCount("Score"))
+}
+
+func TestAdmitRequest(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPlugin(t, newService(), true, AdmitRequestExtensionPoint)
+	endpoints := []fwksched.Endpoint{newEndpoint("a", true, 0)}
+
+	assert.NoError(t, p.AdmitRequest(ctx, &fwksched.LLMRequest{RequestId: "1"}, endpoints))
+	err := p.AdmitRequest(ctx, &fwksched.LLMRequest{RequestId: "2", Objectives: fwksched.RequestObjectives{Priority: -1}}, endpoints)
+	assert.EqualError(t, err, "sheddable requests are shed")
+}
+
+func TestFailurePolicy(t *testing.T) {
+	ctx := context.Background()
+	endpoints := []fwksched.Endpoint{newEndpoint("a", true, 0), newEndpoint("b", false, 0)}
+	request := &fwksched.LLMRequest{RequestId: "1"}
+	allPoints := []string{FilterExtensionPoint, ScorerExtensionPoint, AdmitRequestExtensionPoint}
+
+	tests := []struct {
+		name      string
+		failOpen  bool
+		wantNames []string
+		wantAdmit bool
+	}{
+		{name: "fail open", failOpen: true, wantNames: []string{"a", "b"}, wantAdmit: true},
+		{name: "fail closed", failOpen: false, wantNames: []string{}, wantAdmit: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// The service answers after the timeout of the plugin.
+			service := newService()
+			service.delay = time.Second
+			p := newTestPlugin(t, service, test.failOpen, allPoints...)
+			p.config.Timeout.Duration = 20 * time.Millisecond
+
+			assert.Equal(t, test.wantNames, names(p.Filter(ctx, nil, request, endpoints)))
+			assert.Empty(t, p.Score(ctx, nil, request, endpoints))
+			err := p.AdmitRequest(ctx, request, endpoints)
+			if test.wantAdmit {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDisabledExtensionPoints(t *testing.T) {
+	ctx := context.Background()
+	service := newService()
+	p := newTestPlugin(t, service, false, PreRequestExtensionPoint)
+	endpoints := []fwksched.Endpoint{newEndpoint("a", false, 0)}
+	request := &fwksched.LLMRequest{RequestId: "1"}
+
+	assert.Equal(t, endpoints, p.Filter(ctx, nil, request, endpoints))
+	assert.Empty(t, p.Score(ctx, nil, request, endpoints))
+	assert.NoError(t, p.AdmitRequest(ctx, request, endpoints))
+	p.ResponseComplete(ctx, request, &requestcontrol.Response{}, requestcontrol.Termination{Reason: requestcontrol.TerminationCompleted},
+		endpoints[0].GetMetadata())
+
+	time.Sleep(50 * time.Millisecond)
+	for _, method := range []string{"Filter", "Score", "AdmitRequest", "ResponseComplete"} {
+		assert.Zero(t, service.callCount(method), method)
+	}
+}
+
+func TestNotifications(t *testing.T) {
+	ctx := context.Background()
+	service := newService()
+	p := newTestPlugin(t, service, true, PreRequestExtensionPoint, ResponseCompleteExtensionPoint)
+	endpoint := newEndpoint("a", true, 0)
+	request := &fwksched.LLMRequest{RequestId: "1"}
+
+	p.PreRequest(ctx, request, &fwksched.SchedulingResult{
+		ProfileResults:     map[string]*fwksched.ProfileRunResult{"default": {TargetEndpoints: []fwksched.Endpoint{endpoint}}},
+		PrimaryProfileName: "default",
+	})
+	p.ResponseComplete(ctx, request, &requestcontrol.Response{Headers: map[string]string{":status": "503"}},
+		requestcontrol.Termination{Reason: requestcontrol.TerminationUpstreamError, StatusCode: 503}, endpoint.GetMetadata())
+
+	require.Eventually(t, func() bool { return service.callCount("ResponseComplete") == 1 }, time.Second, 10*time.Millisecond)
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	assert.Equal(t, []string{"pre-request 1 default/a", "complete 1 default/a 503 UpstreamError 503"}, service.notified)
+}
+
+func TestToRequest(t *testing.T) {
+	request := &fwksched.LLMRequest{
+		RequestId:   "1",
+		TargetModel: "m",
+		Headers:     map[string]string{"x-user": "u"},
+		Body:        &fwksched.LLMRequestBody{Completions: &fwksched.CompletionsRequest{Prompt: "hello"}},
+		Objectives:  fwksched.RequestObjectives{Priority: 2, TTFT: 300 * time.Millisecond},
+	}
+	message := toRequest(request)
+	assert.Equal(t, "1", message.GetRequestId())
+	assert.Equal(t, "m", message.GetTargetModel())
+	assert.Equal(t, map[string]string{"x-user": "u"}, message.GetHeaders())
+	assert.Equal(t, int32(2), message.GetPriority())
+	assert.Equal(t, int64(300), message.GetTtftObjectiveMs())
+	assert.JSONEq(t, `{"completions": {"prompt": "hello"}}`, string(message.GetBody()))
+	assert.Nil(t, toRequest(nil))
+}
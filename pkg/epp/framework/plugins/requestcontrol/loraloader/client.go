@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loraloader
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// maxErrorBodySize bounds how much of an error response body is included in returned errors.
+const maxErrorBodySize = 1024
+
+// AdapterClient loads and unloads LoRA adapters on a model server.
+type AdapterClient interface {
+	// LoadAdapter loads the adapter with the given name from source on the endpoint.
+	LoadAdapter(ctx context.Context, endpoint *fwkdl.EndpointMetadata, name, source string) error
+	// UnloadAdapter unloads the adapter with the given name from the endpoint.
+	UnloadAdapter(ctx context.Context, endpoint *fwkdl.EndpointMetadata, name string) error
+}
+
+// httpAdapterClient implements AdapterClient against the vLLM dynamic LoRA serving API.
+type httpAdapterClient struct {
+	client     *http.Client
+	scheme     string
+	loadPath   string
+	unloadPath string
+}
+
+var _ AdapterClient = &httpAdapterClient{}
+
+func (c *httpAdapterClient) LoadAdapter(ctx context.Context, endpoint *fwkdl.EndpointMetadata, name, source string) error {
+	return c.post(ctx, endpoint, c.loadPath, map[string]string{"lora_name": name, "lora_path": source})
+}
+
+func (c *httpAdapterClient) UnloadAdapter(ctx context.Context, endpoint *fwkdl.EndpointMetadata, name string) error {
+	return c.post(ctx, endpoint, c.unloadPath, map[string]string{"lora_name": name})
+}
+
+func (c *httpAdapterClient) post(ctx context.Context, endpoint *fwkdl.EndpointMetadata, path string, payload map[string]string) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s://%s%s", c.scheme, net.JoinHostPort(endpoint.Address, endpoint.Port), path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if resp.StatusCode == http.StatusBadRequest && strings.Contains(string(msg), "already been loaded") {
+		return nil // another replica of the EPP or the sidecar won the race.
+	}
+	return fmt.Errorf("request to %s returned status %d: %s", url, resp.StatusCode, strings.TrimSpace(string(msg)))
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loraloader
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	compbasemetrics "k8s.io/component-base/metrics"
+
+	metricsutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/metrics"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// loraAdapterOperationsTotal is shared by all the instances of the plugin.
+var loraAdapterOperationsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: "inference_extension",
+		Name:      "lora_adapter_operations_total",
+		Help:      metricsutil.HelpMsgWithStability("Total number of LoRA adapter load and unload operations issued to model servers.", compbasemetrics.ALPHA),
+	},
+	[]string{"pod_name", "namespace", "port", "operation", "status"},
+)
+
+// Collectors implements plugin.MetricsCollector.
+func (p *Plugin) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{loraAdapterOperationsTotal}
+}
+
+// recordOperation counts a load or unload operation issued to an endpoint.
+func recordOperation(endpoint *fwkdl.EndpointMetadata, operation string, err error) {
+	status := statusSuccess
+	if err != nil {
+		status = statusFailure
+	}
+	loraAdapterOperationsTotal.WithLabelValues(endpoint.PodName, endpoint.NamespacedName.Namespace, endpoint.Port, operation, status).Inc()
+}
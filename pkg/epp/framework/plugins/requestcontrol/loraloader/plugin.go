@@ -0,0 +1,370 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package loraloader provides a request control plugin that loads LoRA adapters on demand on the
+// model server selected by the scheduler.
+package loraloader
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	// LoraAdapterLoaderType is the type of the LoRA adapter loader plugin.
+	LoraAdapterLoaderType = "lora-adapter-loader"
+
+	// endpointCleanupInterval is the interval at which state of endpoints that left the pool is dropped.
+	endpointCleanupInterval = 2 * time.Minute
+
+	operationLoad   = "load"
+	operationUnload = "unload"
+	statusSuccess   = "success"
+	statusFailure   = "failure"
+)
+
+// Config holds the LoRA adapter loader parameters.
+type Config struct {
+	// Adapters maps the adapter names that may be loaded on demand to the source passed to the
+	// model server, e.g. a Hugging Face repository or a local path. Requests targeting any other
+	// model are ignored.
+	Adapters map[string]string `json:"adapters"`
+	// Scheme is the scheme used to reach the model server API.
+	Scheme string `json:"scheme"`
+	// LoadPath is the path of the model server load adapter API.
+	LoadPath string `json:"loadPath"`
+	// UnloadPath is the path of the model server unload adapter API.
+	UnloadPath string `json:"unloadPath"`
+	// Timeout bounds how long a request waits for its adapter to be loaded, and how long a load
+	// and the unload that makes room for it may take.
+	Timeout metav1.Duration `json:"timeout"`
+}
+
+// DefaultConfig holds the default LoRA adapter loader parameters, matching the vLLM API.
+var DefaultConfig = Config{
+	Scheme:     "http",
+	LoadPath:   "/v1/load_lora_adapter",
+	UnloadPath: "/v1/unload_lora_adapter",
+	Timeout:    metav1.Duration{Duration: 30 * time.Second},
+}
+
+// compile-time type assertion
+var (
+	_ requestcontrol.PreRequest = &Plugin{}
+	_ plugin.MetricsCollector   = &Plugin{}
+)
+
+// LoraAdapterLoaderFactory defines the factory function for the LoRA adapter loader plugin.
+func LoraAdapterLoaderFactory(name string, rawParameters json.RawMessage, handle plugin.Handle) (plugin.Plugin, error) {
+	parameters := DefaultConfig
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the %s plugin. Error: %s", LoraAdapterLoaderType, err)
+		}
+	}
+
+	p, err := New(parameters, &httpAdapterClient{
+		client:     &http.Client{},
+		scheme:     parameters.Scheme,
+		loadPath:   parameters.LoadPath,
+		unloadPath: parameters.UnloadPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+	go p.cleanUpInactiveEndpoints(handle.Context(), handle)
+	return p.WithName(name).WithContext(handle.Context()), nil
+}
+
+// New initializes a new LoRA adapter loader plugin that uses the given client to reach model servers.
+func New(config Config, client AdapterClient) (*Plugin, error) {
+	if len(config.Adapters) == 0 {
+		return nil, fmt.Errorf("%s requires at least one adapter", LoraAdapterLoaderType)
+	}
+	if config.Timeout.Duration <= 0 {
+		return nil, errors.New("timeout must be positive")
+	}
+	return &Plugin{
+		typedName: plugin.TypedName{Type: LoraAdapterLoaderType, Name: LoraAdapterLoaderType},
+		config:    config,
+		client:    client,
+		ctx:       context.Background(),
+		endpoints: map[k8stypes.NamespacedName]*endpointAdapters{},
+		now:       time.Now,
+	}, nil
+}
+
+// Plugin loads the adapter targeted by a request on the selected endpoint before the request is
+// forwarded, evicting the least recently used adapter when the endpoint is at capacity.
+type Plugin struct {
+	typedName plugin.TypedName
+	config    Config
+	client    AdapterClient
+	// ctx bounds the lifetime of loads, which are shared by all the requests waiting for them and
+	// so must not be cancelled with the request that started them.
+	ctx context.Context
+
+	mu        sync.Mutex
+	endpoints map[k8stypes.NamespacedName]*endpointAdapters
+	// now is the clock used for LRU bookkeeping, replaceable in tests.
+	now func() time.Time
+}
+
+// endpointAdapters tracks the adapters of a single endpoint as seen by this plugin. Loads and
+// unloads issued by the plugin take effect before the next metrics scrape reports them, so they
+// are remembered until the endpoint metrics are newer than the operation.
+type endpointAdapters struct {
+	lastUsed map[string]time.Time
+	loaded   map[string]time.Time
+	unloaded map[string]time.Time
+	inflight map[string]*loadOperation
+	// unloading holds the adapters being unloaded to make room for a load, so that concurrent
+	// loads pick different victims.
+	unloading map[string]struct{}
+}
+
+// loadOperation lets concurrent requests for the same adapter wait on a single load.
+type loadOperation struct {
+	done chan struct{}
+	err  error
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *Plugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin.
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+// WithContext sets the context bounding the lifetime of the loads issued by the plugin.
+func (p *Plugin) WithContext(ctx context.Context) *Plugin {
+	p.ctx = ctx
+	return p
+}
+
+// PreRequest ensures the adapter targeted by the request is resident on the selected endpoint,
+// blocking until it is loaded or the configured timeout expires. Failures are logged and the
+// request is forwarded regardless, leaving the model server to reject it.
+func (p *Plugin) PreRequest(ctx context.Context, request *scheduling.LLMRequest, schedulingResult *scheduling.SchedulingResult) {
+	source, ok := p.config.Adapters[request.TargetModel]
+	if !ok || schedulingResult == nil {
+		return
+	}
+	primary, ok := schedulingResult.ProfileResults[schedulingResult.PrimaryProfileName]
+	if !ok || primary == nil || len(primary.TargetEndpoints) == 0 {
+		return
+	}
+	target := primary.TargetEndpoints[0]
+	if err := p.ensureLoaded(ctx, target.GetMetadata(), target.GetMetrics(), request.TargetModel, source); err != nil {
+		log.FromContext(ctx).V(logutil.DEFAULT).Error(err, "Failed to load LoRA adapter", "adapter", request.TargetModel, "endpoint", target.GetMetadata().NamespacedName)
+	}
+}
+
+// Load ensures a configured adapter is resident on the given endpoint ahead of any request, e.g.
+// to converge toward an adapter placement plan. The adapter counts as used for LRU eviction.
+func (p *Plugin) Load(ctx context.Context, endpoint *fwkdl.EndpointMetadata, endpointMetrics *fwkdl.Metrics, adapter string) error {
+	source, ok := p.config.Adapters[adapter]
+	if !ok {
+		return fmt.Errorf("adapter %q is not configured in the %s plugin", adapter, p.typedName)
+	}
+	return p.ensureLoaded(ctx, endpoint, endpointMetrics, adapter, source)
+}
+
+func (p *Plugin) ensureLoaded(ctx context.Context, endpoint *fwkdl.EndpointMetadata, endpointMetrics *fwkdl.Metrics, adapter, source string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.config.Timeout.Duration)
+	defer cancel()
+
+	p.mu.Lock()
+	state, ok := p.endpoints[endpoint.NamespacedName]
+	if !ok {
+		state = &endpointAdapters{
+			lastUsed:  map[string]time.Time{},
+			loaded:    map[string]time.Time{},
+			unloaded:  map[string]time.Time{},
+			inflight:  map[string]*loadOperation{},
+			unloading: map[string]struct{}{},
+		}
+		p.endpoints[endpoint.NamespacedName] = state
+	}
+	state.lastUsed[adapter] = p.now()
+	resident := state.resident(endpointMetrics)
+	if _, ok := resident[adapter]; ok {
+		p.mu.Unlock()
+		return nil
+	}
+	op, ok := state.inflight[adapter]
+	if !ok {
+		op = &loadOperation{done: make(chan struct{})}
+		state.inflight[adapter] = op
+		victim := ""
+		if endpointMetrics != nil && endpointMetrics.MaxActiveModels > 0 && len(resident)+len(state.inflight)-1 >= endpointMetrics.MaxActiveModels {
+			victim = state.evictionCandidate(endpointMetrics, resident)
+			if victim != "" {
+				state.unloading[victim] = struct{}{}
+			}
+		}
+		// The load is shared with the requests arriving while it is in progress, so it runs
+		// detached from this request.
+		go p.load(log.IntoContext(p.ctx, log.FromContext(ctx)), endpoint, state, op, adapter, source, victim)
+	}
+	p.mu.Unlock()
+
+	select {
+	case <-op.done:
+		return op.err
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for in-progress load: %w", ctx.Err())
+	}
+}
+
+// load unloads the victim, if any, then loads the adapter and records the outcome in the endpoint
+// state before releasing the requests waiting on the operation.
+func (p *Plugin) load(ctx context.Context, endpoint *fwkdl.EndpointMetadata, state *endpointAdapters, op *loadOperation, adapter, source, victim string) {
+	ctx, cancel := context.WithTimeout(ctx, p.config.Timeout.Duration)
+	defer cancel()
+
+	if victim != "" {
+		err := p.client.UnloadAdapter(ctx, endpoint, victim)
+		recordOperation(endpoint, operationUnload, err)
+		p.mu.Lock()
+		delete(state.unloading, victim)
+		if err == nil {
+			delete(state.loaded, victim)
+			delete(state.lastUsed, victim)
+			state.unloaded[victim] = p.now()
+		}
+		p.mu.Unlock()
+		if err != nil {
+			log.FromContext(ctx).V(logutil.DEFAULT).Error(err, "Failed to unload LoRA adapter", "adapter", victim, "endpoint", endpoint.NamespacedName)
+		} else {
+			log.FromContext(ctx).V(logutil.VERBOSE).Info("Unloaded least recently used LoRA adapter", "adapter", victim, "endpoint", endpoint.NamespacedName)
+		}
+	}
+
+	op.err = p.client.LoadAdapter(ctx, endpoint, adapter, source)
+	recordOperation(endpoint, operationLoad, op.err)
+	p.mu.Lock()
+	delete(state.inflight, adapter)
+	if op.err == nil {
+		state.loaded[adapter] = p.now()
+		delete(state.unloaded, adapter)
+	}
+	p.mu.Unlock()
+	close(op.done)
+
+	if op.err == nil {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("Loaded LoRA adapter", "adapter", adapter, "endpoint", endpoint.NamespacedName)
+	}
+}
+
+// resident returns the adapters considered loaded on the endpoint, combining the last scraped
+// metrics with the operations issued since that scrape.
+func (s *endpointAdapters) resident(endpointMetrics *fwkdl.Metrics) map[string]struct{} {
+	var updated time.Time
+	resident := map[string]struct{}{}
+	if endpointMetrics != nil {
+		updated = endpointMetrics.UpdateTime
+		for name := range endpointMetrics.ActiveModels {
+			resident[name] = struct{}{}
+		}
+		for name := range endpointMetrics.WaitingModels {
+			resident[name] = struct{}{}
+		}
+	}
+	for name, at := range s.loaded {
+		if at.After(updated) {
+			resident[name] = struct{}{}
+		} else {
+			delete(s.loaded, name) // metrics caught up with the load
+		}
+	}
+	for name, at := range s.unloaded {
+		if at.After(updated) {
+			delete(resident, name)
+		} else {
+			delete(s.unloaded, name) // metrics caught up with the unload
+		}
+	}
+	return resident
+}
+
+// evictionCandidate returns the least recently used resident adapter that can be unloaded.
+// Adapters with waiting requests, adapters being loaded and adapters already being unloaded are
+// never evicted.
+func (s *endpointAdapters) evictionCandidate(endpointMetrics *fwkdl.Metrics, resident map[string]struct{}) string {
+	victim := ""
+	var victimUsed time.Time
+	for name := range resident {
+		if _, waiting := endpointMetrics.WaitingModels[name]; waiting {
+			continue
+		}
+		if _, loading := s.inflight[name]; loading {
+			continue
+		}
+		if _, unloading := s.unloading[name]; unloading {
+			continue
+		}
+		used := s.lastUsed[name] // adapters never routed through this plugin sort first
+		if victim == "" || used.Before(victimUsed) || (used.Equal(victimUsed) && name < victim) {
+			victim, victimUsed = name, used
+		}
+	}
+	return victim
+}
+
+// cleanUpInactiveEndpoints periodically drops the state of endpoints that left the pool.
+func (p *Plugin) cleanUpInactiveEndpoints(ctx context.Context, handle plugin.Handle) {
+	ticker := time.NewTicker(endpointCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			active := map[k8stypes.NamespacedName]struct{}{}
+			for _, name := range handle.PodList() {
+				active[name] = struct{}{}
+			}
+			p.mu.Lock()
+			for name, state := range p.endpoints {
+				if _, ok := active[name]; !ok && len(state.inflight) == 0 {
+					delete(p.endpoints, name)
+				}
+			}
+			p.mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loraloader
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+type fakeAdapterClient struct {
+	mu       sync.Mutex
+	loads    []string
+	unloads  []string
+	loadErr  error
+	loadGate chan struct{} // if set, loads block until closed
+	// unloadGate, if set, blocks unloads until closed.
+	unloadGate chan struct{}
+}
+
+func (c *fakeAdapterClient) LoadAdapter(_ context.Context, _ *fwkdl.EndpointMetadata, name, _ string) error {
+	if c.loadGate != nil {
+		<-c.loadGate
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loads = append(c.loads, name)
+	return c.loadErr
+}
+
+func (c *fakeAdapterClient) UnloadAdapter(_ context.Context, _ *fwkdl.EndpointMetadata, name string) error {
+	if c.unloadGate != nil {
+		<-c.unloadGate
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.unloads = append(c.unloads, name)
+	return nil
+}
+
+var testConfig = Config{
+	Adapters: map[string]string{"sql-lora": "hf/sql-lora", "tweet-lora": "hf/tweet-lora", "chat-lora": "hf/chat-lora", "code-lora": "hf/code-lora"},
+	Timeout:  DefaultConfig.Timeout,
+}
+
+func newTestPlugin(t *testing.T, client AdapterClient) (*Plugin, *time.Time) {
+	t.Helper()
+	p, err := New(testConfig, client)
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	p.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	return p, &now
+}
+
+func schedulingResultFor(endpoint scheduling.Endpoint) *scheduling.SchedulingResult {
+	return &scheduling.SchedulingResult{
+		PrimaryProfileName: "default",
+		ProfileResults: map[string]*scheduling.ProfileRunResult{
+			"default": {TargetEndpoints: []scheduling.Endpoint{endpoint}},
+		},
+	}
+}
+
+func newEndpoint(m *fwkdl.Metrics) scheduling.Endpoint {
+	return scheduling.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: "pod-1"},
+		PodName:        "pod-1",
+		Port:           "8000",
+	}, m, nil)
+}
+
+func TestPreRequestLoadsMissingAdapter(t *testing.T) {
+	client := &fakeAdapterClient{}
+	p, _ := newTestPlugin(t, client)
+	endpoint := newEndpoint(&fwkdl.Metrics{ActiveModels: map[string]int{}, WaitingModels: map[string]int{}, MaxActiveModels: 4})
+	ctx := context.Background()
+
+	p.PreRequest(ctx, &scheduling.LLMRequest{TargetModel: "sql-lora"}, schedulingResultFor(endpoint))
+	// The adapter is remembered as loaded until the metrics catch up.
+	p.PreRequest(ctx, &scheduling.LLMRequest{TargetModel: "sql-lora"}, schedulingResultFor(endpoint))
+	// Models that are not configured adapters are ignored.
+	p.PreRequest(ctx, &scheduling.LLMRequest{TargetModel: "base-model"}, schedulingResultFor(endpoint))
+
+	assert.Equal(t, []string{"sql-lora"}, client.loads)
+	assert.Empty(t, client.unloads)
+}
+
+func TestPreRequestSkipsResidentAdapter(t *testing.T) {
+	client := &fakeAdapterClient{}
+	p, _ := newTestPlugin(t, client)
+	endpoint := newEndpoint(&fwkdl.Metrics{
+		ActiveModels:    map[string]int{"sql-lora": 1},
+		WaitingModels:   map[string]int{"tweet-lora": 1},
+		MaxActiveModels: 2,
+	})
+
+	p.PreRequest(context.Background(), &scheduling.LLMRequest{TargetModel: "sql-lora"}, schedulingResultFor(endpoint))
+	p.PreRequest(context.Background(), &scheduling.LLMRequest{TargetModel: "tweet-lora"}, schedulingResultFor(endpoint))
+
+	assert.Empty(t, client.loads)
+}
+
+func TestPreRequestEvictsLeastRecentlyUsed(t *testing.T) {
+	client := &fakeAdapterClient{}
+	p, now := newTestPlugin(t, client)
+	ctx := context.Background()
+	full := newEndpoint(&fwkdl.Metrics{
+		ActiveModels:    map[string]int{"sql-lora": 1, "tweet-lora": 1},
+		WaitingModels:   map[string]int{},
+		MaxActiveModels: 2,
+		UpdateTime:      *now,
+	})
+
+	// tweet-lora is used after sql-lora, so sql-lora is the LRU adapter.
+	p.PreRequest(ctx, &scheduling.LLMRequest{TargetModel: "sql-lora"}, schedulingResultFor(full))
+	p.PreRequest(ctx, &scheduling.LLMRequest{TargetModel: "tweet-lora"}, schedulingResultFor(full))
+	p.PreRequest(ctx, &scheduling.LLMRequest{TargetModel: "chat-lora"}, schedulingResultFor(full))
+
+	assert.Equal(t, []string{"sql-lora"}, client.unloads)
+	assert.Equal(t, []string{"chat-lora"}, client.loads)
+
+	// Until the metrics refresh, sql-lora is known to be unloaded and is loaded again on demand,
+	// evicting tweet-lora which is now the LRU adapter.
+	p.PreRequest(ctx, &scheduling.LLMRequest{TargetModel: "sql-lora"}, schedulingResultFor(full))
+	assert.Equal(t, []string{"sql-lora", "tweet-lora"}, client.unloads)
+	assert.Equal(t, []string{"chat-lora", "sql-lora"}, client.loads)
+}
+
+func TestPreRequestDeduplicatesConcurrentLoads(t *testing.T) {
+	client := &fakeAdapterClient{loadGate: make(chan struct{})}
+	p, _ := newTestPlugin(t, client)
+	endpoint := newEndpoint(&fwkdl.Metrics{ActiveModels: map[string]int{}, WaitingModels: map[string]int{}})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.PreRequest(context.Background(), &scheduling.LLMRequest{TargetModel: "sql-lora"}, schedulingResultFor(endpoint))
+		}()
+	}
+	require.Eventually(t, func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		state, ok := p.endpoints[endpoint.GetMetadata().NamespacedName]
+		return ok && len(state.inflight) == 1
+	}, time.Second, time.Millisecond)
+	close(client.loadGate)
+	wg.Wait()
+
+	assert.Equal(t, []string{"sql-lora"}, client.loads)
+}
+
+func TestLoadSurvivesCancellationOfFirstRequest(t *testing.T) {
+	client := &fakeAdapterClient{loadGate: make(chan struct{})}
+	p, _ := newTestPlugin(t, client)
+	endpoint := newEndpoint(&fwkdl.Metrics{ActiveModels: map[string]int{}, WaitingModels: map[string]int{}})
+
+	// The request that starts the load goes away while the load is in progress.
+	ctx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		firstErr <- p.Load(ctx, endpoint.GetMetadata(), endpoint.GetMetrics(), "sql-lora")
+	}()
+	require.Eventually(t, func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		state, ok := p.endpoints[endpoint.GetMetadata().NamespacedName]
+		return ok && len(state.inflight) == 1
+	}, time.Second, time.Millisecond)
+	cancel()
+	require.ErrorIs(t, <-firstErr, context.Canceled)
+
+	// A request waiting on the same load still gets the adapter.
+	secondErr := make(chan error, 1)
+	go func() {
+		secondErr <- p.Load(context.Background(), endpoint.GetMetadata(), endpoint.GetMetrics(), "sql-lora")
+	}()
+	close(client.loadGate)
+	require.NoError(t, <-secondErr)
+	assert.Equal(t, []string{"sql-lora"}, client.loads)
+}
+
+func TestConcurrentLoadsEvictDifferentAdapters(t *testing.T) {
+	client := &fakeAdapterClient{unloadGate: make(chan struct{})}
+	p, _ := newTestPlugin(t, client)
+	full := newEndpoint(&fwkdl.Metrics{
+		ActiveModels:    map[string]int{"sql-lora": 1, "tweet-lora": 1},
+		WaitingModels:   map[string]int{},
+		MaxActiveModels: 2,
+	})
+
+	var wg sync.WaitGroup
+	for _, adapter := range []string{"chat-lora", "code-lora"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, p.Load(context.Background(), full.GetMetadata(), full.GetMetrics(), adapter))
+		}()
+	}
+	// Both victims are reserved while their unloads are still in progress.
+	require.Eventually(t, func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		state, ok := p.endpoints[full.GetMetadata().NamespacedName]
+		return ok && len(state.unloading) == 2
+	}, time.Second, time.Millisecond)
+	close(client.unloadGate)
+	wg.Wait()
+
+	assert.ElementsMatch(t, []string{"sql-lora", "tweet-lora"}, client.unloads)
+	assert.ElementsMatch(t, []string{"chat-lora", "code-lora"}, client.loads)
+}
+
+func TestPreRequestRetriesFailedLoad(t *testing.T) {
+	client := &fakeAdapterClient{loadErr: errors.New("boom")}
+	p, _ := newTestPlugin(t, client)
+	endpoint := newEndpoint(&fwkdl.Metrics{ActiveModels: map[string]int{}, WaitingModels: map[string]int{}})
+
+	p.PreRequest(context.Background(), &scheduling.LLMRequest{TargetModel: "sql-lora"}, schedulingResultFor(endpoint))
+	p.PreRequest(context.Background(), &scheduling.LLMRequest{TargetModel: "sql-lora"}, schedulingResultFor(endpoint))
+
+	assert.Equal(t, []string{"sql-lora", "sql-lora"}, client.loads)
+}
+
+func TestHTTPAdapterClient(t *testing.T) {
+	var got []map[string]string
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		got = append(got, payload)
+		paths = append(paths, r.URL.Path)
+		switch payload["lora_name"] {
+		case "loaded":
+			http.Error(w, "The lora adapter 'loaded' has already been loaded.", http.StatusBadRequest)
+		case "bad":
+			http.Error(w, "no such adapter", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	endpoint := &fwkdl.EndpointMetadata{Address: host, Port: port}
+	client := &httpAdapterClient{client: server.Client(), scheme: "http", loadPath: DefaultConfig.LoadPath, unloadPath: DefaultConfig.UnloadPath}
+	ctx := context.Background()
+
+	require.NoError(t, client.LoadAdapter(ctx, endpoint, "sql-lora", "hf/sql-lora"))
+	require.NoError(t, client.UnloadAdapter(ctx, endpoint, "sql-lora"))
+	require.NoError(t, client.LoadAdapter(ctx, endpoint, "loaded", "hf/loaded"))
+	err = client.LoadAdapter(ctx, endpoint, "bad", "hf/bad")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no such adapter")
+
+	assert.Equal(t, map[string]string{"lora_name": "sql-lora", "lora_path": "hf/sql-lora"}, got[0])
+	assert.Equal(t, map[string]string{"lora_name": "sql-lora"}, got[1])
+	assert.Equal(t, []string{"/v1/load_lora_adapter", "/v1/unload_lora_adapter"}, paths[:2])
+}
+
+func TestLoraAdapterLoaderFactory(t *testing.T) {
+	handle := fwkplugin.NewEppHandle(context.Background(), nil)
+
+	p, err := LoraAdapterLoaderFactory("loader", json.RawMessage(`{"adapters": {"sql-lora": "hf/sql-lora"}, "timeout": "5s"}`), handle)
+	require.NoError(t, err)
+	loader := p.(*Plugin)
+	assert.Equal(t, "loader", loader.TypedName().Name)
+	assert.Equal(t, 5*time.Second, loader.config.Timeout.Duration)
+	assert.Equal(t, DefaultConfig.LoadPath, loader.config.LoadPath)
+
+	_, err = LoraAdapterLoaderFactory("loader", json.RawMessage(`{}`), handle)
+	assert.Error(t, err)
+	_, err = LoraAdapterLoaderFactory("loader", json.RawMessage(`{"adapters": {"a": "b"}, "timeout": "0s"}`), handle)
+	assert.Error(t, err)
+}
+
+func TestLoad(t *testing.T) {
+	client := &fakeAdapterClient{}
+	p, _ := newTestPlugin(t, client)
+	endpoint := newEndpoint(&fwkdl.Metrics{ActiveModels: map[string]int{}, WaitingModels: map[string]int{}})
+
+	require.NoError(t, p.Load(context.Background(), endpoint.GetMetadata(), endpoint.GetMetrics(), "sql-lora"))
+	require.Error(t, p.Load(context.Background(), endpoint.GetMetadata(), endpoint.GetMetrics(), "unknown-lora"))
+	assert.Equal(t, []string{"sql-lora"}, client.loads)
+}
+
+func TestLoraAdapterOperationsTotal(t *testing.T) {
+	loraAdapterOperationsTotal.Reset()
+	client := &fakeAdapterClient{loadErr: errors.New("boom")}
+	p, _ := newTestPlugin(t, client)
+	full := newEndpoint(&fwkdl.Metrics{ActiveModels: map[string]int{"sql-lora": 1}, WaitingModels: map[string]int{}, MaxActiveModels: 1})
+
+	require.Error(t, p.Load(context.Background(), full.GetMetadata(), full.GetMetrics(), "tweet-lora"))
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(loraAdapterOperationsTotal.WithLabelValues("pod-1", "default", "8000", operationUnload, statusSuccess)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(loraAdapterOperationsTotal.WithLabelValues("pod-1", "default", "8000", operationLoad, statusFailure)))
+	assert.Equal(t, []prometheus.Collector{loraAdapterOperationsTotal}, p.Collectors())
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loraplacement
+
+import (
+	"math"
+	"sort"
+
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+// Plan maps each planned adapter to the set of endpoints it should be resident on.
+type Plan map[string]map[k8stypes.NamespacedName]struct{}
+
+// endpointCapacity is the planner view of an endpoint: its adapter slots and the adapters
+// currently resident on it.
+type endpointCapacity struct {
+	name     k8stypes.NamespacedName
+	slots    int
+	resident map[string]struct{}
+}
+
+// computePlan assigns adapters to endpoint slots. Every adapter with traffic gets one replica,
+// hottest first, before hot adapters get additional replicas, one per requestsPerReplica of
+// request rate, capped at maxReplicas. Replicas are placed on endpoints that already hold the
+// adapter when possible to avoid churn, and otherwise on the endpoint with the least planned
+// traffic. Adapters that do not fit in the available slots are left out of the plan.
+func computePlan(rates map[string]float64, endpoints []endpointCapacity, requestsPerReplica float64, maxReplicas int) Plan {
+	adapters := make([]string, 0, len(rates))
+	for adapter, rate := range rates {
+		if rate > 0 {
+			adapters = append(adapters, adapter)
+		}
+	}
+	sort.Slice(adapters, func(i, j int) bool {
+		if rates[adapters[i]] != rates[adapters[j]] {
+			return rates[adapters[i]] > rates[adapters[j]]
+		}
+		return adapters[i] < adapters[j]
+	})
+
+	if maxReplicas <= 0 || maxReplicas > len(endpoints) {
+		maxReplicas = len(endpoints)
+	}
+	wanted := make(map[string]int, len(adapters))
+	for _, adapter := range adapters {
+		replicas := 1
+		if requestsPerReplica > 0 {
+			replicas = int(math.Ceil(rates[adapter] / requestsPerReplica))
+		}
+		wanted[adapter] = max(1, min(replicas, maxReplicas))
+	}
+
+	free := make([]int, len(endpoints))
+	load := make([]float64, len(endpoints))
+	for i, endpoint := range endpoints {
+		free[i] = endpoint.slots
+	}
+	plan := Plan{}
+	place := func(adapter string) bool {
+		best := -1
+		for i, endpoint := range endpoints {
+			if free[i] <= 0 {
+				continue
+			}
+			if _, ok := plan[adapter][endpoint.name]; ok {
+				continue
+			}
+			if best == -1 || betterCandidate(adapter, endpoint, load[i], endpoints[best], load[best]) {
+				best = i
+			}
+		}
+		if best == -1 {
+			return false
+		}
+		if plan[adapter] == nil {
+			plan[adapter] = map[k8stypes.NamespacedName]struct{}{}
+		}
+		plan[adapter][endpoints[best].name] = struct{}{}
+		free[best]--
+		load[best] += rates[adapter] / float64(wanted[adapter])
+		return true
+	}
+
+	// Coverage first, so that a hot adapter cannot take the last slots away from colder ones.
+	for _, adapter := range adapters {
+		if !place(adapter) {
+			break
+		}
+	}
+	for _, adapter := range adapters {
+		if _, ok := plan[adapter]; !ok {
+			break
+		}
+		for len(plan[adapter]) < wanted[adapter] {
+			if !place(adapter) {
+				break
+			}
+		}
+	}
+	return plan
+}
+
+// betterCandidate reports whether endpoint a is a better host for a replica of the adapter than b.
+func betterCandidate(adapter string, a endpointCapacity, aLoad float64, b endpointCapacity, bLoad float64) bool {
+	_, aResident := a.resident[adapter]
+	_, bResident := b.resident[adapter]
+	if aResident != bResident {
+		return aResident
+	}
+	if aLoad != bLoad {
+		return aLoad < bLoad
+	}
+	return a.name.String() < b.name.String()
+}
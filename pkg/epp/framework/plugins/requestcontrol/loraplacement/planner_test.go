@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loraplacement
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+func endpointName(name string) k8stypes.NamespacedName {
+	return k8stypes.NamespacedName{Namespace: "default", Name: name}
+}
+
+func planOf(placements map[string][]string) Plan {
+	plan := Plan{}
+	for adapter, endpoints := range placements {
+		plan[adapter] = map[k8stypes.NamespacedName]struct{}{}
+		for _, endpoint := range endpoints {
+			plan[adapter][endpointName(endpoint)] = struct{}{}
+		}
+	}
+	return plan
+}
+
+func capacity(name string, slots int, resident ...string) endpointCapacity {
+	c := endpointCapacity{name: endpointName(name), slots: slots, resident: map[string]struct{}{}}
+	for _, adapter := range resident {
+		c.resident[adapter] = struct{}{}
+	}
+	return c
+}
+
+func TestComputePlan(t *testing.T) {
+	tests := []struct {
+		name               string
+		rates              map[string]float64
+		endpoints          []endpointCapacity
+		requestsPerReplica float64
+		maxReplicas        int
+		want               Plan
+	}{
+		{
+			name:               "keeps adapters where they are resident",
+			rates:              map[string]float64{"a": 1, "b": 1},
+			endpoints:          []endpointCapacity{capacity("pod-1", 2, "b"), capacity("pod-2", 2, "a")},
+			requestsPerReplica: 10,
+			want:               planOf(map[string][]string{"a": {"pod-2"}, "b": {"pod-1"}}),
+		},
+		{
+			name:               "replicates hot adapters",
+			rates:              map[string]float64{"hot": 25, "cold": 1},
+			endpoints:          []endpointCapacity{capacity("pod-1", 2), capacity("pod-2", 2), capacity("pod-3", 2)},
+			requestsPerReplica: 10,
+			want:               planOf(map[string][]string{"hot": {"pod-1", "pod-2", "pod-3"}, "cold": {"pod-2"}}),
+		},
+		{
+			name:               "caps replicas",
+			rates:              map[string]float64{"hot": 25},
+			endpoints:          []endpointCapacity{capacity("pod-1", 2), capacity("pod-2", 2), capacity("pod-3", 2)},
+			requestsPerReplica: 10,
+			maxReplicas:        2,
+			want:               planOf(map[string][]string{"hot": {"pod-1", "pod-2"}}),
+		},
+		{
+			name:               "covers adapters before replicating",
+			rates:              map[string]float64{"hot": 100, "warm": 2, "cold": 1},
+			endpoints:          []endpointCapacity{capacity("pod-1", 1), capacity("pod-2", 1), capacity("pod-3", 0)},
+			requestsPerReplica: 10,
+			want:               planOf(map[string][]string{"hot": {"pod-1"}, "warm": {"pod-2"}}),
+		},
+		{
+			name:      "no traffic",
+			rates:     map[string]float64{"a": 0},
+			endpoints: []endpointCapacity{capacity("pod-1", 2, "a")},
+			want:      Plan{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := computePlan(test.rates, test.endpoints, test.requestsPerReplica, test.maxReplicas)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Unexpected plan (-want +got): %s", diff)
+			}
+		})
+	}
+}
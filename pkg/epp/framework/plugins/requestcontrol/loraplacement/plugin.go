@@ -0,0 +1,312 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package loraplacement provides a request control plugin that plans where LoRA adapters should
+// be resident across the pool, based on the request rate of each adapter.
+package loraplacement
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrlora "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/lora"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/loraloader"
+)
+
+const (
+	// LoraPlacementPlannerType is the type of the LoRA adapter placement planner plugin.
+	LoraPlacementPlannerType = "lora-placement-planner"
+
+	// rateSmoothing is the weight of the latest planning interval in the smoothed adapter request rates.
+	rateSmoothing = 0.5
+	// minRate is the request rate, in requests per second, below which an adapter is forgotten.
+	minRate = 0.001
+)
+
+// Config holds the LoRA adapter placement planner parameters.
+type Config struct {
+	// PlanInterval is the interval at which the placement plan is recomputed.
+	PlanInterval metav1.Duration `json:"planInterval"`
+	// RequestsPerReplica is the request rate, in requests per second, that a single endpoint is
+	// expected to serve for an adapter. Hotter adapters are replicated on more endpoints.
+	RequestsPerReplica float64 `json:"requestsPerReplica"`
+	// MaxReplicas caps the number of endpoints an adapter is planned on. Zero means no cap.
+	MaxReplicas int `json:"maxReplicas"`
+	// Adapters lists the models that are LoRA adapters. When empty, any model reported in the
+	// endpoint ActiveModels or WaitingModels metrics is treated as an adapter.
+	Adapters []string `json:"adapters"`
+	// Loader is the name of a lora-adapter-loader plugin used to load planned adapters ahead of
+	// requests. When empty, the plan is only exposed to the lora-affinity-scorer.
+	Loader string `json:"loader"`
+}
+
+// DefaultConfig holds the default LoRA adapter placement planner parameters.
+var DefaultConfig = Config{
+	PlanInterval:       metav1.Duration{Duration: 30 * time.Second},
+	RequestsPerReplica: 10,
+}
+
+// compile-time type assertions
+var (
+	_ requestcontrol.PrepareDataPlugin = &Planner{}
+	_ fwkdl.EndpointListConsumer       = &Planner{}
+)
+
+// LoraPlacementPlannerFactory defines the factory function for the LoRA adapter placement planner plugin.
+func LoraPlacementPlannerFactory(name string, rawParameters json.RawMessage, handle plugin.Handle) (plugin.Plugin, error) {
+	parameters := DefaultConfig
+	if rawParameters != nil {
+		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the %s plugin. Error: %s", LoraPlacementPlannerType, err)
+		}
+	}
+
+	var loader *loraloader.Plugin
+	if parameters.Loader != "" {
+		var err error
+		if loader, err = plugin.PluginByType[*loraloader.Plugin](handle, parameters.Loader); err != nil {
+			return nil, fmt.Errorf("invalid loader for the %s plugin: %w", LoraPlacementPlannerType, err)
+		}
+	}
+
+	p, err := New(parameters, loader)
+	if err != nil {
+		return nil, err
+	}
+	go p.run(handle.Context(), handle.PodList)
+	return p.WithName(name), nil
+}
+
+// New initializes a new LoRA adapter placement planner. The loader is optional.
+func New(config Config, loader *loraloader.Plugin) (*Planner, error) {
+	if config.PlanInterval.Duration <= 0 {
+		return nil, errors.New("planInterval must be positive")
+	}
+	if config.RequestsPerReplica < 0 {
+		return nil, errors.New("requestsPerReplica must not be negative")
+	}
+	if config.MaxReplicas < 0 {
+		return nil, errors.New("maxReplicas must not be negative")
+	}
+	adapters := make(map[string]struct{}, len(config.Adapters))
+	for _, adapter := range config.Adapters {
+		adapters[adapter] = struct{}{}
+	}
+	return &Planner{
+		typedName: plugin.TypedName{Type: LoraPlacementPlannerType, Name: LoraPlacementPlannerType},
+		config:    config,
+		loader:    loader,
+		adapters:  adapters,
+		requests:  map[string]int{},
+		rates:     map[string]float64{},
+		plan:      Plan{},
+		now:       time.Now,
+	}, nil
+}
+
+// Planner counts requests per adapter and periodically computes a target placement of adapters
+// on endpoints, replicating hot adapters. The plan is computed from the live endpoints of the
+// datastore. It is exposed to scorers as an endpoint attribute and, when a loader is configured,
+// planned adapters are loaded ahead of requests.
+type Planner struct {
+	typedName plugin.TypedName
+	config    Config
+	loader    *loraloader.Plugin
+
+	mu sync.Mutex
+	// adapters holds the models known to be adapters.
+	adapters map[string]struct{}
+	// requests counts the requests per model since the last plan.
+	requests map[string]int
+	// rates holds the smoothed request rate per adapter, in requests per second.
+	rates map[string]float64
+	// endpointList lists the live endpoints the plan is computed over.
+	endpointList fwkdl.EndpointListFunc
+	plan         Plan
+	lastPlan     time.Time
+	// now is the clock used to compute request rates, replaceable in tests.
+	now func() time.Time
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *Planner) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin.
+func (p *Planner) WithName(name string) *Planner {
+	p.typedName.Name = name
+	return p
+}
+
+// Produces returns the data produced by the plugin.
+func (p *Planner) Produces() map[string]any {
+	return map[string]any{attrlora.LoraPlacementKey: attrlora.LoraPlacement{}}
+}
+
+// Consumes returns the data consumed by the plugin.
+func (p *Planner) Consumes() map[string]any {
+	return map[string]any{
+		metrics.ActiveModelsKey:  map[string]int{},
+		metrics.WaitingModelsKey: map[string]int{},
+	}
+}
+
+// SetEndpointList sets the function listing the live endpoints the plan is computed over.
+func (p *Planner) SetEndpointList(list fwkdl.EndpointListFunc) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.endpointList = list
+}
+
+// PrepareRequestData records the request for the adapter rates and, when the plan covers the
+// target adapter, marks each candidate endpoint as planned or not.
+func (p *Planner) PrepareRequestData(_ context.Context, request *scheduling.LLMRequest, endpoints []scheduling.Endpoint) error {
+	p.mu.Lock()
+	p.requests[request.TargetModel]++
+	planned, covered := p.plan[request.TargetModel]
+	p.mu.Unlock()
+
+	if !covered {
+		return nil
+	}
+	for _, endpoint := range endpoints {
+		_, ok := planned[endpoint.GetMetadata().NamespacedName]
+		endpoint.Put(attrlora.LoraPlacementKey, attrlora.NewLoraPlacement(ok))
+	}
+	return nil
+}
+
+// run recomputes the plan every plan interval until the context is cancelled.
+func (p *Planner) run(ctx context.Context, podList plugin.PodListFunc) {
+	ticker := time.NewTicker(p.config.PlanInterval.Duration)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.replan(ctx, podList())
+		}
+	}
+}
+
+// replan folds the requests seen since the last plan into the adapter rates, computes a new plan
+// over the live endpoints of the given active pods and, when a loader is configured, converges
+// toward it.
+func (p *Planner) replan(ctx context.Context, activePods []k8stypes.NamespacedName) {
+	active := make(map[k8stypes.NamespacedName]struct{}, len(activePods))
+	for _, name := range activePods {
+		active[name] = struct{}{}
+	}
+
+	p.mu.Lock()
+	endpointList := p.endpointList
+	p.mu.Unlock()
+	endpoints := map[k8stypes.NamespacedName]fwkdl.Endpoint{}
+	if endpointList != nil {
+		for _, endpoint := range endpointList() {
+			name := endpoint.GetMetadata().NamespacedName
+			if _, ok := active[name]; ok {
+				endpoints[name] = endpoint
+			}
+		}
+	}
+
+	p.mu.Lock()
+	now := p.now()
+	elapsed := now.Sub(p.lastPlan).Seconds()
+	if p.lastPlan.IsZero() || elapsed <= 0 {
+		elapsed = p.config.PlanInterval.Seconds()
+	}
+	p.lastPlan = now
+
+	capacities := make([]endpointCapacity, 0, len(endpoints))
+	for name, endpoint := range endpoints {
+		capacity := endpointCapacity{name: name, resident: map[string]struct{}{}}
+		if endpointMetrics := endpoint.GetMetrics(); endpointMetrics != nil {
+			capacity.slots = endpointMetrics.MaxActiveModels
+			for _, models := range []map[string]int{endpointMetrics.ActiveModels, endpointMetrics.WaitingModels} {
+				for model := range models {
+					capacity.resident[model] = struct{}{}
+					if len(p.config.Adapters) == 0 {
+						p.adapters[model] = struct{}{}
+					}
+				}
+			}
+		}
+		capacities = append(capacities, capacity)
+	}
+
+	for model := range p.adapters {
+		rate := rateSmoothing*float64(p.requests[model])/elapsed + (1-rateSmoothing)*p.rates[model]
+		if rate < minRate {
+			delete(p.rates, model)
+			continue
+		}
+		p.rates[model] = rate
+	}
+	p.requests = map[string]int{}
+
+	plan := computePlan(p.rates, capacities, p.config.RequestsPerReplica, p.config.MaxReplicas)
+	p.plan = plan
+	p.mu.Unlock()
+
+	log.FromContext(ctx).V(logutil.DEBUG).Info("Computed LoRA adapter placement plan", "adapters", len(plan), "endpoints", len(capacities))
+	if p.loader != nil {
+		p.converge(ctx, plan, capacities, endpoints)
+	}
+}
+
+// converge loads the planned adapters that are not yet resident on their endpoints.
+func (p *Planner) converge(ctx context.Context, plan Plan, capacities []endpointCapacity, endpoints map[k8stypes.NamespacedName]fwkdl.Endpoint) {
+	adapters := make([]string, 0, len(plan))
+	for adapter := range plan {
+		adapters = append(adapters, adapter)
+	}
+	sort.Strings(adapters)
+
+	for _, capacity := range capacities {
+		endpoint := endpoints[capacity.name]
+		for _, adapter := range adapters {
+			if _, ok := plan[adapter][capacity.name]; !ok {
+				continue
+			}
+			if _, ok := capacity.resident[adapter]; ok {
+				continue
+			}
+			if err := p.loader.Load(ctx, endpoint.GetMetadata(), endpoint.GetMetrics(), adapter); err != nil {
+				log.FromContext(ctx).V(logutil.DEFAULT).Error(err, "Failed to load planned LoRA adapter", "adapter", adapter, "endpoint", capacity.name)
+			}
+		}
+	}
+}
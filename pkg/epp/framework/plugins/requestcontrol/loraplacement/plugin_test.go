@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loraplacement
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrlora "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/lora"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requestcontrol/loraloader"
+)
+
+type fakeAdapterClient struct {
+	mu    sync.Mutex
+	loads []string
+}
+
+func (c *fakeAdapterClient) LoadAdapter(_ context.Context, endpoint *fwkdl.EndpointMetadata, name, _ string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loads = append(c.loads, endpoint.NamespacedName.Name+"/"+name)
+	return nil
+}
+
+func (c *fakeAdapterClient) UnloadAdapter(_ context.Context, _ *fwkdl.EndpointMetadata, _ string) error {
+	return nil
+}
+
+func newLiveEndpoint(name string, active ...string) *fwkdl.ModelServer {
+	models := map[string]int{}
+	for _, adapter := range active {
+		models[adapter] = 1
+	}
+	return fwkdl.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: endpointName(name), PodName: name},
+		&fwkdl.Metrics{ActiveModels: models, WaitingModels: map[string]int{}, MaxActiveModels: 2})
+}
+
+func newEndpoint(name string, active ...string) scheduling.Endpoint {
+	live := newLiveEndpoint(name, active...)
+	return scheduling.NewEndpoint(live.GetMetadata(), live.GetMetrics(), nil)
+}
+
+func endpointList(endpoints ...fwkdl.Endpoint) fwkdl.EndpointListFunc {
+	return func() []fwkdl.Endpoint {
+		return endpoints
+	}
+}
+
+func placement(t *testing.T, endpoint scheduling.Endpoint) *attrlora.LoraPlacement {
+	t.Helper()
+	raw, ok := endpoint.Get(attrlora.LoraPlacementKey)
+	if !ok {
+		return nil
+	}
+	return raw.(*attrlora.LoraPlacement)
+}
+
+func TestPlannerMarksPlannedEndpoints(t *testing.T) {
+	config := DefaultConfig
+	config.RequestsPerReplica = 0.05
+	planner, err := New(config, nil)
+	require.NoError(t, err)
+	// The request snapshots of pod-1 predate the load of sql-lora, the plan follows the live state.
+	planner.SetEndpointList(endpointList(newLiveEndpoint("pod-1", "sql-lora"), newLiveEndpoint("pod-2"), newLiveEndpoint("pod-3")))
+	ctx := context.Background()
+
+	endpoints := []scheduling.Endpoint{newEndpoint("pod-1"), newEndpoint("pod-2"), newEndpoint("pod-3")}
+	require.NoError(t, planner.PrepareRequestData(ctx, &scheduling.LLMRequest{TargetModel: "sql-lora"}, endpoints))
+	for _, endpoint := range endpoints {
+		assert.Nil(t, placement(t, endpoint), "no plan exists yet")
+	}
+	for i := 0; i < 2; i++ {
+		require.NoError(t, planner.PrepareRequestData(ctx, &scheduling.LLMRequest{TargetModel: "sql-lora"}, endpoints))
+	}
+
+	// 3 requests in 30s is 0.05 rps after smoothing, which warrants a single replica where the adapter
+	// is already resident. pod-3 left the pool.
+	planner.replan(ctx, []k8stypes.NamespacedName{endpointName("pod-1"), endpointName("pod-2")})
+	assert.Equal(t, planOf(map[string][]string{"sql-lora": {"pod-1"}}), planner.plan)
+
+	endpoints = []scheduling.Endpoint{newEndpoint("pod-1", "sql-lora"), newEndpoint("pod-2")}
+	require.NoError(t, planner.PrepareRequestData(ctx, &scheduling.LLMRequest{TargetModel: "sql-lora"}, endpoints))
+	assert.True(t, placement(t, endpoints[0]).Planned())
+	assert.False(t, placement(t, endpoints[1]).Planned())
+
+	// Models that are not planned, such as the base model, leave the endpoints untouched.
+	endpoints = []scheduling.Endpoint{newEndpoint("pod-1", "sql-lora")}
+	require.NoError(t, planner.PrepareRequestData(ctx, &scheduling.LLMRequest{TargetModel: "base-model"}, endpoints))
+	assert.Nil(t, placement(t, endpoints[0]))
+}
+
+func TestPlannerConvergesWithLoader(t *testing.T) {
+	client := &fakeAdapterClient{}
+	loader, err := loraloader.New(loraloader.Config{
+		Adapters: map[string]string{"sql-lora": "hf/sql-lora"},
+		Timeout:  loraloader.DefaultConfig.Timeout,
+	}, client)
+	require.NoError(t, err)
+	config := DefaultConfig
+	config.Adapters = []string{"sql-lora", "chat-lora"}
+	config.RequestsPerReplica = 0.001
+	planner, err := New(config, loader)
+	require.NoError(t, err)
+	planner.now = func() time.Time { return time.Unix(1000, 0) }
+	planner.SetEndpointList(endpointList(newLiveEndpoint("pod-1", "sql-lora"), newLiveEndpoint("pod-2")))
+	ctx := context.Background()
+
+	endpoints := []scheduling.Endpoint{newEndpoint("pod-1", "sql-lora"), newEndpoint("pod-2")}
+	require.NoError(t, planner.PrepareRequestData(ctx, &scheduling.LLMRequest{TargetModel: "sql-lora"}, endpoints))
+	require.NoError(t, planner.PrepareRequestData(ctx, &scheduling.LLMRequest{TargetModel: "chat-lora"}, endpoints))
+	planner.replan(ctx, []k8stypes.NamespacedName{endpointName("pod-1"), endpointName("pod-2")})
+
+	// sql-lora is replicated to pod-2. chat-lora is planned too, but the loader does not know its source.
+	assert.Equal(t, []string{"pod-2/sql-lora"}, client.loads)
+}
+
+func TestLoraPlacementPlannerFactory(t *testing.T) {
+	handle := fwkplugin.NewEppHandle(context.Background(), nil)
+	loader, err := loraloader.New(loraloader.Config{Adapters: map[string]string{"a": "b"}, Timeout: loraloader.DefaultConfig.Timeout}, &fakeAdapterClient{})
+	require.NoError(t, err)
+	handle.AddPlugin("loader", loader)
+
+	p, err := LoraPlacementPlannerFactory("planner", json.RawMessage(`{"planInterval": "1m", "maxReplicas": 3, "loader": "loader"}`), handle)
+	require.NoError(t, err)
+	planner := p.(*Planner)
+	assert.Equal(t, "planner", planner.TypedName().Name)
+	assert.Equal(t, time.Minute, planner.config.PlanInterval.Duration)
+	assert.Equal(t, DefaultConfig.RequestsPerReplica, planner.config.RequestsPerReplica)
+	assert.Same(t, loader, planner.loader)
+
+	_, err = LoraPlacementPlannerFactory("planner", json.RawMessage(`{"loader": "missing"}`), handle)
+	assert.Error(t, err)
+	_, err = LoraPlacementPlannerFactory("planner", json.RawMessage(`{"planInterval": "0s"}`), handle)
+	assert.Error(t, err)
+}
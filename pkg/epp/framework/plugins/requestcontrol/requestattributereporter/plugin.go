@@ -147,9 +147,14 @@ func (c *Plugin) TypedName() plugin.TypedName {
 	return c.typedName
 }
 
-// ResponseComplete implements the requestcontrol.ResponseComplete interface.
+// ResponseComplete implements the requestcontrol.ResponseComplete interface. Only requests which completed
+// successfully are reported, as the usage of failed or aborted requests is partial or missing.
 func (c *Plugin) ResponseComplete(ctx context.Context, request *scheduling.LLMRequest, response *requestcontrol.Response,
-	_ *datalayer.EndpointMetadata) {
+	termination requestcontrol.Termination, _ *datalayer.EndpointMetadata) {
+	if !termination.Succeeded() {
+		log.FromContext(ctx).V(logutil.DEBUG).Info("Request did not complete, skipping attributes reporting", "reason", termination.Reason)
+		return
+	}
 	// Convert the request usage Go struct into a protobuf struct so that it can be used as a CEL variable.
 	celData, err := c.getCelData(response)
 	if err != nil {
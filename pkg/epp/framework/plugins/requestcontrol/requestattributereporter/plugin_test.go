@@ -184,11 +184,32 @@ func TestPluginCreation(t *testing.T) {
 
 func TestValueReporting(t *testing.T) {
 	tests := []struct {
-		name       string
-		config     Config
-		response   *requestcontrol.Response
-		wantResult *structpb.Struct
+		name        string
+		config      Config
+		response    *requestcontrol.Response
+		termination requestcontrol.Termination
+		wantResult  *structpb.Struct
 	}{
+		{
+			name: "failed request is not reported",
+			config: Config{
+				Attributes: []Attribute{
+					{
+						Key: AttributeKey{
+							Name: "prompt_tokens",
+						},
+						Expression: "usage.prompt_tokens",
+					},
+				},
+			},
+			response: &requestcontrol.Response{
+				Usage: requestcontrol.Usage{
+					PromptTokens: 15,
+				},
+			},
+			termination: requestcontrol.Termination{Reason: requestcontrol.TerminationUpstreamError, StatusCode: 503},
+			wantResult:  nil,
+		},
 		{
 			name: "request usage expression",
 			config: Config{
@@ -416,7 +437,11 @@ func TestValueReporting(t *testing.T) {
 				t.Fatalf("Failed to create plugin: %v", err)
 			}
 
-			plugin.ResponseComplete(context.Background(), &scheduling.LLMRequest{}, currentResponse, &datalayer.EndpointMetadata{})
+			termination := tt.termination
+			if termination.Reason == "" {
+				termination.Reason = requestcontrol.TerminationCompleted
+			}
+			plugin.ResponseComplete(context.Background(), &scheduling.LLMRequest{}, currentResponse, termination, &datalayer.EndpointMetadata{})
 
 			if diff := cmp.Diff(tt.wantResult, currentResponse.DynamicMetadata, protocmp.Transform()); diff != "" {
 				t.Errorf("ResponseComplete() DynamicMetadata mismatch (-want +got):\n%s", diff)
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metricsstaleness provides a filter that removes endpoints whose metrics have not been
+// refreshed recently, so that scorers do not act on outdated data after scrape failures.
+package metricsstaleness
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	// MetricsStalenessFilterType is the type of the metrics staleness filter plugin.
+	MetricsStalenessFilterType = "metrics-staleness-filter"
+)
+
+// Config holds the metrics staleness filter parameters.
+type Config struct {
+	// StalenessThreshold is the age of Metrics.UpdateTime after which an endpoint is considered stale.
+	// It defaults to the metrics staleness threshold of the EPP.
+	StalenessThreshold metav1.Duration `json:"stalenessThreshold"`
+	// FailOpen keeps the stale endpoints as candidates when every candidate is stale, instead of
+	// returning no candidates.
+	FailOpen bool `json:"failOpen"`
+}
+
+// DefaultConfig holds the default metrics staleness filter parameters.
+var DefaultConfig = Config{
+	StalenessThreshold: metav1.Duration{Duration: plugin.DefaultMetricsStalenessThreshold},
+	FailOpen:           true,
+}
+
+// compile-time type assertion
+var (
+	_ framework.Filter        = &MetricsStalenessFilter{}
+	_ plugin.MetricsCollector = &MetricsStalenessFilter{}
+)
+
+// MetricsStalenessFilterFactory defines the factory function for the metrics staleness filter.
+func MetricsStalenessFilterFactory(name string, rawParameters json.RawMessage, handle plugin.Handle) (plugin.Plugin, error) {
+	parameters := DefaultConfig
+	parameters.StalenessThreshold.Duration = handle.MetricsStalenessThreshold()
+	if rawParameters != nil {
+		if err := plugin.StrictUnmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the %s plugin. Error: %s", MetricsStalenessFilterType, err)
+		}
+	}
+
+	f, err := NewMetricsStalenessFilter(parameters)
+	if err != nil {
+		return nil, err
+	}
+	return f.WithName(name), nil
+}
+
+// NewMetricsStalenessFilter initializes a new MetricsStalenessFilter and returns its pointer.
+func NewMetricsStalenessFilter(config Config) (*MetricsStalenessFilter, error) {
+	if config.StalenessThreshold.Duration <= 0 {
+		return nil, errors.New("stalenessThreshold must be positive")
+	}
+	return &MetricsStalenessFilter{
+		typedName: plugin.TypedName{Type: MetricsStalenessFilterType, Name: MetricsStalenessFilterType},
+		config:    config,
+		now:       time.Now,
+	}, nil
+}
+
+// MetricsStalenessFilter filters out endpoints whose metrics are older than the staleness threshold.
+type MetricsStalenessFilter struct {
+	typedName plugin.TypedName
+	config    Config
+	// now is the clock used to compute the metrics age, replaceable in tests.
+	now func() time.Time
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (f *MetricsStalenessFilter) TypedName() plugin.TypedName {
+	return f.typedName
+}
+
+// WithName sets the name of the filter.
+func (f *MetricsStalenessFilter) WithName(name string) *MetricsStalenessFilter {
+	f.typedName.Name = name
+	return f
+}
+
+// Filter removes endpoints whose metrics are missing or were last updated before the staleness
+// threshold. When every endpoint is stale and FailOpen is set, the input is returned unchanged so
+// stale endpoints act as a fallback rather than failing the request.
+func (f *MetricsStalenessFilter) Filter(ctx context.Context, _ *framework.CycleState, _ *framework.LLMRequest, endpoints []framework.Endpoint) []framework.Endpoint {
+	now := f.now()
+	fresh := make([]framework.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		m := endpoint.GetMetrics()
+		if m != nil && now.Sub(m.UpdateTime) <= f.config.StalenessThreshold.Duration {
+			fresh = append(fresh, endpoint)
+			continue
+		}
+		recordStale(endpoint.GetMetadata())
+	}
+
+	if len(fresh) == 0 && len(endpoints) > 0 && f.config.FailOpen {
+		log.FromContext(ctx).V(logutil.DEBUG).Info("All candidate endpoints have stale metrics, keeping them",
+			"candidates", len(endpoints), "stalenessThreshold", f.config.StalenessThreshold.Duration)
+		return endpoints
+	}
+	return fresh
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsstaleness
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+func TestMetricsStalenessFilter(t *testing.T) {
+	now := time.Unix(1000, 0)
+	endpoint := func(name string, age time.Duration) fwksched.Endpoint {
+		return fwksched.NewEndpoint(&fwkdl.EndpointMetadata{
+			NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: name},
+			PodName:        name,
+		}, &fwkdl.Metrics{UpdateTime: now.Add(-age)}, nil)
+	}
+	fresh := endpoint("fresh", 100*time.Millisecond)
+	edge := endpoint("edge", time.Second)
+	stale := endpoint("stale", time.Minute)
+	never := fwksched.NewEndpoint(&fwkdl.EndpointMetadata{PodName: "never"}, &fwkdl.Metrics{}, nil)
+
+	tests := []struct {
+		name      string
+		failOpen  bool
+		endpoints []fwksched.Endpoint
+		want      []fwksched.Endpoint
+	}{
+		{
+			name:      "stale and never updated endpoints are removed",
+			failOpen:  true,
+			endpoints: []fwksched.Endpoint{fresh, stale, edge, never},
+			want:      []fwksched.Endpoint{fresh, edge},
+		},
+		{
+			name:      "all stale with fail open keeps all",
+			failOpen:  true,
+			endpoints: []fwksched.Endpoint{stale, never},
+			want:      []fwksched.Endpoint{stale, never},
+		},
+		{
+			name:      "all stale without fail open removes all",
+			failOpen:  false,
+			endpoints: []fwksched.Endpoint{stale, never},
+			want:      []fwksched.Endpoint{},
+		},
+		{
+			name:      "no endpoints",
+			failOpen:  true,
+			endpoints: []fwksched.Endpoint{},
+			want:      []fwksched.Endpoint{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := NewMetricsStalenessFilter(Config{
+				StalenessThreshold: DefaultConfig.StalenessThreshold,
+				FailOpen:           test.failOpen,
+			})
+			require.NoError(t, err)
+			filter.now = func() time.Time { return now }
+
+			got := filter.Filter(context.Background(), fwksched.NewCycleState(), &fwksched.LLMRequest{}, test.endpoints)
+			if diff := cmp.Diff(test.want, got, cmp.Comparer(fwksched.EndpointComparer)); diff != "" {
+				t.Errorf("Unexpected output (-want +got): %v", diff)
+			}
+		})
+	}
+}
+
+func TestStaleEndpointMetricsTotal(t *testing.T) {
+	staleEndpointMetricsTotal.Reset()
+	now := time.Unix(1000, 0)
+	endpoint := func(name string, age time.Duration) fwksched.Endpoint {
+		return fwksched.NewEndpoint(&fwkdl.EndpointMetadata{
+			NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: name},
+			PodName:        name,
+			Port:           "8000",
+		}, &fwkdl.Metrics{UpdateTime: now.Add(-age)}, nil)
+	}
+	filter, err := NewMetricsStalenessFilter(DefaultConfig)
+	require.NoError(t, err)
+	filter.now = func() time.Time { return now }
+	endpoints := []fwksched.Endpoint{endpoint("fresh", 0), endpoint("stale", time.Minute)}
+
+	filter.Filter(context.Background(), fwksched.NewCycleState(), &fwksched.LLMRequest{}, endpoints)
+	filter.Filter(context.Background(), fwksched.NewCycleState(), &fwksched.LLMRequest{}, endpoints)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(staleEndpointMetricsTotal.WithLabelValues("stale", "default", "8000")))
+	assert.Equal(t, 1, testutil.CollectAndCount(staleEndpointMetricsTotal), "fresh endpoints must not be counted")
+	assert.Equal(t, []prometheus.Collector{staleEndpointMetricsTotal}, filter.Collectors())
+}
+
+func TestMetricsStalenessFilterFactory(t *testing.T) {
+	handle := fwkplugin.NewEppHandle(context.Background(), nil)
+
+	plugin, err := MetricsStalenessFilterFactory("staleness", json.RawMessage(`{"stalenessThreshold": "500ms", "failOpen": false}`), handle)
+	require.NoError(t, err)
+	filter := plugin.(*MetricsStalenessFilter)
+	assert.Equal(t, "staleness", filter.TypedName().Name)
+	assert.Equal(t, 500*time.Millisecond, filter.config.StalenessThreshold.Duration)
+	assert.False(t, filter.config.FailOpen)
+
+	plugin, err = MetricsStalenessFilterFactory("staleness", nil, handle)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultConfig, plugin.(*MetricsStalenessFilter).config)
+
+	_, err = MetricsStalenessFilterFactory("staleness", json.RawMessage(`{"stalenessThreshold": "0s"}`), handle)
+	assert.Error(t, err)
+	// The threshold defaults to the one of the EPP.
+	handle = fwkplugin.NewEppHandle(context.Background(), nil, fwkplugin.WithMetricsStalenessThreshold(5*time.Second))
+	plugin, err = MetricsStalenessFilterFactory("staleness", json.RawMessage(`{"failOpen": false}`), handle)
+	require.NoError(t, err)
+	assert.Equal(t, Config{StalenessThreshold: metav1.Duration{Duration: 5 * time.Second}}, plugin.(*MetricsStalenessFilter).config)
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsstaleness
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	compbasemetrics "k8s.io/component-base/metrics"
+
+	metricsutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/metrics"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// staleEndpointMetricsTotal is shared by all the instances of the filter.
+var staleEndpointMetricsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: "inference_extension",
+		Name:      "stale_endpoint_metrics_total",
+		Help:      metricsutil.HelpMsgWithStability("Total number of times an endpoint was encountered with stale metrics during scheduling.", compbasemetrics.ALPHA),
+	},
+	[]string{"pod_name", "namespace", "port"},
+)
+
+// Collectors implements plugin.MetricsCollector.
+func (f *MetricsStalenessFilter) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{staleEndpointMetricsTotal}
+}
+
+// recordStale counts an endpoint that was encountered with stale metrics.
+func recordStale(endpoint *fwkdl.EndpointMetadata) {
+	staleEndpointMetricsTotal.WithLabelValues(endpoint.PodName, endpoint.NamespacedName.Namespace, endpoint.Port).Inc()
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outlierdetection
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	compbasemetrics "k8s.io/component-base/metrics"
+
+	metricsutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/metrics"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+)
+
+// The metrics are shared by all the instances of the plugin.
+var (
+	endpointEjected = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: "inference_extension",
+			Name:      "endpoint_ejected",
+			Help:      metricsutil.HelpMsgWithStability("Whether an endpoint is currently ejected from scheduling by outlier detection (1 ejected, 0 not ejected).", compbasemetrics.ALPHA),
+		},
+		[]string{"pod_name", "namespace", "port"},
+	)
+
+	endpointEjectionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: "inference_extension",
+			Name:      "endpoint_ejections_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of times an endpoint was ejected from scheduling by outlier detection.", compbasemetrics.ALPHA),
+		},
+		[]string{"pod_name", "namespace", "port", "reason"},
+	)
+)
+
+// Collectors implements plugin.MetricsCollector.
+func (p *Plugin) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{endpointEjected, endpointEjectionsTotal}
+}
+
+// recordEjected marks an endpoint as ejected and counts the ejection under the given reason.
+func recordEjected(endpoint *fwkdl.EndpointMetadata, reason string) {
+	endpointEjected.WithLabelValues(endpoint.PodName, endpoint.NamespacedName.Namespace, endpoint.Port).Set(1)
+	endpointEjectionsTotal.WithLabelValues(endpoint.PodName, endpoint.NamespacedName.Namespace, endpoint.Port, reason).Inc()
+}
+
+// recordRestored marks a previously ejected endpoint as eligible for scheduling again.
+func recordRestored(endpoint *fwkdl.EndpointMetadata) {
+	endpointEjected.WithLabelValues(endpoint.PodName, endpoint.NamespacedName.Namespace, endpoint.Port).Set(0)
+}
+
+// deleteEjected removes the ejected series of an endpoint that is no longer tracked.
+func deleteEjected(endpoint *fwkdl.EndpointMetadata) {
+	endpointEjected.DeleteLabelValues(endpoint.PodName, endpoint.NamespacedName.Namespace, endpoint.Port)
+}
@@ -0,0 +1,445 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package outlierdetection provides a filter that passively tracks the health of endpoints from
+// response outcomes and temporarily ejects misbehaving endpoints from the candidate list.
+package outlierdetection
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	// OutlierDetectionFilterType is the type of the outlier detection filter plugin.
+	OutlierDetectionFilterType = "outlier-detection-filter"
+
+	// Ejection reasons reported in the endpoint_ejections_total metric.
+	reasonConsecutiveFailures = "consecutive_failures"
+	reasonFailureRate         = "failure_rate"
+	reasonLatency             = "latency"
+
+	// minLatencyPeers is the minimum number of endpoints with enough samples required before
+	// latency outliers are evaluated, so that a single slow endpoint is not compared against itself.
+	minLatencyPeers = 3
+)
+
+// Config holds the outlier detection parameters.
+type Config struct {
+	// Interval is the length of the window over which failure rates and latencies are aggregated.
+	Interval metav1.Duration `json:"interval"`
+	// ConsecutiveFailures ejects an endpoint after this many failures in a row. 0 disables.
+	ConsecutiveFailures int `json:"consecutiveFailures"`
+	// FailureRateThreshold ejects an endpoint whose share of failed requests within an interval
+	// is at or above this value, in [0, 1]. 0 disables.
+	FailureRateThreshold float64 `json:"failureRateThreshold"`
+	// MinimumRequests is the number of requests an endpoint must serve within an interval before
+	// its failure rate or latency is evaluated.
+	MinimumRequests int `json:"minimumRequests"`
+	// ResponseTimeout counts a request as timed out when response headers take longer than this.
+	// 0 disables.
+	ResponseTimeout metav1.Duration `json:"responseTimeout"`
+	// LatencyOutlierFactor ejects an endpoint whose mean response latency within an interval exceeds
+	// this multiple of the median of all evaluated endpoints. 0 disables.
+	LatencyOutlierFactor float64 `json:"latencyOutlierFactor"`
+	// BaseEjectionTime is the duration of the first ejection. Repeated ejections double it.
+	BaseEjectionTime metav1.Duration `json:"baseEjectionTime"`
+	// MaxEjectionTime caps the ejection duration.
+	MaxEjectionTime metav1.Duration `json:"maxEjectionTime"`
+	// MaxEjectionPercent is the maximum percentage of tracked endpoints that may be ejected at once.
+	MaxEjectionPercent int `json:"maxEjectionPercent"`
+}
+
+// DefaultConfig holds the default outlier detection parameters.
+var DefaultConfig = Config{
+	Interval:             metav1.Duration{Duration: 10 * time.Second},
+	ConsecutiveFailures:  5,
+	FailureRateThreshold: 0.5,
+	MinimumRequests:      10,
+	ResponseTimeout:      metav1.Duration{Duration: 60 * time.Second},
+	LatencyOutlierFactor: 3.0,
+	BaseEjectionTime:     metav1.Duration{Duration: 30 * time.Second},
+	MaxEjectionTime:      metav1.Duration{Duration: 300 * time.Second},
+	MaxEjectionPercent:   50,
+}
+
+// compile-time type assertion
+var (
+	_ framework.Filter                = &Plugin{}
+	_ requestcontrol.PreRequest       = &Plugin{}
+	_ requestcontrol.ResponseReceived = &Plugin{}
+	_ plugin.MetricsCollector         = &Plugin{}
+	_ requestcontrol.ResponseComplete = &Plugin{}
+)
+
+// OutlierDetectionFilterFactory defines the factory function for the outlier detection filter.
+func OutlierDetectionFilterFactory(name string, rawParameters json.RawMessage, handle plugin.Handle) (plugin.Plugin, error) {
+	parameters := DefaultConfig
+	if rawParameters != nil {
+		if err := plugin.StrictUnmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the %s plugin. Error: %s", OutlierDetectionFilterType, err)
+		}
+	}
+
+	p, err := New(handle.Context(), parameters)
+	if err != nil {
+		return nil, err
+	}
+	return p.WithName(name), nil
+}
+
+// New initializes a new outlier detection filter and returns its pointer.
+func New(ctx context.Context, config Config) (*Plugin, error) {
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s configuration: %w", OutlierDetectionFilterType, err)
+	}
+
+	log.FromContext(ctx).V(logutil.DEFAULT).Info("OutlierDetectionFilter initialized", "config", config)
+	return &Plugin{
+		typedName:   plugin.TypedName{Type: OutlierDetectionFilterType, Name: OutlierDetectionFilterType},
+		config:      config,
+		pluginState: plugin.NewPluginState(ctx),
+		endpoints:   map[k8stypes.NamespacedName]*endpointStats{},
+		now:         time.Now,
+	}, nil
+}
+
+func (c Config) validate() error {
+	var errs []error
+	if c.Interval.Duration <= 0 {
+		errs = append(errs, errors.New("interval must be positive"))
+	}
+	if c.ConsecutiveFailures < 0 {
+		errs = append(errs, errors.New("consecutiveFailures must not be negative"))
+	}
+	if c.FailureRateThreshold < 0 || c.FailureRateThreshold > 1 {
+		errs = append(errs, errors.New("failureRateThreshold must be in [0, 1]"))
+	}
+	if c.MinimumRequests < 1 {
+		errs = append(errs, errors.New("minimumRequests must be at least 1"))
+	}
+	if c.ResponseTimeout.Duration < 0 {
+		errs = append(errs, errors.New("responseTimeout must not be negative"))
+	}
+	if c.LatencyOutlierFactor != 0 && c.LatencyOutlierFactor <= 1 {
+		errs = append(errs, errors.New("latencyOutlierFactor must be greater than 1, or 0 to disable"))
+	}
+	if c.BaseEjectionTime.Duration <= 0 {
+		errs = append(errs, errors.New("baseEjectionTime must be positive"))
+	}
+	if c.MaxEjectionTime.Duration < c.BaseEjectionTime.Duration {
+		errs = append(errs, errors.New("maxEjectionTime must not be smaller than baseEjectionTime"))
+	}
+	if c.MaxEjectionPercent < 0 || c.MaxEjectionPercent > 100 {
+		errs = append(errs, errors.New("maxEjectionPercent must be in [0, 100]"))
+	}
+	return errors.Join(errs...)
+}
+
+// Plugin tracks per-endpoint error rate, timeout rate and latency from the response hooks and
+// filters endpoints that are currently ejected out of the scheduling candidates.
+type Plugin struct {
+	typedName   plugin.TypedName
+	config      Config
+	pluginState *plugin.PluginState
+
+	mu            sync.Mutex
+	endpoints     map[k8stypes.NamespacedName]*endpointStats
+	intervalStart time.Time
+	// now is the clock used for all bookkeeping, replaceable in tests.
+	now func() time.Time
+}
+
+// requestState is the per-request state kept between PreRequest and the response hooks.
+type requestState struct {
+	start           time.Time
+	headersReceived bool
+}
+
+// Clone implements plugin.StateData.
+func (s *requestState) Clone() plugin.StateData {
+	clone := *s
+	return &clone
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *Plugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin.
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+// Filter removes currently ejected endpoints from the candidate list. If every candidate is
+// ejected the filter fails open and returns the input unchanged, since routing to a suspect
+// endpoint is preferable to rejecting the request.
+func (p *Plugin) Filter(ctx context.Context, _ *framework.CycleState, _ *framework.LLMRequest, endpoints []framework.Endpoint) []framework.Endpoint {
+	p.mu.Lock()
+	now := p.now()
+	p.evaluateLocked(ctx, now)
+	filtered := make([]framework.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if stats, ok := p.endpoints[endpoint.GetMetadata().NamespacedName]; ok && stats.isEjected(now) {
+			continue
+		}
+		filtered = append(filtered, endpoint)
+	}
+	p.mu.Unlock()
+
+	if len(filtered) == 0 && len(endpoints) > 0 {
+		log.FromContext(ctx).V(logutil.DEBUG).Info("All candidate endpoints are ejected, ignoring outlier detection", "candidates", len(endpoints))
+		return endpoints
+	}
+	return filtered
+}
+
+// PreRequest records the time the request is dispatched to the selected endpoint.
+func (p *Plugin) PreRequest(_ context.Context, request *framework.LLMRequest, _ *framework.SchedulingResult) {
+	p.pluginState.Write(request.RequestId, p.stateKey(), &requestState{start: p.now()})
+}
+
+// ResponseReceived classifies the response by status code and latency to first headers.
+func (p *Plugin) ResponseReceived(ctx context.Context, request *framework.LLMRequest, response *requestcontrol.Response, targetEndpoint *fwkdl.EndpointMetadata) {
+	if targetEndpoint == nil {
+		return
+	}
+	state, err := plugin.ReadPluginStateKey[*requestState](p.pluginState, request.RequestId, p.stateKey())
+	if err != nil {
+		log.FromContext(ctx).V(logutil.DEBUG).Info("No outlier detection state for request", "requestID", request.RequestId)
+		return
+	}
+	p.pluginState.Write(request.RequestId, p.stateKey(), &requestState{start: state.start, headersReceived: true})
+
+	now := p.now()
+	latency := now.Sub(state.start)
+	failed := isServerError(response) || (p.config.ResponseTimeout.Duration > 0 && latency > p.config.ResponseTimeout.Duration)
+	p.record(ctx, targetEndpoint, now, latency, failed)
+}
+
+// ResponseComplete releases the request state. A request that terminates before any response
+// headers were received is counted as a failure of the target endpoint, whatever the reason of its
+// termination: a model server that refuses or resets connections ends the stream with Envoy
+// before any headers, which the EPP observes as a client disconnect or a stream failure. Requests
+// failed by the EPP before they were dispatched are not accounted, as PreRequest did not run for
+// them.
+func (p *Plugin) ResponseComplete(ctx context.Context, request *framework.LLMRequest, _ *requestcontrol.Response,
+	_ requestcontrol.Termination, targetEndpoint *fwkdl.EndpointMetadata) {
+	state, err := plugin.ReadPluginStateKey[*requestState](p.pluginState, request.RequestId, p.stateKey())
+	p.pluginState.Delete(request.RequestId)
+	if err != nil || targetEndpoint == nil || state.headersReceived {
+		return
+	}
+	now := p.now()
+	p.record(ctx, targetEndpoint, now, now.Sub(state.start), true)
+}
+
+func (p *Plugin) stateKey() plugin.StateKey {
+	return plugin.StateKey(p.TypedName().String())
+}
+
+// record accounts a single request outcome and ejects the endpoint immediately if it crossed
+// the consecutive failures threshold.
+func (p *Plugin) record(ctx context.Context, endpoint *fwkdl.EndpointMetadata, now time.Time, latency time.Duration, failed bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.evaluateLocked(ctx, now)
+	stats, ok := p.endpoints[endpoint.NamespacedName]
+	if !ok {
+		stats = &endpointStats{metadata: endpoint}
+		p.endpoints[endpoint.NamespacedName] = stats
+	}
+	stats.requests++
+	if failed {
+		stats.failures++
+		stats.consecutiveFailures++
+	} else {
+		stats.consecutiveFailures = 0
+		stats.latencySum += latency
+		stats.latencyCount++
+	}
+
+	if p.config.ConsecutiveFailures > 0 && stats.consecutiveFailures >= p.config.ConsecutiveFailures && !stats.isEjected(now) {
+		p.ejectLocked(ctx, stats, now, reasonConsecutiveFailures)
+	}
+}
+
+// evaluateLocked restores endpoints whose ejection expired and, once per interval, ejects
+// endpoints whose failure rate or latency made them outliers over the last interval.
+func (p *Plugin) evaluateLocked(ctx context.Context, now time.Time) {
+	for _, stats := range p.endpoints {
+		if stats.ejected && !now.Before(stats.ejectedUntil) {
+			stats.ejected = false
+			recordRestored(stats.metadata)
+		}
+	}
+
+	if p.intervalStart.IsZero() {
+		p.intervalStart = now
+		return
+	}
+	if now.Sub(p.intervalStart) < p.config.Interval.Duration {
+		return
+	}
+	p.intervalStart = now
+
+	if p.config.FailureRateThreshold > 0 {
+		for _, stats := range p.endpoints {
+			if stats.requests >= p.config.MinimumRequests && !stats.isEjected(now) &&
+				float64(stats.failures)/float64(stats.requests) >= p.config.FailureRateThreshold {
+				p.ejectLocked(ctx, stats, now, reasonFailureRate)
+			}
+		}
+	}
+
+	if p.config.LatencyOutlierFactor > 0 {
+		means := make([]float64, 0, len(p.endpoints))
+		for _, stats := range p.endpoints {
+			if stats.latencyCount >= p.config.MinimumRequests {
+				means = append(means, stats.meanLatency())
+			}
+		}
+		if len(means) >= minLatencyPeers {
+			threshold := median(means) * p.config.LatencyOutlierFactor
+			for _, stats := range p.endpoints {
+				if stats.latencyCount >= p.config.MinimumRequests && !stats.isEjected(now) && stats.meanLatency() > threshold {
+					p.ejectLocked(ctx, stats, now, reasonLatency)
+				}
+			}
+		}
+	}
+
+	for name, stats := range p.endpoints {
+		healthy := stats.failures == 0 && !stats.isEjected(now)
+		if healthy && stats.ejectionCount > 0 {
+			// each healthy interval forgives one past ejection, shrinking the next backoff.
+			stats.ejectionCount--
+		}
+		if stats.requests == 0 && healthy && stats.ejectionCount == 0 {
+			// stop tracking endpoints that no longer receive traffic, e.g. deleted pods.
+			delete(p.endpoints, name)
+			deleteEjected(stats.metadata)
+			continue
+		}
+		stats.resetInterval()
+	}
+}
+
+// ejectLocked ejects the endpoint unless doing so would exceed the max ejection percentage.
+// At least one endpoint may always be ejected.
+func (p *Plugin) ejectLocked(ctx context.Context, stats *endpointStats, now time.Time, reason string) {
+	ejected := 0
+	for _, s := range p.endpoints {
+		if s.isEjected(now) {
+			ejected++
+		}
+	}
+	if ejected > 0 && float64(ejected+1)*100 > float64(p.config.MaxEjectionPercent*len(p.endpoints)) {
+		log.FromContext(ctx).V(logutil.DEBUG).Info("Skipping ejection, max ejection percent reached",
+			"endpoint", stats.metadata.NamespacedName, "reason", reason, "ejected", ejected)
+		return
+	}
+
+	stats.ejectionCount++
+	duration := time.Duration(float64(p.config.BaseEjectionTime.Duration) * math.Pow(2, float64(stats.ejectionCount-1)))
+	if duration <= 0 || duration > p.config.MaxEjectionTime.Duration {
+		duration = p.config.MaxEjectionTime.Duration
+	}
+	stats.ejected = true
+	stats.ejectedUntil = now.Add(duration)
+	stats.consecutiveFailures = 0
+
+	log.FromContext(ctx).V(logutil.DEFAULT).Info("Ejecting outlier endpoint",
+		"endpoint", stats.metadata.NamespacedName, "reason", reason, "duration", duration)
+	recordEjected(stats.metadata, reason)
+}
+
+// endpointStats holds the health bookkeeping of a single endpoint.
+type endpointStats struct {
+	metadata *fwkdl.EndpointMetadata
+
+	// counters for the current interval
+	requests     int
+	failures     int
+	latencySum   time.Duration
+	latencyCount int
+
+	consecutiveFailures int
+	// ejectionCount drives the exponential backoff of the ejection time.
+	ejectionCount int
+	ejected       bool
+	ejectedUntil  time.Time
+}
+
+func (s *endpointStats) isEjected(now time.Time) bool {
+	return s.ejected && now.Before(s.ejectedUntil)
+}
+
+func (s *endpointStats) meanLatency() float64 {
+	return float64(s.latencySum) / float64(s.latencyCount)
+}
+
+func (s *endpointStats) resetInterval() {
+	s.requests = 0
+	s.failures = 0
+	s.latencySum = 0
+	s.latencyCount = 0
+}
+
+func median(values []float64) float64 {
+	sort.Float64s(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
+
+// isServerError reports whether the response carries a 5xx status code.
+func isServerError(response *requestcontrol.Response) bool {
+	if response == nil {
+		return false
+	}
+	status, ok := response.Headers[":status"]
+	if !ok {
+		status, ok = response.Headers["status"]
+	}
+	if !ok {
+		return false
+	}
+	code, err := strconv.Atoi(status)
+	return err == nil && code >= 500
+}
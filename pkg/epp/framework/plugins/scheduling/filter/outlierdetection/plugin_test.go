@@ -0,0 +1,331 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package outlierdetection
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time          { return c.t }
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestPlugin(t *testing.T, config Config) (*Plugin, *fakeClock) {
+	t.Helper()
+	p, err := New(context.Background(), config)
+	require.NoError(t, err)
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	p.now = clock.now
+	return p, clock
+}
+
+func newEndpoint(name string) fwksched.Endpoint {
+	return fwksched.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: name},
+		PodName:        name,
+		Port:           "8000",
+	}, &fwkdl.Metrics{}, nil)
+}
+
+// serve simulates a full request lifecycle against the endpoint.
+func serve(p *Plugin, clock *fakeClock, endpoint fwksched.Endpoint, id string, status string, latency time.Duration) {
+	ctx := context.Background()
+	req := &fwksched.LLMRequest{RequestId: id}
+	p.PreRequest(ctx, req, nil)
+	clock.advance(latency)
+	p.ResponseReceived(ctx, req, &requestcontrol.Response{Headers: map[string]string{":status": status}}, endpoint.GetMetadata())
+	termination := requestcontrol.Termination{Reason: requestcontrol.TerminationCompleted}
+	if code, _ := strconv.Atoi(status); code >= 300 {
+		termination = requestcontrol.Termination{Reason: requestcontrol.TerminationUpstreamError, StatusCode: code}
+	}
+	p.ResponseComplete(ctx, req, &requestcontrol.Response{}, termination, endpoint.GetMetadata())
+}
+
+// terminate simulates a request that terminated before any response headers were received.
+func terminate(p *Plugin, clock *fakeClock, endpoint fwksched.Endpoint, id string, termination requestcontrol.Termination, latency time.Duration) {
+	ctx := context.Background()
+	req := &fwksched.LLMRequest{RequestId: id}
+	p.PreRequest(ctx, req, nil)
+	clock.advance(latency)
+	p.ResponseComplete(ctx, req, &requestcontrol.Response{}, termination, endpoint.GetMetadata())
+}
+
+func names(endpoints []fwksched.Endpoint) []string {
+	result := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		result = append(result, endpoint.GetMetadata().PodName)
+	}
+	return result
+}
+
+func TestConsecutiveFailuresEjectWithBackoff(t *testing.T) {
+	config := DefaultConfig
+	config.ConsecutiveFailures = 3
+	p, clock := newTestPlugin(t, config)
+	a, b := newEndpoint("a"), newEndpoint("b")
+	candidates := []fwksched.Endpoint{a, b}
+	ctx := context.Background()
+
+	serve(p, clock, b, "warmup", "200", time.Millisecond)
+	for i := 0; i < 2; i++ {
+		serve(p, clock, a, "fail", "503", time.Millisecond)
+	}
+	assert.Equal(t, []string{"a", "b"}, names(p.Filter(ctx, nil, nil, candidates)), "two failures should not eject")
+
+	terminate(p, clock, a, "fail", requestcontrol.Termination{Reason: requestcontrol.TerminationUpstreamError, StatusCode: 503}, time.Millisecond)
+	assert.Equal(t, []string{"b"}, names(p.Filter(ctx, nil, nil, candidates)), "third failure in a row should eject")
+
+	clock.advance(config.BaseEjectionTime.Duration)
+	assert.Equal(t, []string{"a", "b"}, names(p.Filter(ctx, nil, nil, candidates)), "ejection should expire")
+
+	for i := 0; i < 3; i++ {
+		serve(p, clock, a, "fail", "500", time.Millisecond)
+	}
+	clock.advance(config.BaseEjectionTime.Duration)
+	assert.Equal(t, []string{"b"}, names(p.Filter(ctx, nil, nil, candidates)), "second ejection should last twice as long")
+	clock.advance(config.BaseEjectionTime.Duration)
+	assert.Equal(t, []string{"a", "b"}, names(p.Filter(ctx, nil, nil, candidates)))
+}
+
+func TestSuccessResetsConsecutiveFailures(t *testing.T) {
+	config := DefaultConfig
+	config.ConsecutiveFailures = 2
+	p, clock := newTestPlugin(t, config)
+	a := newEndpoint("a")
+
+	serve(p, clock, a, "1", "502", time.Millisecond)
+	serve(p, clock, a, "2", "200", time.Millisecond)
+	serve(p, clock, a, "3", "502", time.Millisecond)
+
+	assert.Equal(t, []string{"a"}, names(p.Filter(context.Background(), nil, nil, []fwksched.Endpoint{a})))
+	assert.False(t, p.endpoints[a.GetMetadata().NamespacedName].isEjected(clock.now()))
+}
+
+func TestFailureRateEjection(t *testing.T) {
+	config := DefaultConfig
+	config.ConsecutiveFailures = 0
+	config.LatencyOutlierFactor = 0
+	config.MinimumRequests = 4
+	p, clock := newTestPlugin(t, config)
+	a, b, c := newEndpoint("a"), newEndpoint("b"), newEndpoint("c")
+	candidates := []fwksched.Endpoint{a, b, c}
+	ctx := context.Background()
+
+	p.Filter(ctx, nil, nil, candidates) // starts the first interval
+	for i := 0; i < 4; i++ {
+		status := "200"
+		if i%2 == 0 {
+			status = "500"
+		}
+		serve(p, clock, a, "a", status, time.Millisecond)
+		serve(p, clock, b, "b", "200", time.Millisecond)
+		serve(p, clock, c, "c", "200", time.Millisecond)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, names(p.Filter(ctx, nil, nil, candidates)), "rates are evaluated at interval end")
+
+	clock.advance(config.Interval.Duration)
+	assert.Equal(t, []string{"b", "c"}, names(p.Filter(ctx, nil, nil, candidates)))
+}
+
+func TestResponseTimeoutCountsAsFailure(t *testing.T) {
+	config := DefaultConfig
+	config.ConsecutiveFailures = 1
+	config.ResponseTimeout = metav1.Duration{Duration: time.Second}
+	p, clock := newTestPlugin(t, config)
+	a, b := newEndpoint("a"), newEndpoint("b")
+
+	serve(p, clock, b, "b", "200", time.Millisecond)
+	serve(p, clock, a, "a", "200", 2*time.Second)
+
+	assert.Equal(t, []string{"b"}, names(p.Filter(context.Background(), nil, nil, []fwksched.Endpoint{a, b})))
+}
+
+func TestTerminationBeforeResponseHeaders(t *testing.T) {
+	connectionReset := status.Error(codes.Unavailable, "upstream connect error or disconnect/reset before headers")
+	tests := []struct {
+		name        string
+		termination requestcontrol.Termination
+	}{
+		{
+			name:        "upstream error",
+			termination: requestcontrol.Termination{Reason: requestcontrol.TerminationUpstreamError, StatusCode: 503},
+		},
+		{
+			name:        "fast connection reset failing the stream",
+			termination: requestcontrol.Termination{Reason: requestcontrol.TerminationEPPError, Err: connectionReset},
+		},
+		{
+			name:        "fast connection reset cancelling the stream",
+			termination: requestcontrol.Termination{Reason: requestcontrol.TerminationClientCancelled, Err: context.Canceled},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := DefaultConfig
+			config.ConsecutiveFailures = 1
+			p, clock := newTestPlugin(t, config)
+			a, b := newEndpoint("a"), newEndpoint("b")
+
+			serve(p, clock, b, "b", "200", time.Millisecond)
+			terminate(p, clock, a, "a", test.termination, time.Millisecond)
+
+			assert.Equal(t, []string{"b"}, names(p.Filter(context.Background(), nil, nil, []fwksched.Endpoint{a, b})))
+		})
+	}
+}
+
+func TestRequestFailedBeforeDispatchNotAccounted(t *testing.T) {
+	config := DefaultConfig
+	config.ConsecutiveFailures = 1
+	p, _ := newTestPlugin(t, config)
+	a := newEndpoint("a")
+
+	// The EPP failed the request before PreRequest, so the plugin has no state for it.
+	p.ResponseComplete(context.Background(), &fwksched.LLMRequest{RequestId: "a"}, &requestcontrol.Response{},
+		requestcontrol.Termination{Reason: requestcontrol.TerminationEPPError}, a.GetMetadata())
+
+	assert.Equal(t, []string{"a"}, names(p.Filter(context.Background(), nil, nil, []fwksched.Endpoint{a})))
+}
+
+func TestLatencyOutlierEjection(t *testing.T) {
+	config := DefaultConfig
+	config.MinimumRequests = 2
+	p, clock := newTestPlugin(t, config)
+	endpoints := []fwksched.Endpoint{newEndpoint("a"), newEndpoint("b"), newEndpoint("c"), newEndpoint("slow")}
+	ctx := context.Background()
+
+	p.Filter(ctx, nil, nil, endpoints)
+	for i := 0; i < 2; i++ {
+		for _, endpoint := range endpoints[:3] {
+			serve(p, clock, endpoint, "fast", "200", 100*time.Millisecond)
+		}
+		serve(p, clock, endpoints[3], "slow", "200", time.Second)
+	}
+	clock.advance(config.Interval.Duration)
+
+	assert.Equal(t, []string{"a", "b", "c"}, names(p.Filter(ctx, nil, nil, endpoints)))
+}
+
+func TestMaxEjectionPercent(t *testing.T) {
+	config := DefaultConfig
+	config.ConsecutiveFailures = 1
+	config.MaxEjectionPercent = 50
+	p, clock := newTestPlugin(t, config)
+	endpoints := []fwksched.Endpoint{newEndpoint("a"), newEndpoint("b"), newEndpoint("c"), newEndpoint("d")}
+	ctx := context.Background()
+
+	serve(p, clock, endpoints[3], "ok", "200", time.Millisecond)
+	for _, endpoint := range endpoints[:3] {
+		serve(p, clock, endpoint, "fail", "500", time.Millisecond)
+	}
+
+	assert.Len(t, p.Filter(ctx, nil, nil, endpoints), 2, "at most half of the endpoints may be ejected")
+}
+
+func TestFilterFailsOpen(t *testing.T) {
+	config := DefaultConfig
+	config.ConsecutiveFailures = 1
+	p, clock := newTestPlugin(t, config)
+	a := newEndpoint("a")
+
+	serve(p, clock, a, "fail", "500", time.Millisecond)
+	assert.True(t, p.endpoints[a.GetMetadata().NamespacedName].isEjected(clock.now()))
+	assert.Equal(t, []string{"a"}, names(p.Filter(context.Background(), nil, nil, []fwksched.Endpoint{a})))
+}
+
+func TestIdleEndpointsAreForgotten(t *testing.T) {
+	endpointEjected.Reset()
+	p, clock := newTestPlugin(t, DefaultConfig)
+	a := newEndpoint("a")
+
+	serve(p, clock, a, "1", "200", time.Millisecond)
+	recordRestored(a.GetMetadata()) // the series of an endpoint ejected in the past
+	clock.advance(DefaultConfig.Interval.Duration)
+	p.Filter(context.Background(), nil, nil, nil)
+	clock.advance(DefaultConfig.Interval.Duration)
+	p.Filter(context.Background(), nil, nil, nil)
+
+	assert.Empty(t, p.endpoints)
+	assert.Zero(t, testutil.CollectAndCount(endpointEjected), "the ejection state of forgotten endpoints must be deleted")
+}
+
+func TestEjectionMetrics(t *testing.T) {
+	endpointEjected.Reset()
+	endpointEjectionsTotal.Reset()
+	config := DefaultConfig
+	config.ConsecutiveFailures = 1
+	p, clock := newTestPlugin(t, config)
+	a, b := newEndpoint("a"), newEndpoint("b")
+
+	serve(p, clock, a, "1", "500", time.Millisecond)
+	serve(p, clock, b, "2", "200", time.Millisecond)
+	assert.Equal(t, 1.0, testutil.ToFloat64(endpointEjected.WithLabelValues("a", "default", "8000")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(endpointEjectionsTotal.WithLabelValues("a", "default", "8000", reasonConsecutiveFailures)))
+
+	clock.advance(config.BaseEjectionTime.Duration)
+	p.Filter(context.Background(), nil, nil, []fwksched.Endpoint{a, b})
+	assert.Equal(t, 0.0, testutil.ToFloat64(endpointEjected.WithLabelValues("a", "default", "8000")), "a should be reported as restored")
+	assert.Equal(t, 1, testutil.CollectAndCount(endpointEjectionsTotal))
+
+	assert.ElementsMatch(t, []prometheus.Collector{endpointEjected, endpointEjectionsTotal}, p.Collectors())
+}
+
+func TestOutlierDetectionFilterFactory(t *testing.T) {
+	handle := fwkplugin.NewEppHandle(context.Background(), nil)
+
+	plugin, err := OutlierDetectionFilterFactory("od", json.RawMessage(`{"interval": "1m", "baseEjectionTime": "5s", "maxEjectionTime": "1m"}`), handle)
+	require.NoError(t, err)
+	p := plugin.(*Plugin)
+	assert.Equal(t, "od", p.TypedName().Name)
+	assert.Equal(t, time.Minute, p.config.Interval.Duration)
+	assert.Equal(t, 5*time.Second, p.config.BaseEjectionTime.Duration)
+	assert.Equal(t, DefaultConfig.ConsecutiveFailures, p.config.ConsecutiveFailures)
+
+	invalid := []string{
+		`{"interval": 10}`,
+		`{"failureRateThreshold": 2}`,
+		`{"baseEjectionTime": "1m", "maxEjectionTime": "1s"}`,
+		`{"latencyOutlierFactor": 0.5}`,
+		`{"maxEjectionPercent": 101}`,
+	}
+	for _, params := range invalid {
+		_, err := OutlierDetectionFilterFactory("od", json.RawMessage(params), handle)
+		assert.Error(t, err, params)
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slowstart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+const (
+	// SlowStartFilterType is the type of the slow start filter plugin.
+	SlowStartFilterType = "slow-start-filter"
+
+	// CurveLinear ramps the weight of a new endpoint linearly over the window.
+	CurveLinear = "linear"
+	// CurveExponential ramps the weight of a new endpoint exponentially over the window, doubling
+	// it at a constant rate.
+	CurveExponential = "exponential"
+)
+
+// Config holds the slow start filter parameters.
+type Config struct {
+	// Window is the warm-up duration after an endpoint joined the pool, over which its weight
+	// ramps up to full.
+	Window metav1.Duration `json:"window"`
+	// Curve is the shape of the ramp, either linear or exponential.
+	Curve string `json:"curve"`
+	// MinWeightPercent is the weight of an endpoint when it joins the pool, in percent of the
+	// full weight.
+	MinWeightPercent int `json:"minWeightPercent"`
+}
+
+// DefaultConfig holds the default slow start filter parameters.
+var DefaultConfig = Config{
+	Window:           metav1.Duration{Duration: 60 * time.Second},
+	Curve:            CurveLinear,
+	MinWeightPercent: 10,
+}
+
+// compile-time type assertion
+var _ framework.Filter = &SlowStartFilter{}
+
+// SlowStartFilterFactory defines the factory function for the slow start filter.
+func SlowStartFilterFactory(name string, rawParameters json.RawMessage, _ plugin.Handle) (plugin.Plugin, error) {
+	parameters := DefaultConfig
+	if rawParameters != nil {
+		if err := plugin.StrictUnmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the %s plugin. Error: %s", SlowStartFilterType, err)
+		}
+	}
+
+	f, err := NewSlowStartFilter(parameters)
+	if err != nil {
+		return nil, err
+	}
+	return f.WithName(name), nil
+}
+
+// NewSlowStartFilter initializes a new SlowStartFilter and returns its pointer.
+func NewSlowStartFilter(config Config) (*SlowStartFilter, error) {
+	if config.Window.Duration <= 0 {
+		return nil, errors.New("window must be positive")
+	}
+	if config.Curve != CurveLinear && config.Curve != CurveExponential {
+		return nil, fmt.Errorf("curve must be %q or %q, got %q", CurveLinear, CurveExponential, config.Curve)
+	}
+	if config.MinWeightPercent < 0 || config.MinWeightPercent > 100 {
+		return nil, errors.New("minWeightPercent must be between 0 and 100")
+	}
+	if config.Curve == CurveExponential && config.MinWeightPercent == 0 {
+		return nil, errors.New("minWeightPercent must be positive with an exponential curve")
+	}
+	return &SlowStartFilter{
+		typedName: plugin.TypedName{Type: SlowStartFilterType, Name: SlowStartFilterType},
+		config:    config,
+		now:       time.Now,
+		random:    rand.Float64,
+	}, nil
+}
+
+// SlowStartFilter ramps up the share of traffic sent to endpoints that recently joined the pool,
+// so that they warm up their caches instead of being flooded with requests as soon as they are
+// ready.
+type SlowStartFilter struct {
+	typedName plugin.TypedName
+	config    Config
+	// now is the clock used to compute the endpoint age, replaceable in tests.
+	now func() time.Time
+	// random returns a number in [0, 1), replaceable in tests.
+	random func() float64
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (f *SlowStartFilter) TypedName() plugin.TypedName {
+	return f.typedName
+}
+
+// WithName sets the name of the filter.
+func (f *SlowStartFilter) WithName(name string) *SlowStartFilter {
+	f.typedName.Name = name
+	return f
+}
+
+// Filter keeps each warming endpoint with a probability scaled to its weight relative to the
+// rest of the candidates: an endpoint of weight w among candidates of total weight W is kept with
+// probability w * N / W, where N is the number of candidates. An endpoint kept by the filter is
+// then chosen by the scorers among the others, so that with scorers indifferent between the
+// candidates it receives a share w / W of the requests, its weighted share of the fleet. With
+// scorers that favor it, for example because it is idle, it still receives at most w * N / W of
+// the requests instead of all of them. Endpoints that are past their warm-up window, or whose join
+// time is unknown, have a weight of 1 and are always kept. The endpoints of the highest weight are
+// always kept, so that warming endpoints still serve when all the candidates are warming.
+func (f *SlowStartFilter) Filter(ctx context.Context, _ *framework.CycleState, _ *framework.LLMRequest, endpoints []framework.Endpoint) []framework.Endpoint {
+	now := f.now()
+	weights := make([]float64, len(endpoints))
+	totalWeight := 0.0
+	for i, endpoint := range endpoints {
+		weights[i] = f.weight(now, endpoint)
+		totalWeight += weights[i]
+	}
+	if totalWeight == 0 {
+		return endpoints
+	}
+
+	kept := make([]framework.Endpoint, 0, len(endpoints))
+	for i, endpoint := range endpoints {
+		if probability := weights[i] * float64(len(endpoints)) / totalWeight; probability >= 1 || f.random() < probability {
+			kept = append(kept, endpoint)
+		}
+	}
+	log.FromContext(ctx).V(logutil.TRACE).Info("Slow start filtered the candidate endpoints",
+		"candidates", len(endpoints), "kept", len(kept))
+	return kept
+}
+
+// weight returns the share of its full traffic an endpoint receives, in [0, 1]. The weight ramps
+// from MinWeightPercent to 1 over the window along the configured curve.
+func (f *SlowStartFilter) weight(now time.Time, endpoint framework.Endpoint) float64 {
+	attribute, ok := endpoint.Get(fwkdl.JoinTimeKey)
+	if !ok {
+		return 1
+	}
+	joinTime, ok := attribute.(*fwkdl.JoinTime)
+	if !ok {
+		return 1
+	}
+	age := now.Sub(joinTime.Time())
+	if age >= f.config.Window.Duration {
+		return 1
+	}
+	progress := max(float64(age)/float64(f.config.Window.Duration), 0)
+	minWeight := float64(f.config.MinWeightPercent) / 100
+
+	switch f.config.Curve {
+	case CurveExponential:
+		return math.Pow(minWeight, 1-progress)
+	default:
+		return minWeight + (1-minWeight)*progress
+	}
+}
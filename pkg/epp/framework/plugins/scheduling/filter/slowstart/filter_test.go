@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slowstart
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+var now = time.Unix(1000, 0)
+
+func makeEndpoint(name string, age time.Duration) fwksched.Endpoint {
+	attributes := fwkdl.NewAttributes()
+	if age >= 0 {
+		attributes.Put(fwkdl.JoinTimeKey, fwkdl.NewJoinTime(now.Add(-age)))
+	}
+	return fwksched.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: name},
+		PodName:        name,
+	}, &fwkdl.Metrics{}, attributes)
+}
+
+func TestSlowStartWeight(t *testing.T) {
+	window := metav1.Duration{Duration: 100 * time.Second}
+	tests := []struct {
+		name     string
+		config   Config
+		endpoint fwksched.Endpoint
+		want     float64
+	}{
+		{
+			name:     "unknown join time",
+			config:   Config{Window: window, Curve: CurveLinear, MinWeightPercent: 10},
+			endpoint: makeEndpoint("unknown", -1),
+			want:     1,
+		},
+		{
+			name:     "past the window",
+			config:   Config{Window: window, Curve: CurveLinear, MinWeightPercent: 10},
+			endpoint: makeEndpoint("warm", 200*time.Second),
+			want:     1,
+		},
+		{
+			name:     "linear at join",
+			config:   Config{Window: window, Curve: CurveLinear, MinWeightPercent: 10},
+			endpoint: makeEndpoint("new", 0),
+			want:     0.1,
+		},
+		{
+			name:     "linear half way",
+			config:   Config{Window: window, Curve: CurveLinear, MinWeightPercent: 10},
+			endpoint: makeEndpoint("new", 50*time.Second),
+			want:     0.55,
+		},
+		{
+			name:     "linear from zero",
+			config:   Config{Window: window, Curve: CurveLinear, MinWeightPercent: 0},
+			endpoint: makeEndpoint("new", 25*time.Second),
+			want:     0.25,
+		},
+		{
+			name:     "exponential at join",
+			config:   Config{Window: window, Curve: CurveExponential, MinWeightPercent: 1},
+			endpoint: makeEndpoint("new", 0),
+			want:     0.01,
+		},
+		{
+			name:     "exponential half way",
+			config:   Config{Window: window, Curve: CurveExponential, MinWeightPercent: 1},
+			endpoint: makeEndpoint("new", 50*time.Second),
+			want:     0.1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := NewSlowStartFilter(test.config)
+			require.NoError(t, err)
+			assert.InDelta(t, test.want, filter.weight(now, test.endpoint), 1e-9)
+		})
+	}
+}
+
+func TestSlowStartFilter(t *testing.T) {
+	warm := makeEndpoint("warm", 2*time.Minute)
+	unknown := makeEndpoint("unknown", -1)
+	// Linear weights of 0.1 and 0.55 with the default configuration. Among the four endpoints, of
+	// total weight 2.65, they are kept with probabilities 0.15 and 0.83.
+	justJoined := makeEndpoint("just-joined", 0)
+	halfWay := makeEndpoint("half-way", 30*time.Second)
+
+	tests := []struct {
+		name      string
+		random    float64
+		endpoints []fwksched.Endpoint
+		want      []fwksched.Endpoint
+	}{
+		{
+			name:      "warming endpoints dropped",
+			random:    0.9,
+			endpoints: []fwksched.Endpoint{warm, justJoined, halfWay, unknown},
+			want:      []fwksched.Endpoint{warm, unknown},
+		},
+		{
+			name:      "warming endpoints kept with a probability scaled to their weight",
+			random:    0.5,
+			endpoints: []fwksched.Endpoint{warm, justJoined, halfWay, unknown},
+			want:      []fwksched.Endpoint{warm, halfWay, unknown},
+		},
+		{
+			name:      "all endpoints kept",
+			random:    0.1,
+			endpoints: []fwksched.Endpoint{warm, justJoined, halfWay, unknown},
+			want:      []fwksched.Endpoint{warm, justJoined, halfWay, unknown},
+		},
+		{
+			name:      "heaviest endpoint kept when all are warming",
+			random:    0.99,
+			endpoints: []fwksched.Endpoint{justJoined, halfWay},
+			want:      []fwksched.Endpoint{halfWay},
+		},
+		{
+			name:      "no endpoints",
+			random:    0,
+			endpoints: []fwksched.Endpoint{},
+			want:      []fwksched.Endpoint{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := NewSlowStartFilter(DefaultConfig)
+			require.NoError(t, err)
+			filter.now = func() time.Time { return now }
+			filter.random = func() float64 { return test.random }
+			got := filter.Filter(context.Background(), fwksched.NewCycleState(), &fwksched.LLMRequest{}, test.endpoints)
+			if diff := cmp.Diff(test.want, got, cmp.Comparer(fwksched.EndpointComparer)); diff != "" {
+				t.Errorf("Unexpected output (-want +got): %v", diff)
+			}
+		})
+	}
+}
+
+// TestSlowStartShare checks the share of the requests a warming endpoint receives among warm ones,
+// depending on how the scorers rank it after the filter.
+func TestSlowStartShare(t *testing.T) {
+	justJoined := makeEndpoint("just-joined", 0)
+	endpoints := []fwksched.Endpoint{justJoined}
+	for _, name := range []string{"warm-1", "warm-2", "warm-3", "warm-4"} {
+		endpoints = append(endpoints, makeEndpoint(name, 2*time.Minute))
+	}
+
+	tests := []struct {
+		name string
+		// pick returns the endpoint chosen among the ones kept by the filter.
+		pick func(random *rand.Rand, kept []fwksched.Endpoint) fwksched.Endpoint
+		want float64
+	}{
+		{
+			name: "scorers indifferent between the endpoints",
+			pick: func(random *rand.Rand, kept []fwksched.Endpoint) fwksched.Endpoint {
+				return kept[random.IntN(len(kept))]
+			},
+			// The weighted share 0.1 / (4 + 0.1) of the warming endpoint.
+			want: 0.1 / 4.1,
+		},
+		{
+			name: "scorers favoring the warming endpoint",
+			pick: func(_ *rand.Rand, kept []fwksched.Endpoint) fwksched.Endpoint {
+				return kept[0]
+			},
+			// Its probability 0.1 * 5 / (4 + 0.1) to be kept, instead of all the requests.
+			want: 0.5 / 4.1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := NewSlowStartFilter(DefaultConfig)
+			require.NoError(t, err)
+			random := rand.New(rand.NewPCG(1, 2))
+			filter.now = func() time.Time { return now }
+			filter.random = random.Float64
+
+			const requests = 100000
+			picked := 0
+			for range requests {
+				kept := filter.Filter(context.Background(), fwksched.NewCycleState(), &fwksched.LLMRequest{}, endpoints)
+				if test.pick(random, kept) == justJoined {
+					picked++
+				}
+			}
+			assert.InDelta(t, test.want, float64(picked)/requests, 0.005)
+		})
+	}
+}
+
+func TestSlowStartFilterFactory(t *testing.T) {
+	plugin, err := SlowStartFilterFactory("slow-start", json.RawMessage(`{"window": "2m", "curve": "exponential", "minWeightPercent": 5}`), nil)
+	require.NoError(t, err)
+	filter := plugin.(*SlowStartFilter)
+	assert.Equal(t, "slow-start", filter.TypedName().Name)
+	assert.Equal(t, Config{Window: metav1.Duration{Duration: 2 * time.Minute}, Curve: CurveExponential, MinWeightPercent: 5}, filter.config)
+
+	for _, params := range []string{
+		`{"window": "0s"}`,
+		`{"curve": "step"}`,
+		`{"minWeightPercent": 101}`,
+		`{"curve": "exponential", "minWeightPercent": 0}`,
+		`{"window": 1}`,
+		`{"windw": "2m"}`,
+	} {
+		_, err := SlowStartFilterFactory("slow-start", json.RawMessage(params), nil)
+		assert.Error(t, err, params)
+	}
+}
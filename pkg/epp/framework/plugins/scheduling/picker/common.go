@@ -27,11 +27,14 @@ const (
 	DefaultMaxNumOfEndpoints = 1 // common default to all pickers
 )
 
-// pickerParameters defines the common parameters for all pickers
-type pickerParameters struct {
+// Parameters defines the common parameters for all pickers
+type Parameters struct {
 	MaxNumOfEndpoints int `json:"maxNumOfEndpoints"`
 }
 
+// DefaultParameters are the parameters of the pickers created without parameters.
+var DefaultParameters = Parameters{MaxNumOfEndpoints: DefaultMaxNumOfEndpoints}
+
 func shuffleScoredEndpoints(scoredEndpoints []*types.ScoredEndpoint) {
 	// Rand package is not safe for concurrent use, so we create a new instance.
 	// Source: https://pkg.go.dev/math/rand/v2#pkg-overview
@@ -22,11 +22,16 @@ import (
 
 	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrlora "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/lora"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/extractor/metrics"
 )
 
 const (
 	LoraAffinityScorerType = "lora-affinity-scorer"
+
+	// unplannedScoreFactor scales the score of endpoints that the adapter placement plan does not
+	// assign the target adapter to, so that planned endpoints win over an otherwise equal choice.
+	unplannedScoreFactor = 0.5
 )
 
 // compile-time type assertion
@@ -62,8 +67,9 @@ func (s *LoraAffinityScorer) Category() framework.ScorerCategory {
 // Consumes returns the list of data that is consumed by the plugin.
 func (s *LoraAffinityScorer) Consumes() map[string]any {
 	return map[string]any{
-		metrics.ActiveModelsKey:  map[string]int{},
-		metrics.WaitingModelsKey: map[string]int{},
+		metrics.ActiveModelsKey:   map[string]int{},
+		metrics.WaitingModelsKey:  map[string]int{},
+		attrlora.LoraPlacementKey: attrlora.LoraPlacement{},
 	}
 }
 
@@ -96,6 +102,13 @@ func (s *LoraAffinityScorer) Score(_ context.Context, _ *framework.CycleState, r
 		default:
 			scores[endpoint] = 0.0
 		}
+
+		// When an adapter placement plan covers the target adapter, prefer the endpoints it is planned on.
+		if placementRaw, ok := endpoint.Get(attrlora.LoraPlacementKey); ok {
+			if placement, ok := placementRaw.(*attrlora.LoraPlacement); ok && !placement.Planned() {
+				scores[endpoint] *= unplannedScoreFactor
+			}
+		}
 	}
 
 	return scores
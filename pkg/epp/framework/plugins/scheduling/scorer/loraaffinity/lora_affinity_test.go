@@ -25,6 +25,7 @@ import (
 
 	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	attrlora "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/attribute/lora"
 )
 
 func TestLoraAffinityScorer(t *testing.T) {
@@ -162,3 +163,30 @@ func TestLoraAffinityScorer(t *testing.T) {
 		})
 	}
 }
+
+func TestLoraAffinityScorerWithPlacement(t *testing.T) {
+	newEndpoint := func(name string, active map[string]int) fwksched.Endpoint {
+		return fwksched.NewEndpoint(
+			&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: name}},
+			&fwkdl.Metrics{ActiveModels: active, WaitingModels: map[string]int{}, MaxActiveModels: 2}, nil)
+	}
+	plannedActive := newEndpoint("planned-active", map[string]int{"adapter": 1})
+	plannedFree := newEndpoint("planned-free", map[string]int{})
+	unplannedActive := newEndpoint("unplanned-active", map[string]int{"adapter": 1})
+	unplannedFree := newEndpoint("unplanned-free", map[string]int{})
+	notCovered := newEndpoint("not-covered", map[string]int{})
+
+	plannedActive.Put(attrlora.LoraPlacementKey, attrlora.NewLoraPlacement(true))
+	plannedFree.Put(attrlora.LoraPlacementKey, attrlora.NewLoraPlacement(true))
+	unplannedActive.Put(attrlora.LoraPlacementKey, attrlora.NewLoraPlacement(false))
+	unplannedFree.Put(attrlora.LoraPlacementKey, attrlora.NewLoraPlacement(false))
+
+	endpoints := []fwksched.Endpoint{plannedActive, plannedFree, unplannedActive, unplannedFree, notCovered}
+	scores := NewLoraAffinityScorer().Score(context.Background(), fwksched.NewCycleState(), &fwksched.LLMRequest{TargetModel: "adapter"}, endpoints)
+
+	assert.InDelta(t, 1.0, scores[plannedActive], 0.0001)
+	assert.InDelta(t, 0.8, scores[plannedFree], 0.0001)
+	assert.InDelta(t, 0.5, scores[unplannedActive], 0.0001)
+	assert.InDelta(t, 0.4, scores[unplannedFree], 0.0001)
+	assert.InDelta(t, 0.8, scores[notCovered], 0.0001)
+}
@@ -33,7 +33,7 @@ func (s *PredictedLatency) PrepareRequestData(ctx context.Context, request *sche
 	logger := log.FromContext(ctx)
 	predictedLatencyCtx := s.getOrMakePredictedLatencyContextForRequest(request)
 
-	s.parseSLOHeaders(ctx, request, predictedLatencyCtx)
+	s.parseSLOs(request, predictedLatencyCtx)
 	var prefixCacheScore float64
 	for _, endpoint := range endpoints {
 
@@ -249,7 +249,11 @@ func (t *PredictedLatency) ResponseStreaming(ctx context.Context, request *sched
 
 }
 
-func (t *PredictedLatency) ResponseComplete(ctx context.Context, request *schedulingtypes.LLMRequest, response *requestcontrol.Response, metadata *fwkdl.EndpointMetadata) {
+// ResponseComplete records the latencies of the request and trains the predictor with them, then releases the state of
+// the request. Requests which did not complete successfully release their state only, as their latencies do not
+// reflect the endpoint: an aborted stream or an error status would skew the predictor.
+func (t *PredictedLatency) ResponseComplete(ctx context.Context, request *schedulingtypes.LLMRequest, _ *requestcontrol.Response,
+	termination requestcontrol.Termination, metadata *fwkdl.EndpointMetadata) {
 	logger := log.FromContext(ctx)
 	if request == nil {
 		logger.V(logutil.DEBUG).Info("PredictedLatency.ResponseComplete: request is nil, skipping")
@@ -266,6 +270,38 @@ func (t *PredictedLatency) ResponseComplete(ctx context.Context, request *schedu
 		logger.V(logutil.DEBUG).Info("PredictedLatency.ResponseComplete: Failed to get SLO context for request", "error", err, "requestID", id)
 		return
 	}
+	if termination.Succeeded() {
+		t.recordCompletion(ctx, request, predictedLatencyCtx, targetMetadata)
+	} else {
+		logger.V(logutil.DEBUG).Info("PredictedLatency.ResponseComplete: request did not complete, skipping latency recording",
+			"reason", termination.Reason, "statusCode", termination.StatusCode)
+	}
+
+	// Decrement per-pod token-in-flight counters now that the request is complete.
+	// Also clean up the map entry if the counter reaches zero, preventing stale entries
+	// from accumulating when pods are removed.
+	decodePodKey := targetMetadata.NamespacedName.String()
+	// In streaming mode the prefill pod counter was already decremented at first-token time
+	// (ResponseStreaming). In non-streaming mode, decrement it here at completion.
+	if !t.config.StreamingMode && predictedLatencyCtx.prefillTargetMetadata != nil {
+		prefillPodKey := predictedLatencyCtx.prefillTargetMetadata.NamespacedName.String()
+		if t.podCounter(&t.prefillTokensInFlight, prefillPodKey).Add(-int64(predictedLatencyCtx.inputTokenCount)) == 0 {
+			t.prefillTokensInFlight.Delete(prefillPodKey)
+		}
+	}
+	if t.podCounter(&t.prefillTokensInFlight, decodePodKey).Add(-int64(predictedLatencyCtx.inputTokenCount)) == 0 {
+		t.prefillTokensInFlight.Delete(decodePodKey)
+	}
+
+	id := request.Headers[reqcommon.RequestIdHeaderKey]
+	t.removeRequestFromQueue(id, predictedLatencyCtx)
+	t.deletePredictedLatencyContextForRequest(request)
+}
+
+// recordCompletion records the TTFT and TPOT of a completed request and trains the predictor with its average TPOT.
+func (t *PredictedLatency) recordCompletion(ctx context.Context, request *schedulingtypes.LLMRequest, predictedLatencyCtx *predictedLatencyCtx,
+	targetMetadata *fwkdl.EndpointMetadata) {
+	logger := log.FromContext(ctx)
 	now := time.Now()
 	if !t.config.StreamingMode {
 		processFirstTokenForLatencyPrediction(ctx, t.latencypredictor, t.config.StreamingMode, t.config.EndpointRoleLabel, predictedLatencyCtx, now, t.config.SamplingMean, t.config.MaxSampledTokens)
@@ -314,26 +350,6 @@ func (t *PredictedLatency) ResponseComplete(ctx context.Context, request *schedu
 			}
 		}
 	}
-
-	// Decrement per-pod token-in-flight counters now that the request is complete.
-	// Also clean up the map entry if the counter reaches zero, preventing stale entries
-	// from accumulating when pods are removed.
-	decodePodKey := targetMetadata.NamespacedName.String()
-	// In streaming mode the prefill pod counter was already decremented at first-token time
-	// (ResponseStreaming). In non-streaming mode, decrement it here at completion.
-	if !t.config.StreamingMode && predictedLatencyCtx.prefillTargetMetadata != nil {
-		prefillPodKey := predictedLatencyCtx.prefillTargetMetadata.NamespacedName.String()
-		if t.podCounter(&t.prefillTokensInFlight, prefillPodKey).Add(-int64(predictedLatencyCtx.inputTokenCount)) == 0 {
-			t.prefillTokensInFlight.Delete(prefillPodKey)
-		}
-	}
-	if t.podCounter(&t.prefillTokensInFlight, decodePodKey).Add(-int64(predictedLatencyCtx.inputTokenCount)) == 0 {
-		t.prefillTokensInFlight.Delete(decodePodKey)
-	}
-
-	id := request.Headers[reqcommon.RequestIdHeaderKey]
-	t.removeRequestFromQueue(id, predictedLatencyCtx)
-	t.deletePredictedLatencyContextForRequest(request)
 }
 
 func (t *PredictedLatency) checkPredictor(logger logr.Logger, metadata *fwkdl.EndpointMetadata) bool {
@@ -41,6 +41,8 @@ const (
 	waitingQueue    = 1
 )
 
+var completed = requestcontrol.Termination{Reason: requestcontrol.TerminationCompleted}
+
 // Helper functions
 
 func createTestSchedulingResult(metadata *fwkdl.EndpointMetadata) *schedulingtypes.SchedulingResult {
@@ -462,7 +464,7 @@ func TestPredictedLatency_ResponseComplete_QueueNotFound(t *testing.T) {
 	router.runningRequestLists.Store(endpoint.GetMetadata().NamespacedName, newRequestPriorityQueue())
 
 	// Should handle gracefully when request is not in queue
-	router.ResponseComplete(ctx, request, response, endpoint.GetMetadata())
+	router.ResponseComplete(ctx, request, response, completed, endpoint.GetMetadata())
 
 	// Context should be deleted
 	_, err := router.getPredictedLatencyContextForRequest(request)
@@ -511,7 +513,7 @@ func TestPredictedLatency_ResponseComplete_Success(t *testing.T) {
 	predictedLatencyCtx.targetMetadata = endpoint.GetMetadata()
 	router.setPredictedLatencyContextForRequest(request, predictedLatencyCtx)
 
-	router.ResponseComplete(ctx, request, response, endpoint.GetMetadata())
+	router.ResponseComplete(ctx, request, response, completed, endpoint.GetMetadata())
 
 	// Verify context was deleted
 	_, err := router.getPredictedLatencyContextForRequest(request)
@@ -521,6 +523,47 @@ func TestPredictedLatency_ResponseComplete_Success(t *testing.T) {
 	assert.Equal(t, 0, queue.Len())
 }
 
+func TestPredictedLatency_ResponseComplete_NotSucceeded(t *testing.T) {
+	for _, reason := range []requestcontrol.TerminationReason{
+		requestcontrol.TerminationClientCancelled,
+		requestcontrol.TerminationUpstreamError,
+		requestcontrol.TerminationEPPError,
+	} {
+		t.Run(string(reason), func(t *testing.T) {
+			router := createTestRouter()
+			router.config.StreamingMode = false
+			router.latencypredictor = new(mockPredictor)
+
+			ctx := context.Background()
+			endpoint := createTestEndpoint("test-pod", 1, 1, 1)
+			request := createTestLLMRequest("test", 100, 50)
+
+			queue := newRequestPriorityQueue()
+			router.runningRequestLists.Store(endpoint.GetMetadata().NamespacedName, queue)
+			queue.Add(request.Headers[reqcommon.RequestIdHeaderKey], 50.0)
+
+			predictedLatencyCtx := newPredictedLatencyContext(request)
+			predictedLatencyCtx.inputTokenCount = 10
+			predictedLatencyCtx.targetMetadata = endpoint.GetMetadata()
+			router.setPredictedLatencyContextForRequest(request, predictedLatencyCtx)
+			router.podCounter(&router.prefillTokensInFlight, endpoint.GetMetadata().NamespacedName.String()).Add(10)
+
+			router.ResponseComplete(ctx, request, &requestcontrol.Response{}, requestcontrol.Termination{Reason: reason}, endpoint.GetMetadata())
+
+			// The latencies of the request are not recorded.
+			assert.Zero(t, predictedLatencyCtx.ttft)
+			assert.Zero(t, predictedLatencyCtx.generatedTokenCount)
+
+			// The state of the request is released.
+			_, err := router.getPredictedLatencyContextForRequest(request)
+			assert.Error(t, err)
+			assert.Equal(t, 0, queue.Len())
+			_, ok := router.prefillTokensInFlight.Load(endpoint.GetMetadata().NamespacedName.String())
+			assert.False(t, ok)
+		})
+	}
+}
+
 func TestPredictedLatency_ResponseComplete_NilPredictor(t *testing.T) {
 	router := createTestRouter()
 	router.latencypredictor = nil
@@ -534,7 +577,7 @@ func TestPredictedLatency_ResponseComplete_NilPredictor(t *testing.T) {
 	router.setPredictedLatencyContextForRequest(request, predictedLatencyCtx)
 
 	// Should not panic
-	router.ResponseComplete(ctx, request, response, endpoint.GetMetadata())
+	router.ResponseComplete(ctx, request, response, completed, endpoint.GetMetadata())
 
 	// Context should still exist (deletion happens only with predictor)
 	_, err := router.getPredictedLatencyContextForRequest(request)
@@ -554,7 +597,7 @@ func TestPredictedLatency_ResponseComplete_NoPod(t *testing.T) {
 	router.setPredictedLatencyContextForRequest(request, predictedLatencyCtx)
 
 	// Should not panic with nil pod
-	router.ResponseComplete(ctx, request, response, nil)
+	router.ResponseComplete(ctx, request, response, completed, nil)
 
 	// Context should still exist (deletion happens only with validpod.GetPod())
 	_, err := router.getPredictedLatencyContextForRequest(request)
@@ -572,7 +615,7 @@ func TestPredictedLatency_ResponseComplete_NoContext(t *testing.T) {
 	response := &requestcontrol.Response{}
 
 	// Don't set SLO context - should handle gracefully
-	router.ResponseComplete(ctx, request, response, endpoint.GetMetadata())
+	router.ResponseComplete(ctx, request, response, completed, endpoint.GetMetadata())
 
 	// Should not panic
 
@@ -604,7 +647,7 @@ func TestPredictedLatency_ResponseComplete_WithMetrics(t *testing.T) {
 	router.setPredictedLatencyContextForRequest(request, predictedLatencyCtx)
 
 	// Should record metrics without panicking
-	router.ResponseComplete(ctx, request, response, endpoint.GetMetadata())
+	router.ResponseComplete(ctx, request, response, completed, endpoint.GetMetadata())
 
 	// Verify cleanup
 	_, err := router.getPredictedLatencyContextForRequest(request)
@@ -633,7 +676,7 @@ func TestPredictedLatency_ResponseComplete_NoSLOs(t *testing.T) {
 	router.setPredictedLatencyContextForRequest(request, predictedLatencyCtx)
 
 	// Should handle missing SLOs gracefully
-	router.ResponseComplete(ctx, request, response, endpoint.GetMetadata())
+	router.ResponseComplete(ctx, request, response, completed, endpoint.GetMetadata())
 
 	// Verify cleanup
 	_, err := router.getPredictedLatencyContextForRequest(request)
@@ -842,7 +885,7 @@ func TestPredictedLatency_RequestLifecycle_Complete(t *testing.T) {
 	retrievedCtx.ttft = 80
 	retrievedCtx.avgTPOT = 30
 	router.setPredictedLatencyContextForRequest(request, retrievedCtx)
-	router.ResponseComplete(ctx, request, response, endpoint.GetMetadata())
+	router.ResponseComplete(ctx, request, response, completed, endpoint.GetMetadata())
 
 	// Verify context was cleaned up
 	_, err = router.getPredictedLatencyContextForRequest(request)
@@ -76,6 +76,10 @@ type Config struct {
 	SelectionMode             string        `json:"selectionMode,omitempty"`
 	StreamingMode             bool          `json:"streamingMode,omitempty"`
 	EndpointRoleLabel         string        `json:"endpointRoleLabel,omitempty"`
+	LatencyModel              string        `json:"latencyModel,omitempty"`
+	OnlineModelQuantile       float64       `json:"onlineModelQuantile,omitempty"`
+	OnlineModelStateFile      string        `json:"onlineModelStateFile,omitempty"`
+	OnlineModelSaveEvery      time.Duration `json:"onlineModelSaveEvery,omitempty"`
 }
 
 var DefaultConfig = Config{
@@ -97,6 +101,9 @@ var DefaultConfig = Config{
 	ContextTTL:                5 * time.Minute,
 	SelectionMode:             "linear",
 	StreamingMode:             true,
+	LatencyModel:              latencyModelRemote,
+	OnlineModelQuantile:       0.9,
+	OnlineModelSaveEvery:      time.Minute,
 }
 
 func PredictedLatencyFactory(name string, rawParameters json.RawMessage, handle plugin.Handle) (plugin.Plugin, error) {
@@ -111,7 +118,7 @@ func PredictedLatencyFactory(name string, rawParameters json.RawMessage, handle
 		return nil, fmt.Errorf("invalid PredictedLatency config: %w", err)
 	}
 
-	predictor, err := startPredictor(handle)
+	predictor, err := startPredictor(handle, parameters)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start latency predictor: %w", err)
 	}
@@ -157,6 +164,19 @@ func (c *Config) validate() error {
 		errs = append(errs, fmt.Errorf("affinityGateTauGlobal must be in (0, 1], got %f", c.AffinityGateTauGlobal))
 	}
 
+	switch c.LatencyModel {
+	case latencyModelRemote:
+	case latencyModelOnline:
+		if c.OnlineModelQuantile <= 0 || c.OnlineModelQuantile >= 1 {
+			errs = append(errs, fmt.Errorf("onlineModelQuantile must be in (0, 1), got %f", c.OnlineModelQuantile))
+		}
+		if c.OnlineModelStateFile != "" && c.OnlineModelSaveEvery <= 0 {
+			errs = append(errs, fmt.Errorf("onlineModelSaveEvery must be > 0, got %s", c.OnlineModelSaveEvery))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("latencyModel must be %q or %q, got %q", latencyModelRemote, latencyModelOnline, c.LatencyModel))
+	}
+
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
@@ -211,9 +231,27 @@ func NewPredictedLatency(config Config, predictor latencypredictor.PredictorInte
 	return predictedLatency
 }
 
-func startPredictor(handle plugin.Handle) (latencypredictor.PredictorInterface, error) {
+func startPredictor(handle plugin.Handle, config Config) (latencypredictor.PredictorInterface, error) {
 	// Initialize the latency predictor
-	predictor := latencypredictor.New(latencypredictor.ConfigFromEnv(), ctrl.Log.WithName("latency-predictor"))
+	var predictor interface {
+		latencypredictor.PredictorInterface
+		Start(ctx context.Context) error
+		Stop(ctx context.Context)
+	}
+	switch config.LatencyModel {
+	case latencyModelOnline:
+		onlineConfig := latencypredictor.DefaultOnlineConfig()
+		onlineConfig.Quantile = config.OnlineModelQuantile
+		onlineConfig.StateFile = config.OnlineModelStateFile
+		onlineConfig.SaveInterval = config.OnlineModelSaveEvery
+		online, err := latencypredictor.NewOnlinePredictor(onlineConfig, ctrl.Log.WithName("latency-predictor"))
+		if err != nil {
+			return nil, err
+		}
+		predictor = online
+	default:
+		predictor = latencypredictor.New(latencypredictor.ConfigFromEnv(), ctrl.Log.WithName("latency-predictor"))
+	}
 	if err := predictor.Start(handle.Context()); err != nil {
 		return nil, fmt.Errorf("failed to start latency predictor: %w", err)
 	}
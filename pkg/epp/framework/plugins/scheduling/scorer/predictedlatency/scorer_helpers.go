@@ -18,31 +18,21 @@ package predictedlatency
 
 import (
 	"context"
-	"fmt"
 	"math/rand"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
-	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 	schedulingtypes "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 )
 
-func (s *PredictedLatency) parseSLOHeaders(ctx context.Context, request *schedulingtypes.LLMRequest, predictedLatencyCtx *predictedLatencyCtx) {
-	logger := log.FromContext(ctx)
-	var err error
-
-	// Get Request SLOs from request header
-	predictedLatencyCtx.ttftSLO, err = parseFloatHeader(*request, ttftSLOHeaderKey)
-	if err != nil {
-		logger.V(logutil.DEBUG).Error(errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("%v must be a float: %v", ttftSLOHeaderKey, err)}, "PredictedLatency: Error parsing TTFT SLO from header")
-	}
-
-	predictedLatencyCtx.avgTPOTSLO, err = parseFloatHeader(*request, tpotSLOHeaderKey)
-	if err != nil {
-		logger.V(logutil.DEBUG).Error(errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("%v must be a float: %v", tpotSLOHeaderKey, err)}, "PredictedLatency: Error parsing TPOT SLO from header")
-	}
+// parseSLOs sets the latency targets of the request, in milliseconds. The Director resolves them
+// from the InferenceObjective of the request, tightened by its SLO headers.
+func (s *PredictedLatency) parseSLOs(request *schedulingtypes.LLMRequest, predictedLatencyCtx *predictedLatencyCtx) {
+	predictedLatencyCtx.ttftSLO = float64(request.Objectives.TTFT) / float64(time.Millisecond)
+	predictedLatencyCtx.avgTPOTSLO = float64(request.Objectives.TPOT) / float64(time.Millisecond)
 }
 
 func (s *PredictedLatency) classifyEndpointsByHeadroom(allPreds []endpointPredictionResult) (posHeadroomEndpoints, negHeadroomEndpoints []endpointPredictionResult) {
@@ -799,3 +799,36 @@ func TestSloContextStoreEviction(t *testing.T) {
 	assert.Nil(t, item, "Item should have been evicted from cache")
 	assert.False(t, queue.Contains(requestID), "Request should be removed from queue via OnEviction")
 }
+
+func TestConfigValidateLatencyModel(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Config)
+		wantErr bool
+	}{
+		{name: "remote by default", modify: func(c *Config) {}},
+		{name: "online", modify: func(c *Config) { c.LatencyModel = latencyModelOnline }},
+		{name: "online with state file", modify: func(c *Config) {
+			c.LatencyModel = latencyModelOnline
+			c.OnlineModelStateFile = "/var/lib/epp/latency-model.json"
+		}},
+		{name: "unknown model", modify: func(c *Config) { c.LatencyModel = "python" }, wantErr: true},
+		{name: "online quantile out of range", modify: func(c *Config) {
+			c.LatencyModel = latencyModelOnline
+			c.OnlineModelQuantile = 1
+		}, wantErr: true},
+		{name: "online state file without save interval", modify: func(c *Config) {
+			c.LatencyModel = latencyModelOnline
+			c.OnlineModelStateFile = "/var/lib/epp/latency-model.json"
+			c.OnlineModelSaveEvery = 0
+		}, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultConfig
+			tc.modify(&cfg)
+			err := cfg.validate()
+			assert.Equal(t, tc.wantErr, err != nil, "validate() error = %v", err)
+		})
+	}
+}
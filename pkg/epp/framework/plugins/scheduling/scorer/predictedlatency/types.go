@@ -37,11 +37,6 @@ const (
 	headroomStrategyCompositeLeast headroomStrategy = "composite-least"
 	headroomStrategyCompositeMost  headroomStrategy = "composite-most"
 	headroomStrategyCompositeOnly  headroomStrategy = "composite-only"
-
-	// TTFT header string
-	ttftSLOHeaderKey = "x-slo-ttft-ms"
-	// TPOT header string
-	tpotSLOHeaderKey = "x-slo-tpot-ms"
 )
 
 const (
@@ -51,6 +46,13 @@ const (
 	minWeight                  = 1
 )
 
+const (
+	// latencyModelRemote predicts latencies with the Python training and prediction servers.
+	latencyModelRemote = "remote"
+	// latencyModelOnline predicts latencies with a model learned in process.
+	latencyModelOnline = "online"
+)
+
 type podSelectionMode string
 
 const (
@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
 	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
@@ -159,6 +160,7 @@ func (s *SchedulingContextState) Clone() plugin.StateData {
 var (
 	_ framework.Scorer          = &Plugin{}
 	_ requestcontrol.PreRequest = &Plugin{}
+	_ fwkdl.EndpointListener    = &Plugin{}
 )
 
 // PrefixCachePluginFactory defines the factory function for Prefix plugin.
@@ -166,7 +168,7 @@ func PrefixCachePluginFactory(name string, rawParameters json.RawMessage, handle
 	parameters := DefaultConfig
 
 	if rawParameters != nil {
-		if err := json.Unmarshal(rawParameters, &parameters); err != nil {
+		if err := plugin.StrictUnmarshal(rawParameters, &parameters); err != nil {
 			return nil, fmt.Errorf("failed to parse the parameters of the %s plugin. Error: %s", PrefixCachePluginType, err)
 		}
 	}
@@ -393,6 +395,20 @@ func (m *Plugin) CleanUpInactivePods(ctx context.Context, handle plugin.Handle)
 	}
 }
 
+// EndpointDraining drops the prefix cache entries of a draining endpoint, since it receives no
+// new requests that could hit them.
+func (m *Plugin) EndpointDraining(ctx context.Context, endpoint *fwkdl.EndpointMetadata) {
+	m.indexer.RemovePod(ServerID(endpoint.NamespacedName))
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("Removed draining pod", "pod", endpoint.NamespacedName)
+}
+
+// EndpointRemoved drops the prefix cache entries of a removed endpoint, including those added by
+// requests scheduled while it started draining.
+func (m *Plugin) EndpointRemoved(ctx context.Context, endpoint *fwkdl.EndpointMetadata) {
+	m.indexer.RemovePod(ServerID(endpoint.NamespacedName))
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("Removed pod", "pod", endpoint.NamespacedName)
+}
+
 // hashPrompt divides the prompt into blocks and calculate the prefix cache for each block.
 // hash[0] is calculated including the model name and cache_salt(if provided), since different models generally don't share prefix cache.
 // For block i, hash(i) = hash(block i content, hash(i-1)).
@@ -744,3 +744,51 @@ func BenchmarkPrefixPluginChatCompletionsStress(b *testing.B) {
 		})
 	}
 }
+
+func TestPrefixPluginEndpointLifecycle(t *testing.T) {
+	config := Config{
+		BlockSizeTokens:        1,
+		MaxPrefixBlocksToMatch: DefaultMaxPrefixBlocks,
+		LRUCapacityPerServer:   DefaultLRUCapacityPerServer,
+	}
+	plugin, err := New(context.Background(), config)
+	assert.NoError(t, err)
+
+	endpoint1 := fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, fwkdl.NewMetrics(), nil)
+	endpoint2 := fwksched.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod2"}}, fwkdl.NewMetrics(), nil)
+	endpoints := []fwksched.Endpoint{endpoint1, endpoint2}
+
+	schedule := func(target fwksched.Endpoint) map[fwksched.Endpoint]float64 {
+		req := &fwksched.LLMRequest{
+			RequestId:   uuid.NewString(),
+			TargetModel: "test-model",
+			Body: &fwksched.LLMRequestBody{
+				Completions: &fwksched.CompletionsRequest{Prompt: "aaaaaaaa"},
+			},
+		}
+		scores := plugin.Score(context.Background(), fwksched.NewCycleState(), req, endpoints)
+		plugin.PreRequest(context.Background(), req, &fwksched.SchedulingResult{
+			PrimaryProfileName: "default",
+			ProfileResults:     map[string]*fwksched.ProfileRunResult{"default": {TargetEndpoints: []fwksched.Endpoint{target}}},
+		})
+		plugin.wg.Wait()
+		return scores
+	}
+
+	schedule(endpoint1)
+	schedule(endpoint2)
+	scores := schedule(endpoint1)
+	assert.Equal(t, float64(1), scores[endpoint1], "score for endpoint1")
+	assert.Equal(t, float64(1), scores[endpoint2], "score for endpoint2")
+
+	plugin.EndpointDraining(context.Background(), endpoint1.GetMetadata())
+	assert.ElementsMatch(t, []ServerID{ServerID(endpoint2.GetMetadata().NamespacedName)}, plugin.indexer.Pods())
+
+	// A request scheduled to the endpoint while it started draining is dropped on removal.
+	schedule(endpoint1)
+	plugin.EndpointRemoved(context.Background(), endpoint1.GetMetadata())
+	assert.ElementsMatch(t, []ServerID{ServerID(endpoint2.GetMetadata().NamespacedName)}, plugin.indexer.Pods())
+	scores = schedule(endpoint2)
+	assert.Equal(t, float64(0), scores[endpoint1], "score for endpoint1")
+	assert.Equal(t, float64(1), scores[endpoint2], "score for endpoint2")
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+// hostModule is the name of the module the host functions are imported from.
+const hostModule = "epp"
+
+// The functions a module exports to serve the extension points. They take no arguments, and read
+// the request and the endpoints with the host functions.
+const (
+	// scoreExport scores the endpoints with set_score.
+	scoreExport = "score"
+	// filterExport keeps the endpoints passed to keep, the other endpoints are filtered out.
+	filterExport = "filter"
+	// admitExport returns 0 to admit the request, or another value to deny it, with the reason
+	// given to set_reason.
+	admitExport = "admit"
+	// initializeExport is called once per instance, as the entry point of WASI reactors.
+	initializeExport = "_initialize"
+)
+
+// The metrics of an endpoint returned by endpoint_metric.
+const (
+	metricWaitingQueueSize uint32 = iota
+	metricRunningRequestsSize
+	metricKVCacheUsagePercent
+	metricMaxActiveModels
+	metricActiveModels
+	metricWaitingModels
+	metricKvCacheMaxTokenCapacity
+	metricCacheBlockSize
+)
+
+// notFound is returned by the host functions writing a value that does not exist.
+const notFound int32 = -1
+
+// call is the state of a call to a module, read and written by the host functions.
+type call struct {
+	pluginName string
+	request    *framework.LLMRequest
+	endpoints  []framework.Endpoint
+	scores     []float64
+	kept       []bool
+	reason     string
+}
+
+type callKey struct{}
+
+func callFrom(ctx context.Context) *call {
+	c, _ := ctx.Value(callKey{}).(*call)
+	return c
+}
+
+// instantiateHostModule defines the host functions modules import from the epp module. The
+// functions writing a value write it to the buffer (buf, bufLen) of the module memory when it fits,
+// and return its length either way, or -1 when it does not exist, so that modules can retry with a
+// larger buffer.
+func instantiateHostModule(ctx context.Context, runtime wazero.Runtime) error {
+	_, err := runtime.NewHostModuleBuilder(hostModule).
+		NewFunctionBuilder().WithFunc(requestID).Export("request_id").
+		NewFunctionBuilder().WithFunc(requestTargetModel).Export("request_target_model").
+		NewFunctionBuilder().WithFunc(requestHeader).Export("request_header").
+		NewFunctionBuilder().WithFunc(requestBody).Export("request_body").
+		NewFunctionBuilder().WithFunc(requestPriority).Export("request_priority").
+		NewFunctionBuilder().WithFunc(endpointCount).Export("endpoint_count").
+		NewFunctionBuilder().WithFunc(endpointName).Export("endpoint_name").
+		NewFunctionBuilder().WithFunc(endpointLabel).Export("endpoint_label").
+		NewFunctionBuilder().WithFunc(endpointMetric).Export("endpoint_metric").
+		NewFunctionBuilder().WithFunc(endpointAttribute).Export("endpoint_attribute").
+		NewFunctionBuilder().WithFunc(setScore).Export("set_score").
+		NewFunctionBuilder().WithFunc(keep).Export("keep").
+		NewFunctionBuilder().WithFunc(setReason).Export("set_reason").
+		NewFunctionBuilder().WithFunc(logMessage).Export("log").
+		Instantiate(ctx)
+	return err
+}
+
+// write writes value to the buffer of the module when it fits and returns its length.
+func write(m api.Module, buf, bufLen uint32, value []byte) int32 {
+	if uint32(len(value)) <= bufLen {
+		m.Memory().Write(buf, value)
+	}
+	return int32(len(value))
+}
+
+// read returns the string at (ptr, length) in the module memory.
+func read(m api.Module, ptr, length uint32) (string, bool) {
+	value, ok := m.Memory().Read(ptr, length)
+	return string(value), ok
+}
+
+func requestID(ctx context.Context, m api.Module, buf, bufLen uint32) int32 {
+	c := callFrom(ctx)
+	if c == nil || c.request == nil {
+		return notFound
+	}
+	return write(m, buf, bufLen, []byte(c.request.RequestId))
+}
+
+func requestTargetModel(ctx context.Context, m api.Module, buf, bufLen uint32) int32 {
+	c := callFrom(ctx)
+	if c == nil || c.request == nil {
+		return notFound
+	}
+	return write(m, buf, bufLen, []byte(c.request.TargetModel))
+}
+
+func requestHeader(ctx context.Context, m api.Module, key, keyLen, buf, bufLen uint32) int32 {
+	c := callFrom(ctx)
+	if c == nil || c.request == nil {
+		return notFound
+	}
+	name, ok := read(m, key, keyLen)
+	if !ok {
+		return notFound
+	}
+	value, ok := c.request.Headers[name]
+	if !ok {
+		return notFound
+	}
+	return write(m, buf, bufLen, []byte(value))
+}
+
+func requestBody(ctx context.Context, m api.Module, buf, bufLen uint32) int32 {
+	c := callFrom(ctx)
+	if c == nil || c.request == nil || c.request.Body == nil {
+		return notFound
+	}
+	body, err := json.Marshal(c.request.Body)
+	if err != nil {
+		return notFound
+	}
+	return write(m, buf, bufLen, body)
+}
+
+func requestPriority(ctx context.Context) int32 {
+	c := callFrom(ctx)
+	if c == nil || c.request == nil {
+		return 0
+	}
+	return int32(c.request.Objectives.Priority)
+}
+
+func endpointCount(ctx context.Context) int32 {
+	c := callFrom(ctx)
+	if c == nil {
+		return 0
+	}
+	return int32(len(c.endpoints))
+}
+
+// endpoint returns the endpoint at index i of the call, if any.
+func (c *call) endpoint(i int32) (framework.Endpoint, bool) {
+	if c == nil || i < 0 || int(i) >= len(c.endpoints) {
+		return nil, false
+	}
+	return c.endpoints[i], true
+}
+
+func endpointName(ctx context.Context, m api.Module, i int32, buf, bufLen uint32) int32 {
+	endpoint, ok := callFrom(ctx).endpoint(i)
+	if !ok || endpoint.GetMetadata() == nil {
+		return notFound
+	}
+	return write(m, buf, bufLen, []byte(endpoint.GetMetadata().NamespacedName.String()))
+}
+
+func endpointLabel(ctx context.Context, m api.Module, i int32, key, keyLen, buf, bufLen uint32) int32 {
+	endpoint, ok := callFrom(ctx).endpoint(i)
+	if !ok || endpoint.GetMetadata() == nil {
+		return notFound
+	}
+	name, ok := read(m, key, keyLen)
+	if !ok {
+		return notFound
+	}
+	value, ok := endpoint.GetMetadata().Labels[name]
+	if !ok {
+		return notFound
+	}
+	return write(m, buf, bufLen, []byte(value))
+}
+
+func endpointMetric(ctx context.Context, i int32, metric uint32) float64 {
+	endpoint, ok := callFrom(ctx).endpoint(i)
+	if !ok || endpoint.GetMetrics() == nil {
+		return 0
+	}
+	metrics := endpoint.GetMetrics()
+	switch metric {
+	case metricWaitingQueueSize:
+		return float64(metrics.WaitingQueueSize)
+	case metricRunningRequestsSize:
+		return float64(metrics.RunningRequestsSize)
+	case metricKVCacheUsagePercent:
+		return metrics.KVCacheUsagePercent
+	case metricMaxActiveModels:
+		return float64(metrics.MaxActiveModels)
+	case metricActiveModels:
+		return float64(len(metrics.ActiveModels))
+	case metricWaitingModels:
+		return float64(len(metrics.WaitingModels))
+	case metricKvCacheMaxTokenCapacity:
+		return float64(metrics.KvCacheMaxTokenCapacity)
+	case metricCacheBlockSize:
+		return float64(metrics.CacheBlockSize)
+	default:
+		return 0
+	}
+}
+
+func endpointAttribute(ctx context.Context, m api.Module, i int32, key, keyLen, buf, bufLen uint32) int32 {
+	endpoint, ok := callFrom(ctx).endpoint(i)
+	if !ok {
+		return notFound
+	}
+	name, ok := read(m, key, keyLen)
+	if !ok {
+		return notFound
+	}
+	attribute, ok := endpoint.Get(name)
+	if !ok {
+		return notFound
+	}
+	value, err := json.Marshal(attribute)
+	if err != nil {
+		return notFound
+	}
+	return write(m, buf, bufLen, value)
+}
+
+func setScore(ctx context.Context, i int32, score float64) {
+	c := callFrom(ctx)
+	if _, ok := c.endpoint(i); ok && c.scores != nil {
+		c.scores[i] = score
+	}
+}
+
+func keep(ctx context.Context, i int32) {
+	c := callFrom(ctx)
+	if _, ok := c.endpoint(i); ok && c.kept != nil {
+		c.kept[i] = true
+	}
+}
+
+func setReason(ctx context.Context, m api.Module, ptr, length uint32) {
+	c := callFrom(ctx)
+	if c == nil {
+		return
+	}
+	if reason, ok := read(m, ptr, length); ok {
+		c.reason = reason
+	}
+}
+
+func logMessage(ctx context.Context, m api.Module, ptr, length uint32) {
+	message, ok := read(m, ptr, length)
+	if !ok {
+		return
+	}
+	var pluginName string
+	if c := callFrom(ctx); c != nil {
+		pluginName = c.pluginName
+	}
+	log.FromContext(ctx).V(logutil.DEBUG).Info(message, "plugin", pluginName)
+}
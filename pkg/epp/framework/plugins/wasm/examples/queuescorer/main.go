@@ -0,0 +1,71 @@
+//go:build wasip1
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command queuescorer is an example module of the wasm plugin, which scores endpoints by their
+// waiting queue and sheds sheddable requests when all endpoints are queueing. Build it with:
+//
+//	GOOS=wasip1 GOARCH=wasm go build -buildmode=c-shared -o queuescorer.wasm .
+package main
+
+import (
+	"unsafe"
+)
+
+//go:wasmimport epp endpoint_count
+func endpointCount() int32
+
+//go:wasmimport epp endpoint_metric
+func endpointMetric(i int32, metric uint32) float64
+
+//go:wasmimport epp request_priority
+func requestPriority() int32
+
+//go:wasmimport epp set_score
+func setScore(i int32, score float64)
+
+//go:wasmimport epp set_reason
+func setReason(reason unsafe.Pointer, reasonLen uint32)
+
+// The metrics returned by endpoint_metric.
+const (
+	metricWaitingQueueSize uint32 = 0
+)
+
+func main() {}
+
+//go:wasmexport score
+func score() {
+	for i := range endpointCount() {
+		setScore(i, 1/(1+endpointMetric(i, metricWaitingQueueSize)))
+	}
+}
+
+//go:wasmexport admit
+func admit() int32 {
+	if requestPriority() >= 0 {
+		return 0
+	}
+	for i := range endpointCount() {
+		if endpointMetric(i, metricWaitingQueueSize) == 0 {
+			return 0
+		}
+	}
+	reason := "all endpoints are queueing, shedding sheddable requests"
+	setReason(unsafe.Pointer(unsafe.StringData(reason)), uint32(len(reason)))
+	return 1
+}
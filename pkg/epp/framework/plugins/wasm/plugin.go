@@ -0,0 +1,308 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package wasm provides a plugin running filter, scorer and admission logic compiled to
+// WebAssembly, in a sandbox within the EPP process. The module is reloaded when its file changes.
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	framework "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/external"
+)
+
+const (
+	// WasmPluginType is the type of the WebAssembly plugin.
+	WasmPluginType = "wasm"
+)
+
+// Config holds the WebAssembly plugin parameters.
+type Config struct {
+	// Path is the path of the WebAssembly module, such as a file of a mounted ConfigMap.
+	Path string `json:"path"`
+	// Timeout bounds each call to the module.
+	Timeout metav1.Duration `json:"timeout"`
+	external.Config
+	// MaxMemoryMiB bounds the memory of each instance of the module.
+	MaxMemoryMiB int `json:"maxMemoryMiB"`
+	// ReloadInterval is the interval at which the file of the module is checked for changes. 0
+	// disables reloading.
+	ReloadInterval metav1.Duration `json:"reloadInterval"`
+}
+
+// DefaultConfig holds the default WebAssembly plugin parameters.
+var DefaultConfig = Config{
+	Timeout:        metav1.Duration{Duration: 20 * time.Millisecond},
+	Config:         external.DefaultConfig,
+	MaxMemoryMiB:   128,
+	ReloadInterval: metav1.Duration{Duration: 10 * time.Second},
+}
+
+// compile-time type assertion
+var (
+	_ framework.Filter               = &Plugin{}
+	_ framework.Scorer               = &Plugin{}
+	_ requestcontrol.AdmissionPlugin = &Plugin{}
+)
+
+// WasmPluginFactory defines the factory function for the WebAssembly plugin. The module is closed
+// when the context of the handle is done.
+func WasmPluginFactory(name string, rawParameters json.RawMessage, handle plugin.Handle) (plugin.Plugin, error) {
+	parameters := DefaultConfig
+	if rawParameters != nil {
+		if err := plugin.StrictUnmarshal(rawParameters, &parameters); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the %s plugin. Error: %s", WasmPluginType, err)
+		}
+	}
+
+	p, err := New(handle.Context(), parameters)
+	if err != nil {
+		return nil, err
+	}
+	return p.WithName(name), nil
+}
+
+func (c Config) validate() error {
+	var errs []error
+	if c.Path == "" {
+		errs = append(errs, errors.New("path is required"))
+	}
+	if c.Timeout.Duration <= 0 {
+		errs = append(errs, errors.New("timeout must be positive"))
+	}
+	if err := c.Config.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.MaxMemoryMiB <= 0 || c.MaxMemoryMiB > 4096 {
+		errs = append(errs, errors.New("maxMemoryMiB must be in [1, 4096]"))
+	}
+	if c.ReloadInterval.Duration < 0 {
+		errs = append(errs, errors.New("reloadInterval must not be negative"))
+	}
+	return errors.Join(errs...)
+}
+
+// New initializes a new WebAssembly plugin running the module of the configuration and returns
+// its pointer. The module is reloaded when its file changes, until the context is done.
+func New(ctx context.Context, config Config) (*Plugin, error) {
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s configuration: %w", WasmPluginType, err)
+	}
+
+	p := &Plugin{
+		typedName: plugin.TypedName{Type: WasmPluginType, Name: WasmPluginType},
+		config:    config,
+	}
+	binary, err := os.ReadFile(config.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the module of the %s plugin - %w", WasmPluginType, err)
+	}
+	if err := p.load(ctx, binary); err != nil {
+		return nil, fmt.Errorf("failed to load the module of the %s plugin from '%s' - %w", WasmPluginType, config.Path, err)
+	}
+
+	go p.watch(ctx)
+	log.FromContext(ctx).V(logutil.DEFAULT).Info("WebAssembly plugin initialized", "config", config)
+	return p, nil
+}
+
+// Plugin runs the functions exported by a WebAssembly module at the filter, scorer and admission
+// extension points. The extension points whose functions the module does not export do nothing.
+type Plugin struct {
+	typedName plugin.TypedName
+	config    Config
+
+	mu      sync.RWMutex
+	program *program
+	binary  []byte
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *Plugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin.
+func (p *Plugin) WithName(name string) *Plugin {
+	p.typedName.Name = name
+	return p
+}
+
+// Category returns the category of the scores given by the module.
+func (p *Plugin) Category() framework.ScorerCategory {
+	return p.config.ScorerCategory
+}
+
+// load compiles the module and replaces the running one with it. The replaced module is closed once
+// its calls in progress have returned.
+func (p *Plugin) load(ctx context.Context, binary []byte) error {
+	compiled, err := compile(ctx, binary, memoryLimitPages(p.config.MaxMemoryMiB), runtime.GOMAXPROCS(0))
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	replaced := p.program
+	p.program, p.binary = compiled, binary
+	p.mu.Unlock()
+	if replaced != nil {
+		go func() { _ = replaced.close(context.Background()) }()
+	}
+	return nil
+}
+
+// watch reloads the module when its file changes, and closes it when the context is done.
+func (p *Plugin) watch(ctx context.Context) {
+	logger := log.FromContext(ctx).WithValues("path", p.config.Path)
+	var ticks <-chan time.Time
+	if p.config.ReloadInterval.Duration > 0 {
+		ticker := time.NewTicker(p.config.ReloadInterval.Duration)
+		defer ticker.Stop()
+		ticks = ticker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			p.mu.Lock()
+			closed := p.program
+			p.program = nil
+			p.mu.Unlock()
+			_ = closed.close(context.Background())
+			return
+		case <-ticks:
+			if err := p.reload(ctx); err != nil {
+				logger.Error(err, "Failed to reload the WebAssembly module, keeping the running one")
+			}
+		}
+	}
+}
+
+// reload loads the module again when the content of its file changed.
+func (p *Plugin) reload(ctx context.Context) error {
+	binary, err := os.ReadFile(p.config.Path)
+	if err != nil {
+		return err
+	}
+	p.mu.RLock()
+	unchanged := bytes.Equal(binary, p.binary)
+	p.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+	if err := p.load(ctx, binary); err != nil {
+		return err
+	}
+	log.FromContext(ctx).V(logutil.DEFAULT).Info("Reloaded the WebAssembly module", "path", p.config.Path)
+	return nil
+}
+
+// run calls the exported function of the running module, bounded by the timeout. It returns false
+// when the module does not export the function.
+func (p *Plugin) run(ctx context.Context, function string, c *call) (uint64, bool, error) {
+	p.mu.RLock()
+	current := p.program
+	if current == nil || !current.exports[function] {
+		p.mu.RUnlock()
+		return 0, false, nil
+	}
+	current.calls.Add(1)
+	p.mu.RUnlock()
+	defer current.calls.Done()
+
+	ctx, cancel := context.WithTimeout(ctx, p.config.Timeout.Duration)
+	defer cancel()
+	c.pluginName = p.typedName.Name
+	result, err := current.run(ctx, function, c)
+	return result, true, err
+}
+
+// Filter returns the candidate endpoints kept by the filter function of the module.
+func (p *Plugin) Filter(ctx context.Context, _ *framework.CycleState, request *framework.LLMRequest, endpoints []framework.Endpoint) []framework.Endpoint {
+	c := &call{request: request, endpoints: endpoints, kept: make([]bool, len(endpoints))}
+	_, exported, err := p.run(ctx, filterExport, c)
+	if !exported {
+		return endpoints
+	}
+	if err != nil {
+		p.config.LogFailure(ctx, err, p.typedName, filterExport, request)
+		return p.config.FailedFilter(endpoints)
+	}
+
+	filtered := make([]framework.Endpoint, 0, len(endpoints))
+	for i, endpoint := range endpoints {
+		if c.kept[i] {
+			filtered = append(filtered, endpoint)
+		}
+	}
+	return filtered
+}
+
+// Score returns the scores given to the candidate endpoints by the score function of the module.
+func (p *Plugin) Score(ctx context.Context, _ *framework.CycleState, request *framework.LLMRequest, endpoints []framework.Endpoint) map[framework.Endpoint]float64 {
+	scores := make(map[framework.Endpoint]float64, len(endpoints))
+	c := &call{request: request, endpoints: endpoints, scores: make([]float64, len(endpoints))}
+	_, exported, err := p.run(ctx, scoreExport, c)
+	if !exported {
+		return scores
+	}
+	if err != nil {
+		p.config.LogFailure(ctx, err, p.typedName, scoreExport, request)
+		return scores
+	}
+
+	for i, endpoint := range endpoints {
+		scores[endpoint] = external.ClampScore(c.scores[i])
+	}
+	return scores
+}
+
+// AdmitRequest returns the reason given by the admit function of the module for denying the
+// request, if it does.
+func (p *Plugin) AdmitRequest(ctx context.Context, request *framework.LLMRequest, endpoints []framework.Endpoint) error {
+	c := &call{request: request, endpoints: endpoints}
+	result, exported, err := p.run(ctx, admitExport, c)
+	if !exported {
+		return nil
+	}
+	if err != nil {
+		p.config.LogFailure(ctx, err, p.typedName, admitExport, request)
+		if p.config.FailOpen {
+			return nil
+		}
+		return fmt.Errorf("WebAssembly plugin '%s' failed - %w", p.typedName.Name, err)
+	}
+	if int32(result) != 0 {
+		if c.reason == "" {
+			return fmt.Errorf("denied by WebAssembly plugin '%s'", p.typedName.Name)
+		}
+		return errors.New(c.reason)
+	}
+	return nil
+}
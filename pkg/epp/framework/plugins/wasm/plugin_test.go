@@ -0,0 +1,386 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+)
+
+// WebAssembly value types and instructions used by the test modules.
+const (
+	i32 = 0x7f
+	f64 = 0x7c
+
+	opBlock    = 0x02
+	opLoop     = 0x03
+	opIf       = 0x04
+	opElse     = 0x05
+	opEnd      = 0x0b
+	opBr       = 0x0c
+	opBrIf     = 0x0d
+	opCall     = 0x10
+	opLocalGet = 0x20
+	opLocalSet = 0x21
+	opI32Const = 0x41
+	opF64Const = 0x44
+	opI32LtS   = 0x48
+	opI32GeS   = 0x4e
+	opI32Add   = 0x6a
+	opF64Add   = 0xa0
+	opF64Div   = 0xa3
+	blockVoid  = 0x40
+)
+
+// funcType is the signature of a function of a test module.
+type funcType struct {
+	params, results []byte
+}
+
+// hostImport is a function imported from the epp host module.
+type hostImport struct {
+	name string
+	typ  funcType
+}
+
+// exportedFunc is a function defined and exported by a test module, with its i32 locals.
+type exportedFunc struct {
+	name   string
+	typ    funcType
+	locals int
+	body   []byte
+}
+
+func uleb(v uint32) []byte {
+	var out []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			out = append(out, b|0x80)
+			continue
+		}
+		return append(out, b)
+	}
+}
+
+func sleb(v int32) []byte {
+	var out []byte
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+func vector(items ...[]byte) []byte {
+	out := uleb(uint32(len(items)))
+	for _, item := range items {
+		out = append(out, item...)
+	}
+	return out
+}
+
+func name(s string) []byte {
+	return append(uleb(uint32(len(s))), s...)
+}
+
+func section(id byte, content []byte) []byte {
+	return append(append([]byte{id}, uleb(uint32(len(content)))...), content...)
+}
+
+func i32Const(v int32) []byte {
+	return append([]byte{opI32Const}, sleb(v)...)
+}
+
+func f64Const(v float64) []byte {
+	out := []byte{opF64Const}
+	return binary.LittleEndian.AppendUint64(out, math.Float64bits(v))
+}
+
+func code(parts ...any) []byte {
+	var out []byte
+	for _, part := range parts {
+		switch part := part.(type) {
+		case byte:
+			out = append(out, part)
+		case int:
+			out = append(out, byte(part))
+		case []byte:
+			out = append(out, part...)
+		}
+	}
+	return out
+}
+
+// assemble returns the binary of a module importing the given host functions, exporting its
+// memory and the given functions, and holding data at the start of its memory.
+func assemble(imports []hostImport, funcs []exportedFunc, data string) []byte {
+	var types [][]byte
+	typeIndex := func(t funcType) []byte {
+		encoded := append(append([]byte{0x60}, vector(bytesOf(t.params)...)...), vector(bytesOf(t.results)...)...)
+		for i, existing := range types {
+			if bytes.Equal(existing, encoded) {
+				return uleb(uint32(i))
+			}
+		}
+		types = append(types, encoded)
+		return uleb(uint32(len(types) - 1))
+	}
+
+	var importEntries, funcEntries, exportEntries, bodies [][]byte
+	for _, imp := range imports {
+		importEntries = append(importEntries, code(name(hostModule), name(imp.name), 0x00, typeIndex(imp.typ)))
+	}
+	for i, fn := range funcs {
+		funcEntries = append(funcEntries, typeIndex(fn.typ))
+		exportEntries = append(exportEntries, code(name(fn.name), 0x00, uleb(uint32(len(imports)+i))))
+		var locals []byte
+		if fn.locals > 0 {
+			locals = vector(code(uleb(uint32(fn.locals)), i32))
+		} else {
+			locals = vector()
+		}
+		body := append(locals, fn.body...)
+		body = append(body, opEnd)
+		bodies = append(bodies, append(uleb(uint32(len(body))), body...))
+	}
+	exportEntries = append(exportEntries, code(name("memory"), 0x02, 0x00))
+
+	module := []byte{0x00, 'a', 's', 'm', 0x01, 0x00, 0x00, 0x00}
+	module = append(module, section(1, vector(types...))...)
+	module = append(module, section(2, vector(importEntries...))...)
+	module = append(module, section(3, vector(funcEntries...))...)
+	module = append(module, section(5, vector(code(0x00, 0x01)))...)
+	module = append(module, section(7, vector(exportEntries...))...)
+	module = append(module, section(10, vector(bodies...))...)
+	module = append(module, section(11, vector(code(0x00, i32Const(0), opEnd, name(data))))...)
+	return module
+}
+
+func bytesOf(types []byte) [][]byte {
+	out := make([][]byte, 0, len(types))
+	for _, t := range types {
+		out = append(out, []byte{t})
+	}
+	return out
+}
+
+var testImports = []hostImport{
+	{name: "endpoint_count", typ: funcType{results: []byte{i32}}},                                          // 0
+	{name: "endpoint_metric", typ: funcType{params: []byte{i32, i32}, results: []byte{f64}}},               // 1
+	{name: "set_score", typ: funcType{params: []byte{i32, f64}}},                                           // 2
+	{name: "endpoint_label", typ: funcType{params: []byte{i32, i32, i32, i32, i32}, results: []byte{i32}}}, // 3
+	{name: "keep", typ: funcType{params: []byte{i32}}},                                                     // 4
+	{name: "request_priority", typ: funcType{results: []byte{i32}}},                                        // 5
+	{name: "set_reason", typ: funcType{params: []byte{i32, i32}}},                                          // 6
+}
+
+// forEachEndpoint loops over the endpoints with local 0 as index and local 1 as count.
+func forEachEndpoint(body []byte) []byte {
+	return code(
+		opCall, 0, opLocalSet, 1,
+		opBlock, blockVoid, opLoop, blockVoid,
+		opLocalGet, 0, opLocalGet, 1, opI32GeS, opBrIf, 1,
+		body,
+		opLocalGet, 0, i32Const(1), opI32Add, opLocalSet, 0,
+		opBr, 0,
+		opEnd, opEnd,
+	)
+}
+
+// scoreByQueue scores each endpoint 1/(1+queue), or gives a constant score when constant is set.
+func scoreFunc(constant float64) exportedFunc {
+	score := code(f64Const(1), f64Const(1), opLocalGet, 0, i32Const(int32(metricWaitingQueueSize)), opCall, 1, opF64Add, opF64Div)
+	if constant != 0 {
+		score = f64Const(constant)
+	}
+	return exportedFunc{name: scoreExport, locals: 2, body: forEachEndpoint(code(opLocalGet, 0, score, opCall, 2))}
+}
+
+// filterFunc keeps the endpoints having the label "keep", stored at offset 0 of the memory.
+var filterFunc = exportedFunc{name: filterExport, locals: 2, body: forEachEndpoint(code(
+	opLocalGet, 0, i32Const(0), i32Const(4), i32Const(64), i32Const(64), opCall, 3,
+	i32Const(0), opI32GeS, opIf, blockVoid, opLocalGet, 0, opCall, 4, opEnd,
+))}
+
+// admitFunc denies the requests of negative priority with the reason stored at offset 4.
+var admitFunc = exportedFunc{name: admitExport, typ: funcType{results: []byte{i32}}, body: code(
+	opCall, 5, i32Const(0), opI32LtS,
+	opIf, i32, i32Const(4), i32Const(9), opCall, 6, i32Const(1), opElse, i32Const(0), opEnd,
+)}
+
+// spinFunc never returns.
+var spinFunc = exportedFunc{name: scoreExport, body: code(opLoop, blockVoid, opBr, 0, opEnd)}
+
+const testData = "keepsheddable"
+
+func writeModule(t *testing.T, path string, funcs ...exportedFunc) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, assemble(testImports, funcs, testData), 0o600))
+}
+
+func newTestPlugin(t *testing.T, config Config, funcs ...exportedFunc) *Plugin {
+	t.Helper()
+	config.Path = filepath.Join(t.TempDir(), "plugin.wasm")
+	writeModule(t, config.Path, funcs...)
+	p, err := New(t.Context(), config)
+	require.NoError(t, err)
+	return p
+}
+
+func newEndpoint(name string, keep bool, queue int) fwksched.Endpoint {
+	labels := map[string]string{}
+	if keep {
+		labels["keep"] = "true"
+	}
+	return fwksched.NewEndpoint(&fwkdl.EndpointMetadata{
+		NamespacedName: k8stypes.NamespacedName{Namespace: "default", Name: name},
+		PodName:        name,
+		Labels:         labels,
+	}, &fwkdl.Metrics{WaitingQueueSize: queue}, nil)
+}
+
+func names(endpoints []fwksched.Endpoint) []string {
+	result := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		result = append(result, endpoint.GetMetadata().PodName)
+	}
+	return result
+}
+
+func TestWasmPlugin(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPlugin(t, DefaultConfig, scoreFunc(0), filterFunc, admitFunc)
+	endpoints := []fwksched.Endpoint{newEndpoint("a", true, 0), newEndpoint("b", false, 1), newEndpoint("c", true, 3)}
+	request := &fwksched.LLMRequest{RequestId: "1"}
+
+	assert.Equal(t, []string{"a", "c"}, names(p.Filter(ctx, nil, request, endpoints)))
+	assert.Equal(t, map[fwksched.Endpoint]float64{endpoints[0]: 1, endpoints[1]: 0.5, endpoints[2]: 0.25},
+		p.Score(ctx, nil, request, endpoints))
+	assert.NoError(t, p.AdmitRequest(ctx, request, endpoints))
+	sheddable := &fwksched.LLMRequest{RequestId: "2", Objectives: fwksched.RequestObjectives{Priority: -1}}
+	assert.EqualError(t, p.AdmitRequest(ctx, sheddable, endpoints), "sheddable")
+}
+
+func TestScoresClamped(t *testing.T) {
+	ctx := context.Background()
+	endpoints := []fwksched.Endpoint{newEndpoint("a", true, 0)}
+	request := &fwksched.LLMRequest{RequestId: "1"}
+
+	p := newTestPlugin(t, DefaultConfig, scoreFunc(3))
+	assert.Equal(t, map[fwksched.Endpoint]float64{endpoints[0]: 1}, p.Score(ctx, nil, request, endpoints))
+	p = newTestPlugin(t, DefaultConfig, scoreFunc(-2))
+	assert.Equal(t, map[fwksched.Endpoint]float64{endpoints[0]: 0}, p.Score(ctx, nil, request, endpoints))
+}
+
+func TestMissingExports(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPlugin(t, DefaultConfig, scoreFunc(0))
+	endpoints := []fwksched.Endpoint{newEndpoint("a", false, 0)}
+	request := &fwksched.LLMRequest{RequestId: "1", Objectives: fwksched.RequestObjectives{Priority: -1}}
+
+	assert.Equal(t, endpoints, p.Filter(ctx, nil, request, endpoints))
+	assert.NoError(t, p.AdmitRequest(ctx, request, endpoints))
+}
+
+func TestTimeout(t *testing.T) {
+	ctx := context.Background()
+	config := DefaultConfig
+	config.Timeout = metav1.Duration{Duration: 20 * time.Millisecond}
+	p := newTestPlugin(t, config, spinFunc)
+	endpoints := []fwksched.Endpoint{newEndpoint("a", false, 0)}
+
+	start := time.Now()
+	assert.Empty(t, p.Score(ctx, nil, &fwksched.LLMRequest{}, endpoints))
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestMaxMemory(t *testing.T) {
+	assert.Equal(t, uint32(16), memoryLimitPages(1))
+	assert.Equal(t, uint32(65536), memoryLimitPages(4096), "the upper bound is the whole 32-bit address space")
+
+	ctx := context.Background()
+	config := DefaultConfig
+	config.MaxMemoryMiB = 4096
+	p := newTestPlugin(t, config, scoreFunc(0))
+	endpoints := []fwksched.Endpoint{newEndpoint("a", false, 3)}
+	assert.Equal(t, 0.25, p.Score(ctx, nil, &fwksched.LLMRequest{}, endpoints)[endpoints[0]])
+
+	config.MaxMemoryMiB = 4097
+	assert.ErrorContains(t, config.validate(), "maxMemoryMiB must be in [1, 4096]")
+}
+
+func TestReload(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPlugin(t, DefaultConfig, scoreFunc(0))
+	endpoints := []fwksched.Endpoint{newEndpoint("a", false, 3)}
+	assert.Equal(t, 0.25, p.Score(ctx, nil, &fwksched.LLMRequest{}, endpoints)[endpoints[0]])
+
+	writeModule(t, p.config.Path, scoreFunc(0.75))
+	require.NoError(t, p.reload(t.Context()))
+	assert.Equal(t, 0.75, p.Score(ctx, nil, &fwksched.LLMRequest{}, endpoints)[endpoints[0]])
+
+	// An invalid module is rejected and the running one is kept.
+	require.NoError(t, os.WriteFile(p.config.Path, []byte("not a module"), 0o600))
+	assert.Error(t, p.reload(t.Context()))
+	assert.Equal(t, 0.75, p.Score(ctx, nil, &fwksched.LLMRequest{}, endpoints)[endpoints[0]])
+}
+
+func TestWasmPluginFactory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plugin.wasm")
+	writeModule(t, path, scoreFunc(0))
+	handle := plugin.NewEppHandle(t.Context(), nil)
+
+	p, err := WasmPluginFactory("my-wasm", []byte(`{"path": "`+path+`", "scorerCategory": "Distribution", "reloadInterval": "0s"}`), handle)
+	require.NoError(t, err)
+	assert.Equal(t, "my-wasm", p.TypedName().Name)
+	assert.Equal(t, fwksched.Distribution, p.(*Plugin).Category())
+
+	invalid := filepath.Join(t.TempDir(), "invalid.wasm")
+	require.NoError(t, os.WriteFile(invalid, []byte("not a module"), 0o600))
+	for _, params := range []string{
+		`{}`,
+		`{"path": "` + filepath.Join(t.TempDir(), "missing.wasm") + `"}`,
+		`{"path": "` + invalid + `"}`,
+		`{"path": "` + path + `", "timeout": "0s"}`,
+		`{"path": "` + path + `", "maxMemoryMiB": 0}`,
+		`{"path": "` + path + `", "scorerCategory": "Random"}`,
+		`{"path": "` + path + `", "reload": "1s"}`,
+	} {
+		_, err := WasmPluginFactory("my-wasm", []byte(params), handle)
+		assert.Error(t, err, params)
+	}
+}
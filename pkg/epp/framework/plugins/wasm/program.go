@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wasm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+// wasmPageSize is the size of a page of WebAssembly memory.
+const wasmPageSize = 64 * 1024
+
+// memoryLimitPages returns the number of pages of WebAssembly memory in the given MiB.
+func memoryLimitPages(mib int) uint32 {
+	return uint32(mib) * (1 << 20 / wasmPageSize)
+}
+
+// program is a compiled module with a pool of instances, since an instance serves one call at a
+// time. Each program has its own runtime, closed once the program is replaced and its last call
+// has returned.
+type program struct {
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	exports  map[string]bool
+	maxIdle  int
+
+	mu   sync.Mutex
+	idle []api.Module
+	// calls counts the calls in progress, so that a replaced program is closed after them.
+	calls sync.WaitGroup
+}
+
+// compile compiles the module and checks the functions it exports.
+func compile(ctx context.Context, binary []byte, maxMemoryPages uint32, maxIdle int) (*program, error) {
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(maxMemoryPages).
+		WithCloseOnContextDone(true))
+	p, err := newProgram(ctx, runtime, binary, maxIdle)
+	if err != nil {
+		_ = runtime.Close(ctx)
+		return nil, err
+	}
+	return p, nil
+}
+
+func newProgram(ctx context.Context, runtime wazero.Runtime, binary []byte, maxIdle int) (*program, error) {
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		return nil, fmt.Errorf("failed to instantiate WASI - %w", err)
+	}
+	if err := instantiateHostModule(ctx, runtime); err != nil {
+		return nil, fmt.Errorf("failed to instantiate the %s host module - %w", hostModule, err)
+	}
+	compiled, err := runtime.CompileModule(ctx, binary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the module - %w", err)
+	}
+
+	exports := map[string]bool{}
+	for name, definition := range compiled.ExportedFunctions() {
+		exports[name] = true
+		switch name {
+		case scoreExport, filterExport:
+			if len(definition.ParamTypes()) != 0 || len(definition.ResultTypes()) != 0 {
+				return nil, fmt.Errorf("the %s function must take no arguments and return nothing", name)
+			}
+		case admitExport:
+			if len(definition.ParamTypes()) != 0 || len(definition.ResultTypes()) != 1 || definition.ResultTypes()[0] != api.ValueTypeI32 {
+				return nil, fmt.Errorf("the %s function must take no arguments and return an i32", name)
+			}
+		}
+	}
+	if !exports[scoreExport] && !exports[filterExport] && !exports[admitExport] {
+		return nil, fmt.Errorf("the module exports none of the %s, %s and %s functions", scoreExport, filterExport, admitExport)
+	}
+
+	p := &program{runtime: runtime, compiled: compiled, exports: exports, maxIdle: maxIdle}
+	// Instantiating once checks the imports and the initialization of the module.
+	instance, err := p.instantiate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.release(instance)
+	return p, nil
+}
+
+func (p *program) instantiate(ctx context.Context) (api.Module, error) {
+	instance, err := p.runtime.InstantiateModule(ctx, p.compiled, wazero.NewModuleConfig().
+		WithName("").
+		WithStartFunctions(initializeExport))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate the module - %w", err)
+	}
+	return instance, nil
+}
+
+// acquire returns an idle instance, or a new one when all are in use.
+func (p *program) acquire(ctx context.Context) (api.Module, error) {
+	p.mu.Lock()
+	if n := len(p.idle); n > 0 {
+		instance := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.mu.Unlock()
+		return instance, nil
+	}
+	p.mu.Unlock()
+	return p.instantiate(ctx)
+}
+
+// release returns an instance to the pool. Closed instances, such as the instances of calls which
+// timed out, and the instances beyond the maximum number of idle instances are dropped.
+func (p *program) release(instance api.Module) {
+	if instance.IsClosed() {
+		return
+	}
+	p.mu.Lock()
+	if len(p.idle) < p.maxIdle {
+		p.idle = append(p.idle, instance)
+		instance = nil
+	}
+	p.mu.Unlock()
+	if instance != nil {
+		_ = instance.Close(context.Background())
+	}
+}
+
+// run calls the exported function of the module with the given call state, and returns its
+// result, if any. The call must be counted in calls.
+func (p *program) run(ctx context.Context, function string, c *call) (uint64, error) {
+	instance, err := p.acquire(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer p.release(instance)
+
+	results, err := instance.ExportedFunction(function).Call(context.WithValue(ctx, callKey{}, c))
+	if err != nil {
+		return 0, fmt.Errorf("the %s function failed - %w", function, err)
+	}
+	if len(results) == 0 {
+		return 0, nil
+	}
+	return results[0], nil
+}
+
+// close closes the runtime of the program once the calls in progress have returned.
+func (p *program) close(ctx context.Context) error {
+	p.calls.Wait()
+	return p.runtime.Close(ctx)
+}
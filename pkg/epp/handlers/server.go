@@ -18,7 +18,9 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"io"
+	"strconv"
 	"strings"
 	"time"
 
@@ -32,6 +34,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	envoy "sigs.k8s.io/gateway-api-inference-extension/pkg/common/envoy"
@@ -83,11 +86,13 @@ type StreamingServer struct {
 type RequestContext struct {
 	TargetPod                 *fwkdl.EndpointMetadata
 	TargetEndpoint            string
+	Pool                      types.NamespacedName
 	IncomingModelName         string
 	TargetModelName           string
 	FairnessID                string
 	ObjectiveKey              string
 	RequestReceivedTimestamp  time.Time
+	FirstChunkTimestamp       time.Time
 	ResponseCompleteTimestamp time.Time
 	RequestSize               int
 	Usage                     fwkrq.Usage
@@ -96,6 +101,11 @@ type RequestContext struct {
 	ResponseStatusCode        string
 	RequestRunning            bool
 	Request                   *Request
+	// BodyMutated is set when the parsed body of the request was mutated and must be serialized again into the raw body
+	// before the request is forwarded.
+	BodyMutated bool
+	// Termination is how the request terminated, passed to the ResponseComplete plugins.
+	Termination fwkrq.Termination
 
 	SchedulingRequest *schedulingtypes.LLMRequest
 
@@ -119,7 +129,9 @@ type Request struct {
 	Metadata map[string]any
 }
 type Response struct {
-	Headers         map[string]string
+	Headers map[string]string
+	// StatusCode is the HTTP status code returned by the model server, or 0 if no response headers were received.
+	StatusCode      int
 	DynamicMetadata *structpb.Struct
 }
 type StreamRequestState int
@@ -172,19 +184,26 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 	// error metrics. This doesn't cover the error "Cannot receive stream request" because
 	// such errors might happen even though response is processed.
 	var err error
+	// streamErr is the error which ended the stream with Envoy, if any. It tells a client disconnect apart from a
+	// failure of the EPP when the request terminates before its response completed.
+	var streamErr error
 	defer func(error, *RequestContext) {
 		if reqCtx.ResponseStatusCode != "" {
 			metrics.RecordRequestErrCounter(reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.ResponseStatusCode)
+			metrics.RecordInferencePoolRequestErr(reqCtx.Pool.Name, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.ResponseStatusCode)
 		} else if err != nil {
 			metrics.RecordRequestErrCounter(reqCtx.IncomingModelName, reqCtx.TargetModelName, errcommon.CanonicalCode(err))
+			metrics.RecordInferencePoolRequestErr(reqCtx.Pool.Name, reqCtx.IncomingModelName, reqCtx.TargetModelName, errcommon.CanonicalCode(err))
 		}
 		if reqCtx.RequestRunning {
 			metrics.DecRunningRequests(reqCtx.IncomingModelName)
+			metrics.DecInferencePoolRunningRequests(reqCtx.Pool.Name, reqCtx.IncomingModelName)
 		}
 
 		// If we scheduled a pod (TargetPod != nil) but never marked the response  as complete (e.g. error, disconnect,
 		// panic), force the completion hooks to run.
 		if reqCtx.TargetPod != nil && !reqCtx.ResponseComplete {
+			reqCtx.Termination = incompleteTermination(reqCtx, err, streamErr)
 			// Use a fresh context as the request context might be canceled (Client Disconnect).
 			// We only need logging from the original context.
 			cleanupCtx := log.IntoContext(context.Background(), logger)
@@ -197,11 +216,15 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 	for {
 		select {
 		case <-ctx.Done():
+			streamErr = ctx.Err()
 			return ctx.Err()
 		default:
 		}
 
 		req, recvErr := srv.Recv()
+		if recvErr != nil {
+			streamErr = recvErr
+		}
 		if recvErr == io.EOF || status.Code(recvErr) == codes.Canceled {
 			return nil
 		}
@@ -259,6 +282,11 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 			for _, header := range v.ResponseHeaders.Headers.GetHeaders() {
 				value := string(header.RawValue)
 				loggerTrace.Info("header", "key", header.Key, "value", value)
+				if header.Key == "status" || header.Key == ":status" {
+					if code, convErr := strconv.Atoi(value); convErr == nil {
+						reqCtx.Response.StatusCode = code
+					}
+				}
 				if header.Key == "status" && value != "200" {
 					reqCtx.ResponseStatusCode = errcommon.ModelServerError
 				} else if header.Key == "content-type" && strings.Contains(value, "text/event-stream") {
@@ -320,12 +348,14 @@ func (s *StreamingServer) Process(srv extProcPb.ExternalProcessor_ProcessServer)
 			}
 			if err := srv.Send(resp); err != nil {
 				logger.V(1).Error(err, "Send failed")
+				streamErr = err
 				return status.Errorf(codes.Unknown, "failed to send response back to Envoy: %v", err)
 			}
 			return nil
 		}
 		loggerTrace.Info("checking", "request state", reqCtx.RequestState)
 		if err := reqCtx.updateStateAndSendIfNeeded(srv, logger); err != nil {
+			streamErr = err
 			return err
 		}
 	}
@@ -343,12 +373,17 @@ func (s *StreamingServer) finishResponse(ctx context.Context, reqCtx *RequestCon
 	reqCtx.ResponseComplete = true
 	reqCtx.ResponseCompleteTimestamp = time.Now()
 	reqCtx.ResponseSize = len(body)
+	reqCtx.Termination = fwkrq.Termination{Reason: fwkrq.TerminationCompleted, StatusCode: reqCtx.Response.StatusCode}
+	if !isSuccessStatus(reqCtx.Response.StatusCode) {
+		reqCtx.Termination.Reason = fwkrq.TerminationUpstreamError
+	}
 
 	if reqCtx.modelServerStreaming {
 		if _, err := s.director.HandleResponseBodyComplete(ctx, reqCtx); err != nil {
 			log.FromContext(ctx).Error(err, "error in HandleResponseBodyComplete")
 		}
 		metrics.RecordRequestLatencies(ctx, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestReceivedTimestamp, reqCtx.ResponseCompleteTimestamp)
+		metrics.RecordInferencePoolRequestLatency(reqCtx.Pool.Name, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestReceivedTimestamp, reqCtx.ResponseCompleteTimestamp)
 		metrics.RecordResponseSizes(reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.ResponseSize)
 		metrics.RecordNormalizedTimePerOutputToken(ctx, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestReceivedTimestamp, reqCtx.ResponseCompleteTimestamp, reqCtx.Usage.CompletionTokens)
 	} else {
@@ -357,6 +392,7 @@ func (s *StreamingServer) finishResponse(ctx context.Context, reqCtx *RequestCon
 			return err
 		}
 		metrics.RecordRequestLatencies(ctx, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestReceivedTimestamp, reqCtx.ResponseCompleteTimestamp)
+		metrics.RecordInferencePoolRequestLatency(reqCtx.Pool.Name, reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.RequestReceivedTimestamp, reqCtx.ResponseCompleteTimestamp)
 		metrics.RecordResponseSizes(reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.ResponseSize)
 		metrics.RecordInputTokens(reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.Usage.PromptTokens)
 		metrics.RecordOutputTokens(reqCtx.IncomingModelName, reqCtx.TargetModelName, reqCtx.Usage.CompletionTokens)
@@ -367,6 +403,33 @@ func (s *StreamingServer) finishResponse(ctx context.Context, reqCtx *RequestCon
 	return nil
 }
 
+// incompleteTermination classifies a request which terminated before its response completed. A failure of the EPP
+// takes precedence over an error status of the model server, which takes precedence over the end of the stream.
+func incompleteTermination(reqCtx *RequestContext, err, streamErr error) fwkrq.Termination {
+	termination := fwkrq.Termination{StatusCode: reqCtx.Response.StatusCode}
+	switch {
+	case err != nil:
+		termination.Reason = fwkrq.TerminationEPPError
+		termination.Err = err
+	case !isSuccessStatus(termination.StatusCode):
+		termination.Reason = fwkrq.TerminationUpstreamError
+	case errors.Is(streamErr, io.EOF) || errors.Is(streamErr, context.Canceled) || status.Code(streamErr) == codes.Canceled:
+		termination.Reason = fwkrq.TerminationClientCancelled
+		termination.Err = streamErr
+	default:
+		// The stream failed, or the processing panicked when there is no error.
+		termination.Reason = fwkrq.TerminationEPPError
+		termination.Err = streamErr
+	}
+	return termination
+}
+
+// isSuccessStatus reports whether the status code of the model server is 2xx. A status code of 0 means that no
+// response headers were received, which is not an error of the model server.
+func isSuccessStatus(statusCode int) bool {
+	return statusCode == 0 || (statusCode >= 200 && statusCode < 300)
+}
+
 // updateStateAndSendIfNeeded checks state and can send mutiple responses in a single pass, but only if ordered properly.
 // Order of requests matter in FULL_DUPLEX_STREAMING. For both request and response, the order of response sent back MUST be: Header->Body->Trailer, with trailer being optional.
 func (r *RequestContext) updateStateAndSendIfNeeded(srv extProcPb.ExternalProcessor_ProcessServer, logger logr.Logger) error {
@@ -391,6 +454,7 @@ func (r *RequestContext) updateStateAndSendIfNeeded(srv extProcPb.ExternalProces
 		logger.V(1).Info("EPP sent request body response(s) to proxy", "modelName", r.IncomingModelName, "targetModelName", r.TargetModelName)
 		r.RequestState = BodyRequestResponsesComplete
 		metrics.IncRunningRequests(r.IncomingModelName)
+		metrics.IncInferencePoolRunningRequests(r.Pool.Name, r.IncomingModelName)
 		r.RequestRunning = true
 		// Dump the response so a new stream message can begin
 		r.reqBodyResp = nil
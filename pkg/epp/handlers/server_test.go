@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	configPb "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkrq "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/openai"
+)
+
+// fakeProcessServer replays the given requests, then ends the stream with endErr.
+type fakeProcessServer struct {
+	extProcPb.ExternalProcessor_ProcessServer
+	ctx      context.Context
+	requests []*extProcPb.ProcessingRequest
+	endErr   error
+}
+
+func (s *fakeProcessServer) Context() context.Context { return s.ctx }
+
+func (s *fakeProcessServer) Recv() (*extProcPb.ProcessingRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, s.endErr
+	}
+	request := s.requests[0]
+	s.requests = s.requests[1:]
+	return request, nil
+}
+
+func (s *fakeProcessServer) Send(*extProcPb.ProcessingResponse) error { return nil }
+
+// terminationDirector schedules every request and records the termination passed on completion.
+type terminationDirector struct {
+	mockDirector
+	completions  int
+	terminations []fwkrq.Termination
+}
+
+func (d *terminationDirector) HandleRequest(_ context.Context, reqCtx *RequestContext) (*RequestContext, error) {
+	reqCtx.TargetPod = &fwkdl.EndpointMetadata{Address: "1.2.3.4", Port: "8000"}
+	reqCtx.TargetEndpoint = "1.2.3.4:8000"
+	return reqCtx, nil
+}
+
+func (d *terminationDirector) HandleResponseBodyComplete(_ context.Context, reqCtx *RequestContext) (*RequestContext, error) {
+	d.completions++
+	d.terminations = append(d.terminations, reqCtx.Termination)
+	return reqCtx, nil
+}
+
+func requestMessages() []*extProcPb.ProcessingRequest {
+	return []*extProcPb.ProcessingRequest{
+		{Request: &extProcPb.ProcessingRequest_RequestHeaders{RequestHeaders: &extProcPb.HttpHeaders{
+			Headers: &configPb.HeaderMap{Headers: []*configPb.HeaderValue{{Key: "x-request-id", RawValue: []byte("1")}}},
+		}}},
+		{Request: &extProcPb.ProcessingRequest_RequestBody{RequestBody: &extProcPb.HttpBody{
+			Body: []byte(`{"model": "m", "prompt": "hello"}`), EndOfStream: true,
+		}}},
+	}
+}
+
+func responseHeadersMessage(statusCode string) *extProcPb.ProcessingRequest {
+	return &extProcPb.ProcessingRequest{Request: &extProcPb.ProcessingRequest_ResponseHeaders{ResponseHeaders: &extProcPb.HttpHeaders{
+		Headers: &configPb.HeaderMap{Headers: []*configPb.HeaderValue{{Key: ":status", RawValue: []byte(statusCode)}}},
+	}}}
+}
+
+func responseBodyMessage() *extProcPb.ProcessingRequest {
+	return &extProcPb.ProcessingRequest{Request: &extProcPb.ProcessingRequest_ResponseBody{ResponseBody: &extProcPb.HttpBody{
+		Body: []byte(body), EndOfStream: true,
+	}}}
+}
+
+func TestProcessTermination(t *testing.T) {
+	tests := []struct {
+		name     string
+		response []*extProcPb.ProcessingRequest
+		endErr   error
+		want     fwkrq.Termination
+	}{
+		{
+			name:     "completed",
+			response: []*extProcPb.ProcessingRequest{responseHeadersMessage("200"), responseBodyMessage()},
+			endErr:   io.EOF,
+			want:     fwkrq.Termination{Reason: fwkrq.TerminationCompleted, StatusCode: 200},
+		},
+		{
+			name:     "upstream error with a complete response",
+			response: []*extProcPb.ProcessingRequest{responseHeadersMessage("503"), responseBodyMessage()},
+			endErr:   io.EOF,
+			want:     fwkrq.Termination{Reason: fwkrq.TerminationUpstreamError, StatusCode: 503},
+		},
+		{
+			name:     "upstream error with an incomplete response",
+			response: []*extProcPb.ProcessingRequest{responseHeadersMessage("500")},
+			endErr:   io.EOF,
+			want:     fwkrq.Termination{Reason: fwkrq.TerminationUpstreamError, StatusCode: 500},
+		},
+		{
+			name:     "client cancelled before the response headers",
+			response: nil,
+			endErr:   status.Error(codes.Canceled, "context canceled"),
+			want: fwkrq.Termination{Reason: fwkrq.TerminationClientCancelled,
+				Err: status.Error(codes.Canceled, "context canceled")},
+		},
+		{
+			name:     "client cancelled during the response",
+			response: []*extProcPb.ProcessingRequest{responseHeadersMessage("200")},
+			endErr:   io.EOF,
+			want:     fwkrq.Termination{Reason: fwkrq.TerminationClientCancelled, StatusCode: 200, Err: io.EOF},
+		},
+		{
+			name:     "stream failure",
+			response: []*extProcPb.ProcessingRequest{responseHeadersMessage("200")},
+			endErr:   status.Error(codes.Unavailable, "connection reset"),
+			want: fwkrq.Termination{Reason: fwkrq.TerminationEPPError, StatusCode: 200,
+				Err: status.Error(codes.Unavailable, "connection reset")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			director := &terminationDirector{}
+			server := NewStreamingServer(nil, director, openai.NewOpenAIParser())
+			srv := &fakeProcessServer{
+				ctx:      logutil.NewTestLoggerIntoContext(context.Background()),
+				requests: append(requestMessages(), test.response...),
+				endErr:   test.endErr,
+			}
+
+			_ = server.Process(srv)
+
+			if assert.Equal(t, 1, director.completions, "completion hooks should run exactly once") {
+				got := director.terminations[0]
+				assert.Equal(t, test.want.Reason, got.Reason)
+				assert.Equal(t, test.want.StatusCode, got.StatusCode)
+				if test.want.Err == nil {
+					assert.NoError(t, got.Err)
+				} else {
+					assert.EqualError(t, got.Err, test.want.Err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestIncompleteTermination(t *testing.T) {
+	eppErr := errors.New("epp failure")
+	tests := []struct {
+		name       string
+		statusCode int
+		err        error
+		streamErr  error
+		want       fwkrq.Termination
+	}{
+		{
+			name:       "epp error takes precedence over the upstream status",
+			statusCode: 503,
+			err:        eppErr,
+			streamErr:  io.EOF,
+			want:       fwkrq.Termination{Reason: fwkrq.TerminationEPPError, StatusCode: 503, Err: eppErr},
+		},
+		{
+			name:       "upstream status takes precedence over the end of the stream",
+			statusCode: 429,
+			streamErr:  io.EOF,
+			want:       fwkrq.Termination{Reason: fwkrq.TerminationUpstreamError, StatusCode: 429},
+		},
+		{
+			name:      "context cancellation",
+			streamErr: context.Canceled,
+			want:      fwkrq.Termination{Reason: fwkrq.TerminationClientCancelled, Err: context.Canceled},
+		},
+		{
+			name: "no error",
+			want: fwkrq.Termination{Reason: fwkrq.TerminationEPPError},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reqCtx := &RequestContext{Response: &Response{StatusCode: test.statusCode}}
+			assert.Equal(t, test.want, incompleteTermination(reqCtx, test.err, test.streamErr))
+		})
+	}
+}
@@ -22,6 +22,11 @@ const (
 	// SubsetFilterKey is the metadata key used by Envoy to specify an array candidate pods for serving the request.
 	// If not specified, all the pods that are associated with the pool are candidates.
 	SubsetFilterKey = "x-gateway-destination-endpoint-subset"
+	// PoolHintNamespace is the key for the outer namespace struct in the metadata field of the extproc request that is used to wrap the pool hint.
+	PoolHintNamespace = "envoy.lb.pool_hint"
+	// PoolHintKey is the metadata key used by Envoy to specify the InferencePool, as "<name>" or "<namespace>/<name>", that
+	// should serve the request when the Endpoint Picker serves multiple pools.
+	PoolHintKey = "x-gateway-inference-pool"
 	// DestinationEndpointNamespace is the key for the outer namespace struct in the metadata field of the extproc response that is used to wrap the target endpoint.
 	DestinationEndpointNamespace = "envoy.lb"
 	// DestinationEndpointKey is the header and response metadata key used by Envoy to route to the appropriate pod.
@@ -34,6 +39,12 @@ const (
 	ObjectiveKey = "x-gateway-inference-objective"
 	// ModelNameRewriteKey is the header key used to specify the model name to be used when the request is forwarded to the model server.
 	ModelNameRewriteKey = "x-gateway-model-name-rewrite"
+	// TTFTSLOKey is the header key used to specify the time to first token target of a request, in milliseconds.
+	TTFTSLOKey = "x-slo-ttft-ms"
+	// TPOTSLOKey is the header key used to specify the time per output token target of a request, in milliseconds.
+	TPOTSLOKey = "x-slo-tpot-ms"
+	// E2ESLOKey is the header key used to specify the end-to-end latency target of a request, in milliseconds.
+	E2ESLOKey = "x-slo-e2e-ms"
 
 	// DefaultFairnessID is the default fairness ID used when no ID is provided in the request.
 	// This ensures that requests without explicit fairness identifiers are still grouped and managed by the Flow Control
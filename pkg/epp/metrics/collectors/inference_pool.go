@@ -36,17 +36,17 @@ var (
 )
 
 type inferencePoolMetricsCollector struct {
-	ds datastore.Datastore
+	datastores []datastore.Datastore
 }
 
 // Check if inferencePoolMetricsCollector implements necessary interface
 var _ prometheus.Collector = &inferencePoolMetricsCollector{}
 
 // NewInferencePoolMetricsCollector implements the prometheus.Collector interface and
-// exposes metrics about inference pool.
-func NewInferencePoolMetricsCollector(ds datastore.Datastore) prometheus.Collector {
+// exposes metrics about the inference pools of the given datastores.
+func NewInferencePoolMetricsCollector(datastores ...datastore.Datastore) prometheus.Collector {
 	return &inferencePoolMetricsCollector{
-		ds: ds,
+		datastores: datastores,
 	}
 }
 
@@ -57,12 +57,18 @@ func (c *inferencePoolMetricsCollector) Describe(ch chan<- *prometheus.Desc) {
 
 // CollectWithStability implements the prometheus.Collector interface.
 func (c *inferencePoolMetricsCollector) Collect(ch chan<- prometheus.Metric) {
-	pool, err := c.ds.PoolGet()
+	for _, ds := range c.datastores {
+		collectPool(ds, ch)
+	}
+}
+
+func collectPool(ds datastore.Datastore, ch chan<- prometheus.Metric) {
+	pool, err := ds.PoolGet()
 	if err != nil {
 		return
 	}
 
-	podMetrics := c.ds.PodList(datastore.AllPodsPredicate)
+	podMetrics := ds.PodList(datastore.AllPodsPredicate)
 	if len(podMetrics) == 0 {
 		return
 	}
@@ -62,7 +62,7 @@ func TestNoMetricsCollected(t *testing.T) {
 		ds := datastore.NewDatastore(context.Background(), epf, 0)
 
 		collector := &inferencePoolMetricsCollector{
-			ds: ds,
+			datastores: []datastore.Datastore{ds},
 		}
 
 		if err := testutil.CollectAndCompare(collector, strings.NewReader(""), ""); err != nil {
@@ -102,7 +102,7 @@ func TestMetricsCollected(t *testing.T) {
 		time.Sleep(1 * time.Second)
 
 		collector := &inferencePoolMetricsCollector{
-			ds: ds,
+			datastores: []datastore.Datastore{ds},
 		}
 		err := testutil.CollectAndCompare(collector, strings.NewReader(`
 		# HELP inference_pool_per_pod_queue_size [ALPHA] The total number of requests pending in the model server queue for each underlying pod.
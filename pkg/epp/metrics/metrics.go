@@ -300,6 +300,52 @@ var (
 		},
 		poolLabels,
 	)
+
+	inferencePoolRequestCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferencePoolComponent,
+			Name:      "request_total",
+			Help:      metricsutil.HelpMsgWithStability("Counter of requests routed to an inference server pool by an Endpoint Picker serving several pools.", compbasemetrics.ALPHA),
+		},
+		append(append([]string{}, poolLabels...), modelLabels...),
+	)
+
+	inferencePoolRequestErrCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferencePoolComponent,
+			Name:      "request_error_total",
+			Help:      metricsutil.HelpMsgWithStability("Counter of request errors of an inference server pool served by an Endpoint Picker serving several pools.", compbasemetrics.ALPHA),
+		},
+		append(append([]string{}, poolLabels...), "model_name", "target_model_name", "error_code"),
+	)
+
+	inferencePoolRequestLatencies = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: inferencePoolComponent,
+			Name:      "request_duration_seconds",
+			Help:      metricsutil.HelpMsgWithStability("Response latency distribution in seconds of an inference server pool served by an Endpoint Picker serving several pools.", compbasemetrics.ALPHA),
+			Buckets:   generalLatencyBuckets,
+		},
+		append(append([]string{}, poolLabels...), modelLabels...),
+	)
+
+	inferencePoolRunningRequests = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: inferencePoolComponent,
+			Name:      "running_requests",
+			Help:      metricsutil.HelpMsgWithStability("Number of running requests of an inference server pool served by an Endpoint Picker serving several pools.", compbasemetrics.ALPHA),
+		},
+		append(append([]string{}, poolLabels...), "model_name"),
+	)
+
+	inferencePoolSpilloverCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferencePoolComponent,
+			Name:      "spillover_total",
+			Help:      metricsutil.HelpMsgWithStability("Counter of requests spilled over from a saturated inference server pool to a fallback target.", compbasemetrics.ALPHA),
+		},
+		append(append([]string{}, poolLabels...), "fallback_pool", "model_name", "fallback_model_name"),
+	)
 )
 
 // --- Scheduling Metrics ---
@@ -337,6 +383,15 @@ var (
 		[]string{"extension_point", "plugin_type", "plugin_name"},
 	)
 
+	prepareDataPluginFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: inferenceExtension,
+			Name:      "prepare_data_plugin_failures_total",
+			Help:      metricsutil.HelpMsgWithStability("Total number of PrepareData plugin failures for each plugin type, plugin name and reason.", compbasemetrics.ALPHA),
+		},
+		[]string{"plugin_type", "plugin_name", "reason"},
+	)
+
 	prefixCacheSize = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Subsystem: inferenceExtension,
@@ -487,9 +542,15 @@ func Register(customCollectors ...prometheus.Collector) {
 		metrics.Registry.MustRegister(inferencePoolAvgKVCache)
 		metrics.Registry.MustRegister(inferencePoolAvgQueueSize)
 		metrics.Registry.MustRegister(inferencePoolReadyPods)
+		metrics.Registry.MustRegister(inferencePoolRequestCounter)
+		metrics.Registry.MustRegister(inferencePoolRequestErrCounter)
+		metrics.Registry.MustRegister(inferencePoolRequestLatencies)
+		metrics.Registry.MustRegister(inferencePoolRunningRequests)
+		metrics.Registry.MustRegister(inferencePoolSpilloverCounter)
 		metrics.Registry.MustRegister(schedulerE2ELatency)
 		metrics.Registry.MustRegister(schedulerAttemptsTotal)
 		metrics.Registry.MustRegister(pluginProcessingLatencies)
+		metrics.Registry.MustRegister(prepareDataPluginFailuresTotal)
 		metrics.Registry.MustRegister(inferenceExtensionInfo)
 		metrics.Registry.MustRegister(prefixCacheSize)
 		metrics.Registry.MustRegister(prefixCacheHitRatio)
@@ -537,9 +598,15 @@ func Reset() {
 	inferencePoolAvgKVCache.Reset()
 	inferencePoolAvgQueueSize.Reset()
 	inferencePoolReadyPods.Reset()
+	inferencePoolRequestCounter.Reset()
+	inferencePoolRequestErrCounter.Reset()
+	inferencePoolRequestLatencies.Reset()
+	inferencePoolRunningRequests.Reset()
+	inferencePoolSpilloverCounter.Reset()
 	schedulerE2ELatency.Reset()
 	schedulerAttemptsTotal.Reset()
 	pluginProcessingLatencies.Reset()
+	prepareDataPluginFailuresTotal.Reset()
 	inferenceExtensionInfo.Reset()
 	prefixCacheSize.Reset()
 	prefixCacheHitRatio.Reset()
@@ -767,6 +834,47 @@ func RecordInferencePoolReadyPods(name string, runningPods float64) {
 	inferencePoolReadyPods.WithLabelValues(name).Set(runningPods)
 }
 
+// RecordInferencePoolRequest records a request routed to the named pool.
+func RecordInferencePoolRequest(name, modelName, targetModelName string) {
+	inferencePoolRequestCounter.WithLabelValues(name, modelName, targetModelName).Inc()
+}
+
+// The pool-scoped request metrics below are only recorded by an Endpoint Picker serving several
+// pools: the requests of an Endpoint Picker serving a single pool have no pool name.
+
+// RecordInferencePoolRequestErr records an error of a request served by the named pool.
+func RecordInferencePoolRequestErr(name, modelName, targetModelName, code string) {
+	if name != "" && code != "" {
+		inferencePoolRequestErrCounter.WithLabelValues(name, modelName, targetModelName, code).Inc()
+	}
+}
+
+// RecordInferencePoolRequestLatency records the latency of a request served by the named pool.
+func RecordInferencePoolRequestLatency(name, modelName, targetModelName string, received time.Time, complete time.Time) {
+	if name != "" && complete.After(received) {
+		inferencePoolRequestLatencies.WithLabelValues(name, modelName, targetModelName).Observe(complete.Sub(received).Seconds())
+	}
+}
+
+// IncInferencePoolRunningRequests increases the running requests of the named pool.
+func IncInferencePoolRunningRequests(name, modelName string) {
+	if name != "" && modelName != "" {
+		inferencePoolRunningRequests.WithLabelValues(name, modelName).Inc()
+	}
+}
+
+// DecInferencePoolRunningRequests decreases the running requests of the named pool.
+func DecInferencePoolRunningRequests(name, modelName string) {
+	if name != "" && modelName != "" {
+		inferencePoolRunningRequests.WithLabelValues(name, modelName).Dec()
+	}
+}
+
+// RecordInferencePoolSpillover records a request spilled over from the named pool to a fallback pool and model.
+func RecordInferencePoolSpillover(name, fallbackPool, modelName, fallbackModelName string) {
+	inferencePoolSpilloverCounter.WithLabelValues(name, fallbackPool, modelName, fallbackModelName).Inc()
+}
+
 // RecordSchedulerE2ELatency records the end-to-end scheduling latency.
 func RecordSchedulerE2ELatency(duration time.Duration) {
 	schedulerE2ELatency.WithLabelValues().Observe(duration.Seconds())
@@ -807,6 +915,11 @@ func RecordPluginProcessingLatency(extensionPoint, pluginType, pluginName string
 	pluginProcessingLatencies.WithLabelValues(extensionPoint, pluginType, pluginName).Observe(duration.Seconds())
 }
 
+// RecordPrepareDataPluginFailure counts a failure of a PrepareData plugin under the given reason.
+func RecordPrepareDataPluginFailure(pluginType, pluginName, reason string) {
+	prepareDataPluginFailuresTotal.WithLabelValues(pluginType, pluginName, reason).Inc()
+}
+
 // RecordPrefixCacheSize records the size of the prefix indexer in megabytes.
 func RecordPrefixCacheSize(size int64) {
 	prefixCacheSize.WithLabelValues().Set(float64(size))
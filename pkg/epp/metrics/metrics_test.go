@@ -577,6 +577,65 @@ func TestInferencePoolMetrics(t *testing.T) {
 	}
 }
 
+func TestInferencePoolRequestTotal(t *testing.T) {
+	Reset()
+
+	RecordInferencePoolRequest("pool-a", "m1", "m1")
+	RecordInferencePoolRequest("pool-a", "m1", "m1")
+	RecordInferencePoolRequest("pool-b", "m2", "m2-v1")
+
+	val, err := testutil.GetCounterMetricValue(inferencePoolRequestCounter.WithLabelValues("pool-a", "m1", "m1"))
+	require.NoError(t, err, "Failed to get request counter for pool-a")
+	require.Equal(t, 2.0, val, "pool-a should have served two requests")
+
+	val, err = testutil.GetCounterMetricValue(inferencePoolRequestCounter.WithLabelValues("pool-b", "m2", "m2-v1"))
+	require.NoError(t, err, "Failed to get request counter for pool-b")
+	require.Equal(t, 1.0, val, "pool-b should have served one request")
+}
+
+func TestInferencePoolRequestMetrics(t *testing.T) {
+	Reset()
+	received := time.Unix(1000, 0)
+
+	RecordInferencePoolRequestErr("pool-a", "m1", "m1", "500")
+	RecordInferencePoolRequestErr("", "m1", "m1", "500")
+	RecordInferencePoolRequestLatency("pool-a", "m1", "m1", received, received.Add(2*time.Second))
+	RecordInferencePoolRequestLatency("", "m1", "m1", received, received.Add(2*time.Second))
+	IncInferencePoolRunningRequests("pool-a", "m1")
+	IncInferencePoolRunningRequests("pool-a", "m1")
+	DecInferencePoolRunningRequests("pool-a", "m1")
+	IncInferencePoolRunningRequests("", "m1")
+
+	val, err := testutil.GetCounterMetricValue(inferencePoolRequestErrCounter.WithLabelValues("pool-a", "m1", "m1", "500"))
+	require.NoError(t, err, "Failed to get request error counter for pool-a")
+	require.Equal(t, 1.0, val, "pool-a should have one error")
+	require.False(t, inferencePoolRequestErrCounter.DeleteLabelValues("", "m1", "m1", "500"), "requests without a pool should not be recorded")
+
+	require.True(t, inferencePoolRequestLatencies.DeleteLabelValues("pool-a", "m1", "m1"), "pool-a latency should be recorded")
+	require.False(t, inferencePoolRequestLatencies.DeleteLabelValues("", "m1", "m1"), "requests without a pool should not be recorded")
+
+	val, err = testutil.GetGaugeMetricValue(inferencePoolRunningRequests.WithLabelValues("pool-a", "m1"))
+	require.NoError(t, err, "Failed to get running requests of pool-a")
+	require.Equal(t, 1.0, val, "pool-a should have one running request")
+	require.False(t, inferencePoolRunningRequests.DeleteLabelValues("", "m1"), "requests without a pool should not be recorded")
+}
+
+func TestInferencePoolSpilloverTotal(t *testing.T) {
+	Reset()
+
+	RecordInferencePoolSpillover("pool-a", "pool-b", "m1", "m1")
+	RecordInferencePoolSpillover("pool-a", "pool-b", "m1", "m1")
+	RecordInferencePoolSpillover("pool-a", "pool-a", "m1", "m1-small")
+
+	val, err := testutil.GetCounterMetricValue(inferencePoolSpilloverCounter.WithLabelValues("pool-a", "pool-b", "m1", "m1"))
+	require.NoError(t, err, "Failed to get spillover counter to pool-b")
+	require.Equal(t, 2.0, val, "Expected two requests spilled over to pool-b")
+
+	val, err = testutil.GetCounterMetricValue(inferencePoolSpilloverCounter.WithLabelValues("pool-a", "pool-a", "m1", "m1-small"))
+	require.NoError(t, err, "Failed to get spillover counter to m1-small")
+	require.Equal(t, 1.0, val, "Expected one request spilled over to m1-small")
+}
+
 func TestPluginProcessingLatencies(t *testing.T) {
 	Reset()
 	type pluginLatency struct {
@@ -1284,3 +1343,19 @@ func TestInferenceModelRewriteDecisionsTotalMetric(t *testing.T) {
 		})
 	}
 }
+
+func TestPrepareDataPluginFailuresTotal(t *testing.T) {
+	Reset()
+
+	RecordPrepareDataPluginFailure("prefix-cache-producer", "producer", "timeout")
+	RecordPrepareDataPluginFailure("prefix-cache-producer", "producer", "timeout")
+	RecordPrepareDataPluginFailure("prefix-cache-producer", "producer", "error")
+
+	timeouts, err := testutil.GetCounterMetricValue(prepareDataPluginFailuresTotal.WithLabelValues("prefix-cache-producer", "producer", "timeout"))
+	require.NoError(t, err, "Failed to get timeout counter")
+	require.Equal(t, 2.0, timeouts, "producer should have timed out twice")
+
+	errs, err := testutil.GetCounterMetricValue(prepareDataPluginFailuresTotal.WithLabelValues("prefix-cache-producer", "producer", "error"))
+	require.NoError(t, err, "Failed to get error counter")
+	require.Equal(t, 1.0, errs, "producer should have failed once")
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectivestats
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+// Datastore lists the InferenceObjectives whose status is reported, and the InferencePool serving
+// them.
+type Datastore interface {
+	PoolGet() (*datalayer.EndpointPool, error)
+	ObjectiveGetAll() []*v1alpha2.InferenceObjective
+}
+
+// StatusReporter periodically writes the statistics aggregated by a Tracker to the status of the
+// InferenceObjectives. Objectives without requests over a window report empty statistics, so that
+// their status does not go stale. The status of an objective is only patched when its statistics
+// changed, apart from the reporting window, so that idle objectives are not patched every interval.
+type StatusReporter struct {
+	client     client.Client
+	tracker    *Tracker
+	interval   time.Duration
+	datastores []Datastore
+}
+
+var _ manager.LeaderElectionRunnable = &StatusReporter{}
+
+// NewStatusReporter creates a StatusReporter that reports the objectives of the given datastores
+// every interval.
+func NewStatusReporter(c client.Client, tracker *Tracker, interval time.Duration, datastores ...Datastore) *StatusReporter {
+	return &StatusReporter{
+		client:     c,
+		tracker:    tracker,
+		interval:   interval,
+		datastores: datastores,
+	}
+}
+
+// Start implements manager.Runnable.
+func (r *StatusReporter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			r.report(ctx, now)
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Only the leader serves requests, so
+// only the leader reports.
+func (r *StatusReporter) NeedLeaderElection() bool {
+	return true
+}
+
+func (r *StatusReporter) report(ctx context.Context, now time.Time) {
+	logger := log.FromContext(ctx)
+	stats, length := r.tracker.Flush(now)
+	for _, ds := range r.datastores {
+		pool, err := ds.PoolGet()
+		if err != nil {
+			continue
+		}
+		poolKey := types.NamespacedName{Namespace: pool.Namespace, Name: pool.Name}
+		for _, objective := range ds.ObjectiveGetAll() {
+			objectiveStats, ok := stats[Key{Pool: poolKey, Objective: client.ObjectKeyFromObject(objective)}]
+			if !ok {
+				objectiveStats = EmptyStats(now, length)
+			}
+			if current := objective.Status.Stats; current != nil && sameStats(*current, objectiveStats) {
+				continue
+			}
+			patched := objective.DeepCopy()
+			patched.Status.Stats = &objectiveStats
+			if err := r.client.Status().Patch(ctx, patched, client.MergeFrom(objective)); client.IgnoreNotFound(err) != nil {
+				logger.V(logutil.DEFAULT).Error(err, "Failed to report InferenceObjective stats", "objective", client.ObjectKeyFromObject(objective))
+			}
+		}
+	}
+}
+
+// sameStats reports whether the statistics are equal, ignoring the reporting window they cover.
+func sameStats(a, b v1alpha2.InferenceObjectiveStats) bool {
+	a.LastUpdateTime, a.Window = b.LastUpdateTime, b.Window
+	return equality.Semantic.DeepEqual(a, b)
+}
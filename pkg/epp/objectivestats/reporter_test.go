@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectivestats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+)
+
+type fakeDatastore struct {
+	pool       *datalayer.EndpointPool
+	objectives []*v1alpha2.InferenceObjective
+}
+
+func (ds fakeDatastore) PoolGet() (*datalayer.EndpointPool, error) {
+	return ds.pool, nil
+}
+
+func (ds fakeDatastore) ObjectiveGetAll() []*v1alpha2.InferenceObjective {
+	return ds.objectives
+}
+
+func TestStatusReporter(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha2.Install(scheme)
+
+	chat := &v1alpha2.InferenceObjective{ObjectMeta: metav1.ObjectMeta{Name: "chat", Namespace: "default"}}
+	batch := &v1alpha2.InferenceObjective{ObjectMeta: metav1.ObjectMeta{Name: "batch", Namespace: "default"}}
+	// An objective of the same name, in the namespace of another pool.
+	otherChat := &v1alpha2.InferenceObjective{ObjectMeta: metav1.ObjectMeta{Name: "chat", Namespace: "other"}}
+	// Deleted objectives are skipped.
+	deleted := &v1alpha2.InferenceObjective{ObjectMeta: metav1.ObjectMeta{Name: "deleted", Namespace: "default"}}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(chat, batch, otherChat).
+		WithStatusSubresource(&v1alpha2.InferenceObjective{}).
+		Build()
+
+	pool := datalayer.NewEndpointPool("default", "pool")
+	otherPool := datalayer.NewEndpointPool("other", "pool")
+	tracker := NewTracker()
+	for range 10 {
+		tracker.RecordRequest(Key{Pool: poolKey, Objective: client.ObjectKeyFromObject(chat)})
+	}
+	tracker.RecordShed(Key{Pool: poolKey, Objective: client.ObjectKeyFromObject(chat)})
+	for range 20 {
+		tracker.RecordRequest(Key{Pool: types.NamespacedName{Namespace: "other", Name: "pool"}, Objective: client.ObjectKeyFromObject(otherChat)})
+	}
+
+	ctx := context.Background()
+	for _, objective := range []*v1alpha2.InferenceObjective{chat, batch, otherChat} {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(objective), objective); err != nil {
+			t.Fatalf("Failed to get objective: %v", err)
+		}
+	}
+	now := time.Now().Truncate(time.Second)
+	tracker.windowStart = now.Add(-10 * time.Second)
+	reporter := NewStatusReporter(c, tracker, 10*time.Second,
+		fakeDatastore{pool: pool, objectives: []*v1alpha2.InferenceObjective{chat, deleted}},
+		fakeDatastore{pool: pool, objectives: []*v1alpha2.InferenceObjective{batch}},
+		fakeDatastore{pool: otherPool, objectives: []*v1alpha2.InferenceObjective{otherChat}})
+	reporter.report(ctx, now)
+
+	tests := []struct {
+		name      string
+		objective client.ObjectKey
+		wantRate  string
+		wantShed  int64
+	}{
+		{name: "objective with requests", objective: client.ObjectKeyFromObject(chat), wantRate: "1", wantShed: 1},
+		{name: "objective without requests", objective: client.ObjectKeyFromObject(batch), wantRate: "0", wantShed: 0},
+		{name: "objective of the same name in another pool", objective: client.ObjectKeyFromObject(otherChat), wantRate: "2", wantShed: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := &v1alpha2.InferenceObjective{}
+			if err := c.Get(ctx, test.objective, got); err != nil {
+				t.Fatalf("Failed to get objective: %v", err)
+			}
+			stats := got.Status.Stats
+			if stats == nil {
+				t.Fatal("Expected stats to be reported")
+			}
+			if !stats.LastUpdateTime.Time.Equal(now) {
+				t.Errorf("LastUpdateTime = %v, want %v", stats.LastUpdateTime.Time, now)
+			}
+			if stats.Window.Duration != 10*time.Second {
+				t.Errorf("Window = %v, want %v", stats.Window.Duration, 10*time.Second)
+			}
+			if stats.RequestRate == nil || stats.RequestRate.String() != test.wantRate {
+				t.Errorf("RequestRate = %v, want %s", stats.RequestRate, test.wantRate)
+			}
+			if stats.Shed != test.wantShed {
+				t.Errorf("Shed = %d, want %d", stats.Shed, test.wantShed)
+			}
+		})
+	}
+}
+
+func TestStatusReporterSkipsUnchangedStats(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = v1alpha2.Install(scheme)
+
+	idle := &v1alpha2.InferenceObjective{ObjectMeta: metav1.ObjectMeta{Name: "idle", Namespace: "default"}}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(idle).
+		WithStatusSubresource(&v1alpha2.InferenceObjective{}).
+		Build()
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	tracker := NewTracker()
+	tracker.windowStart = now.Add(-10 * time.Second)
+	reporter := NewStatusReporter(c, tracker, 10*time.Second, fakeDatastore{
+		pool:       datalayer.NewEndpointPool("default", "pool"),
+		objectives: []*v1alpha2.InferenceObjective{idle},
+	})
+
+	// The first report writes the empty statistics of the idle objective.
+	if err := c.Get(ctx, client.ObjectKeyFromObject(idle), idle); err != nil {
+		t.Fatalf("Failed to get objective: %v", err)
+	}
+	reporter.report(ctx, now)
+	if err := c.Get(ctx, client.ObjectKeyFromObject(idle), idle); err != nil {
+		t.Fatalf("Failed to get objective: %v", err)
+	}
+	if idle.Status.Stats == nil {
+		t.Fatal("Expected stats to be reported")
+	}
+	resourceVersion := idle.ResourceVersion
+
+	// The following ones leave them unchanged.
+	reporter.report(ctx, now.Add(10*time.Second))
+	got := &v1alpha2.InferenceObjective{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(idle), got); err != nil {
+		t.Fatalf("Failed to get objective: %v", err)
+	}
+	if got.ResourceVersion != resourceVersion {
+		t.Errorf("ResourceVersion = %s, want %s: unchanged stats should not be patched", got.ResourceVersion, resourceVersion)
+	}
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package objectivestats aggregates the request statistics of each InferenceObjective and reports them
+// in the status of the objectives.
+package objectivestats
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+)
+
+// maxSamples bounds the number of latency samples kept per objective and window. Beyond it, samples
+// are kept by reservoir sampling.
+const maxSamples = 1000
+
+// Key identifies the statistics of an InferenceObjective, in a given InferencePool. In multi-pool
+// mode, objectives of the same name in different namespaces or pools are tracked separately.
+type Key struct {
+	// Pool is the InferencePool serving the requests.
+	Pool types.NamespacedName
+	// Objective is the InferenceObjective of the requests.
+	Objective types.NamespacedName
+}
+
+// Completion describes a request whose response completed successfully.
+type Completion struct {
+	// TTFT is the time to first token of the request, or zero if the response was not streamed.
+	TTFT time.Duration
+	// TPOT is the average time per output token of the request, or zero if it is unknown.
+	TPOT time.Duration
+	// E2E is the end-to-end latency of the request.
+	E2E time.Duration
+	// TTFTTarget is the time to first token target of the request, or zero if it has none.
+	TTFTTarget time.Duration
+	// TPOTTarget is the time per output token target of the request, or zero if it has none.
+	TPOTTarget time.Duration
+	// E2ETarget is the end-to-end latency target of the request, or zero if it has none.
+	E2ETarget time.Duration
+}
+
+// evaluated reports whether a latency target of the request can be evaluated, that is whether the
+// request has a target whose latency was observed.
+func (c Completion) evaluated() bool {
+	return (c.TTFTTarget > 0 && c.TTFT > 0) || (c.TPOTTarget > 0 && c.TPOT > 0) || (c.E2ETarget > 0 && c.E2E > 0)
+}
+
+// metTargets reports whether the request met all of its latency targets. A latency that was not
+// observed does not count against its target.
+func (c Completion) metTargets() bool {
+	return (c.TTFTTarget <= 0 || c.TTFT <= c.TTFTTarget) && (c.TPOTTarget <= 0 || c.TPOT <= c.TPOTTarget) &&
+		(c.E2ETarget <= 0 || c.E2E <= c.E2ETarget)
+}
+
+// Tracker aggregates the request statistics of each InferenceObjective over a reporting window.
+// It is safe for concurrent use. A nil Tracker records nothing.
+type Tracker struct {
+	mu          sync.Mutex
+	windowStart time.Time
+	objectives  map[Key]*window
+}
+
+// window holds the statistics of an objective over the current window.
+type window struct {
+	requests     int64
+	shed         int64
+	queueEvicted int64
+	sloRequests  int64
+	sloMet       int64
+	ttft         samples
+	tpot         samples
+}
+
+// NewTracker creates a Tracker whose first window starts now.
+func NewTracker() *Tracker {
+	return &Tracker{
+		windowStart: time.Now(),
+		objectives:  map[Key]*window{},
+	}
+}
+
+// RecordRequest records a request received for the objective.
+func (t *Tracker) RecordRequest(objective Key) {
+	t.record(objective, func(w *window) { w.requests++ })
+}
+
+// RecordShed records a request of the objective rejected because the pool was saturated or the
+// queue was full.
+func (t *Tracker) RecordShed(objective Key) {
+	t.record(objective, func(w *window) { w.shed++ })
+}
+
+// RecordQueueEvicted records a request of the objective evicted from the queue.
+func (t *Tracker) RecordQueueEvicted(objective Key) {
+	t.record(objective, func(w *window) { w.queueEvicted++ })
+}
+
+// RecordCompletion records a request of the objective whose response completed successfully.
+func (t *Tracker) RecordCompletion(objective Key, c Completion) {
+	t.record(objective, func(w *window) {
+		if c.TTFT > 0 {
+			w.ttft.add(c.TTFT)
+		}
+		if c.TPOT > 0 {
+			w.tpot.add(c.TPOT)
+		}
+		if c.evaluated() {
+			w.sloRequests++
+			if c.metTargets() {
+				w.sloMet++
+			}
+		}
+	})
+}
+
+func (t *Tracker) record(objective Key, f func(w *window)) {
+	if t == nil || objective.Objective.Name == "" {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	w, ok := t.objectives[objective]
+	if !ok {
+		w = &window{}
+		t.objectives[objective] = w
+	}
+	f(w)
+}
+
+// Flush returns the statistics of each objective that received requests over the current window
+// and the length of the window, and starts a new window.
+func (t *Tracker) Flush(now time.Time) (map[Key]v1alpha2.InferenceObjectiveStats, time.Duration) {
+	t.mu.Lock()
+	objectives, start := t.objectives, t.windowStart
+	t.objectives, t.windowStart = map[Key]*window{}, now
+	t.mu.Unlock()
+
+	length := now.Sub(start)
+	stats := make(map[Key]v1alpha2.InferenceObjectiveStats, len(objectives))
+	for objective, w := range objectives {
+		stats[objective] = w.stats(now, length)
+	}
+	return stats, length
+}
+
+// EmptyStats returns the statistics of an objective that received no requests over a window.
+func EmptyStats(now time.Time, length time.Duration) v1alpha2.InferenceObjectiveStats {
+	return (&window{}).stats(now, length)
+}
+
+func (w *window) stats(now time.Time, length time.Duration) v1alpha2.InferenceObjectiveStats {
+	stats := v1alpha2.InferenceObjectiveStats{
+		LastUpdateTime: metav1.NewTime(now),
+		Window:         metav1.Duration{Duration: length},
+		Shed:           w.shed,
+		QueueEvicted:   w.queueEvicted,
+		TTFT:           w.ttft.percentiles(),
+		TPOT:           w.tpot.percentiles(),
+	}
+	if length > 0 {
+		stats.RequestRate = resource.NewMilliQuantity(int64(math.Round(float64(w.requests)*1000/length.Seconds())), resource.DecimalSI)
+	}
+	if w.sloRequests > 0 {
+		attainment := int32(w.sloMet * 100 / w.sloRequests)
+		stats.SLOAttainmentPercent = &attainment
+	}
+	return stats
+}
+
+// samples is a bounded reservoir of latency samples.
+type samples struct {
+	seen   int64
+	values []time.Duration
+}
+
+func (s *samples) add(d time.Duration) {
+	s.seen++
+	if len(s.values) < maxSamples {
+		s.values = append(s.values, d)
+		return
+	}
+	if i := rand.Int63n(s.seen); i < maxSamples {
+		s.values[i] = d
+	}
+}
+
+// percentiles returns the percentiles of the samples, or nil if there are none.
+func (s *samples) percentiles() *v1alpha2.LatencyPercentiles {
+	if len(s.values) == 0 {
+		return nil
+	}
+	slices.Sort(s.values)
+	return &v1alpha2.LatencyPercentiles{
+		P50: metav1.Duration{Duration: s.percentile(0.50)},
+		P99: metav1.Duration{Duration: s.percentile(0.99)},
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted samples, rounded for readability.
+func (s *samples) percentile(p float64) time.Duration {
+	rank := int(math.Ceil(p*float64(len(s.values)))) - 1
+	return s.values[max(rank, 0)].Round(100 * time.Microsecond)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectivestats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+)
+
+var (
+	poolKey  = types.NamespacedName{Namespace: "default", Name: "pool"}
+	chatKey  = Key{Pool: poolKey, Objective: types.NamespacedName{Namespace: "default", Name: "chat"}}
+	batchKey = Key{Pool: poolKey, Objective: types.NamespacedName{Namespace: "default", Name: "batch"}}
+)
+
+func TestTracker(t *testing.T) {
+	tracker := NewTracker()
+	start := tracker.windowStart
+
+	for range 20 {
+		tracker.RecordRequest(chatKey)
+	}
+	tracker.RecordRequest(Key{Pool: poolKey})
+	tracker.RecordShed(chatKey)
+	tracker.RecordQueueEvicted(chatKey)
+	tracker.RecordQueueEvicted(batchKey)
+	for i := range 10 {
+		tracker.RecordCompletion(chatKey, Completion{
+			TTFT:       time.Duration(i+1) * 100 * time.Millisecond,
+			TPOT:       time.Duration(i+1) * time.Millisecond,
+			TTFTTarget: 500 * time.Millisecond,
+		})
+	}
+	// Responses whose targeted latencies were not observed do not count towards the SLO attainment.
+	tracker.RecordCompletion(chatKey, Completion{})
+	tracker.RecordCompletion(chatKey, Completion{TTFTTarget: time.Millisecond})
+
+	now := start.Add(10 * time.Second)
+	got, length := tracker.Flush(now)
+	if length != 10*time.Second {
+		t.Errorf("Flush() length = %v, want %v", length, 10*time.Second)
+	}
+	want := map[Key]v1alpha2.InferenceObjectiveStats{
+		chatKey: {
+			LastUpdateTime:       metav1.NewTime(now),
+			Window:               metav1.Duration{Duration: 10 * time.Second},
+			RequestRate:          resource.NewMilliQuantity(2000, resource.DecimalSI),
+			TTFT:                 &v1alpha2.LatencyPercentiles{P50: metav1.Duration{Duration: 500 * time.Millisecond}, P99: metav1.Duration{Duration: time.Second}},
+			TPOT:                 &v1alpha2.LatencyPercentiles{P50: metav1.Duration{Duration: 5 * time.Millisecond}, P99: metav1.Duration{Duration: 10 * time.Millisecond}},
+			Shed:                 1,
+			QueueEvicted:         1,
+			SLOAttainmentPercent: ptr.To[int32](50),
+		},
+		batchKey: {
+			LastUpdateTime: metav1.NewTime(now),
+			Window:         metav1.Duration{Duration: 10 * time.Second},
+			RequestRate:    resource.NewMilliQuantity(0, resource.DecimalSI),
+			QueueEvicted:   1,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Flush() mismatch (-want +got):\n%s", diff)
+	}
+
+	if got, _ := tracker.Flush(now.Add(10 * time.Second)); len(got) != 0 {
+		t.Errorf("Flush() after flush = %v, want no stats", got)
+	}
+}
+
+func TestTrackerSeparatesPools(t *testing.T) {
+	tracker := NewTracker()
+	otherPool := Key{
+		Pool:      types.NamespacedName{Namespace: "other", Name: "pool"},
+		Objective: types.NamespacedName{Namespace: "other", Name: "chat"},
+	}
+	tracker.RecordShed(chatKey)
+	tracker.RecordShed(chatKey)
+	tracker.RecordShed(otherPool)
+
+	got, _ := tracker.Flush(time.Now())
+	if got[chatKey].Shed != 2 || got[otherPool].Shed != 1 {
+		t.Errorf("Flush() shed = %d and %d, want 2 and 1", got[chatKey].Shed, got[otherPool].Shed)
+	}
+}
+
+func TestCompletionTargets(t *testing.T) {
+	tests := []struct {
+		name          string
+		completion    Completion
+		wantEvaluated bool
+		wantMet       bool
+	}{
+		{
+			name:          "all targets met",
+			completion:    Completion{TTFT: time.Second, TPOT: 10 * time.Millisecond, TTFTTarget: time.Second, TPOTTarget: 20 * time.Millisecond},
+			wantEvaluated: true,
+			wantMet:       true,
+		},
+		{
+			name:          "TPOT target missed",
+			completion:    Completion{TTFT: time.Second, TPOT: 30 * time.Millisecond, TTFTTarget: time.Second, TPOTTarget: 20 * time.Millisecond},
+			wantEvaluated: true,
+			wantMet:       false,
+		},
+		{
+			name:          "E2E target missed by a non-streamed response",
+			completion:    Completion{E2E: 3 * time.Second, E2ETarget: 2 * time.Second, TTFTTarget: time.Second},
+			wantEvaluated: true,
+			wantMet:       false,
+		},
+		{
+			name:          "unobserved latency is not evaluated",
+			completion:    Completion{TPOT: 30 * time.Millisecond, TTFTTarget: time.Second},
+			wantEvaluated: false,
+			wantMet:       true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.completion.evaluated(); got != test.wantEvaluated {
+				t.Errorf("evaluated() = %v, want %v", got, test.wantEvaluated)
+			}
+			if got := test.completion.metTargets(); got != test.wantMet {
+				t.Errorf("metTargets() = %v, want %v", got, test.wantMet)
+			}
+		})
+	}
+}
+
+func TestNilTracker(t *testing.T) {
+	var tracker *Tracker
+	tracker.RecordRequest(chatKey)
+	tracker.RecordCompletion(chatKey, Completion{TTFT: time.Second})
+}
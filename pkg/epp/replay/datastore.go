@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/requestcontrol"
+)
+
+const poolName = "replay"
+
+// fleetDatastore is the datastore of the director of a replay. It lists the simulated model
+// servers with their current metrics, and an InferenceObjective for every priority of the trace.
+type fleetDatastore struct {
+	servers    []*server
+	objectives map[string]*v1alpha2.InferenceObjective
+}
+
+var _ requestcontrol.Datastore = &fleetDatastore{}
+
+func newFleetDatastore(servers []*server) *fleetDatastore {
+	return &fleetDatastore{
+		servers:    servers,
+		objectives: map[string]*v1alpha2.InferenceObjective{},
+	}
+}
+
+// PoolGet returns the pool of the simulated model servers.
+func (ds *fleetDatastore) PoolGet() (*datalayer.EndpointPool, error) {
+	return datalayer.NewEndpointPool(endpointNamespace, poolName), nil
+}
+
+// ObjectiveGet returns the InferenceObjective registered with the given name by objectiveKey.
+func (ds *fleetDatastore) ObjectiveGet(objectiveName string) *v1alpha2.InferenceObjective {
+	objective, ok := ds.objectives[objectiveName]
+	if !ok {
+		return nil
+	}
+	return objective.DeepCopy()
+}
+
+// PodList refreshes the metrics of the simulated model servers and returns the ones matching the
+// predicate.
+func (ds *fleetDatastore) PodList(predicate func(backendmetrics.PodMetrics) bool) []backendmetrics.PodMetrics {
+	endpoints := make([]backendmetrics.PodMetrics, 0, len(ds.servers))
+	for _, s := range ds.servers {
+		if endpoint := s.refresh(); predicate(endpoint) {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
+// ModelRewriteGet returns no rewrite, as the replay has no InferenceModelRewrites.
+func (ds *fleetDatastore) ModelRewriteGet(string, map[string]string) (*v1alpha2.InferenceModelRewriteRule, string) {
+	return nil, ""
+}
+
+// objectiveKey returns the name of the InferenceObjective of the requests with the given priority,
+// registering it on first use.
+func (ds *fleetDatastore) objectiveKey(priority int) string {
+	name := fmt.Sprintf("priority-%d", priority)
+	if _, ok := ds.objectives[name]; !ok {
+		ds.objectives[name] = &v1alpha2.InferenceObjective{
+			ObjectMeta: metav1.ObjectMeta{Namespace: endpointNamespace, Name: name},
+			Spec:       v1alpha2.InferenceObjectiveSpec{Priority: &priority},
+		}
+	}
+	return name
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+)
+
+const (
+	endpointNamespace = "default"
+	endpointPort      = "8000"
+	metricsPort       = "9090"
+)
+
+// ServingModel describes the simulated model servers. Each server runs continuous batching: every
+// step it admits waiting requests in arrival order while its batch and KV cache have room, prefills
+// the newly admitted requests and generates one token for every running request.
+type ServingModel struct {
+	// Endpoints is the number of model servers in the fleet.
+	Endpoints int
+	// MaxRunningRequests is the maximum number of requests in the batch of a model server.
+	MaxRunningRequests int
+	// KVCacheTokens is the KV cache capacity of a model server in tokens. A running request holds
+	// the KV cache of its prompt and output tokens.
+	KVCacheTokens int
+	// BlockSize is the number of tokens of a KV cache block, the unit of prefix caching.
+	BlockSize int
+	// MaxActiveModels is the maximum number of models, including LoRA adapters, served at once by a
+	// model server.
+	MaxActiveModels int
+	// StepTime is the fixed time of a step of a model server.
+	StepTime time.Duration
+	// DecodeTimePerRequest is the time added to a step for every request generating a token.
+	DecodeTimePerRequest time.Duration
+	// PrefillTimePerToken is the time added to a step for every prompt token missing the prefix cache.
+	PrefillTimePerToken time.Duration
+}
+
+// DefaultServingModel returns a serving model resembling a small fleet of GPUs serving an 8B model.
+func DefaultServingModel() ServingModel {
+	return ServingModel{
+		Endpoints:            4,
+		MaxRunningRequests:   64,
+		KVCacheTokens:        200000,
+		BlockSize:            64,
+		MaxActiveModels:      4,
+		StepTime:             8 * time.Millisecond,
+		DecodeTimePerRequest: 200 * time.Microsecond,
+		PrefillTimePerToken:  100 * time.Microsecond,
+	}
+}
+
+// Validate checks that the serving model describes a working fleet.
+func (m ServingModel) Validate() error {
+	if m.Endpoints <= 0 {
+		return fmt.Errorf("the number of endpoints must be positive, got %d", m.Endpoints)
+	}
+	if m.MaxRunningRequests <= 0 {
+		return fmt.Errorf("the maximum number of running requests must be positive, got %d", m.MaxRunningRequests)
+	}
+	if m.BlockSize <= 0 {
+		return fmt.Errorf("the block size must be positive, got %d", m.BlockSize)
+	}
+	if m.KVCacheTokens < m.BlockSize {
+		return fmt.Errorf("the KV cache of %d tokens must hold at least a block of %d tokens", m.KVCacheTokens, m.BlockSize)
+	}
+	if m.MaxActiveModels < 0 {
+		return fmt.Errorf("the maximum number of active models must not be negative, got %d", m.MaxActiveModels)
+	}
+	if m.StepTime <= 0 {
+		return fmt.Errorf("the step time must be positive, got %s", m.StepTime)
+	}
+	if m.DecodeTimePerRequest < 0 || m.PrefillTimePerToken < 0 {
+		return errors.New("the decode and prefill times must not be negative")
+	}
+	return nil
+}
+
+// simRequest is a request being served by a simulated model server.
+type simRequest struct {
+	record *Record
+	// reqCtx is the context of the request in the director.
+	reqCtx *handlers.RequestContext
+	blocks []uint64
+	// kvTokens is the number of KV cache tokens held by the request while it runs.
+	kvTokens     int
+	cachedTokens int
+	prefilled    bool
+	generated    int
+	firstToken   time.Duration
+	finish       time.Duration
+}
+
+// serverHooks receives the events of the requests served by the simulated model servers.
+type serverHooks interface {
+	firstToken(req *simRequest)
+	completed(req *simRequest)
+}
+
+// server is a simulated model server.
+type server struct {
+	model    ServingModel
+	metadata *fwkdl.EndpointMetadata
+	// endpoint is the server as listed by the datastore, whose attributes persist across requests.
+	endpoint *fwkdl.ModelServer
+	// clock is the time at which the server starts its next step.
+	clock        time.Duration
+	waiting      []*simRequest
+	running      []*simRequest
+	usedKVTokens int
+	// prefixCache holds the hashes of the prompt blocks in the KV cache of the server.
+	prefixCache *lru.Cache[uint64, struct{}]
+	// servedTokens counts the prompt and output tokens of the requests sent to the server.
+	servedTokens int
+	requests     int
+}
+
+func newServer(model ServingModel, index int) *server {
+	cache, _ := lru.New[uint64, struct{}](model.KVCacheTokens / model.BlockSize)
+	name := fmt.Sprintf("replay-server-%d", index)
+	address := fmt.Sprintf("10.0.%d.%d", index/250, index%250+1)
+	metadata := &fwkdl.EndpointMetadata{
+		NamespacedName: k8stypes.NamespacedName{Namespace: endpointNamespace, Name: name},
+		PodName:        name,
+		Address:        address,
+		Port:           endpointPort,
+		MetricsHost:    address + ":" + metricsPort,
+		Labels:         map[string]string{"app": "replay"},
+	}
+	return &server{
+		model:       model,
+		metadata:    metadata,
+		endpoint:    fwkdl.NewEndpoint(metadata, nil),
+		prefixCache: cache,
+	}
+}
+
+// refresh updates the metrics of the endpoint of the server to its current state and returns it.
+func (s *server) refresh() fwkdl.Endpoint {
+	metrics := fwkdl.NewMetrics()
+	for _, req := range s.running {
+		metrics.ActiveModels[req.record.Model]++
+	}
+	for _, req := range s.waiting {
+		if _, ok := metrics.ActiveModels[req.record.Model]; !ok {
+			metrics.WaitingModels[req.record.Model]++
+		}
+	}
+	metrics.MaxActiveModels = s.model.MaxActiveModels
+	metrics.RunningRequestsSize = len(s.running)
+	metrics.WaitingQueueSize = len(s.waiting)
+	metrics.KVCacheUsagePercent = float64(s.usedKVTokens) / float64(s.model.KVCacheTokens)
+	metrics.KvCacheMaxTokenCapacity = s.model.KVCacheTokens
+	metrics.CacheBlockSize = s.model.BlockSize
+	metrics.CacheNumGPUBlocks = s.model.KVCacheTokens / s.model.BlockSize
+	// Plugins check the freshness of the metrics against the wall clock.
+	metrics.UpdateTime = time.Now()
+	s.endpoint.UpdateMetrics(metrics)
+	return s.endpoint
+}
+
+// enqueue adds a request arriving at the given time to the waiting queue of the server.
+func (s *server) enqueue(req *simRequest, arrival time.Duration) {
+	if !s.busy() && s.clock < arrival {
+		s.clock = arrival
+	}
+	req.blocks = promptBlocks(req.record, s.model.BlockSize)
+	req.kvTokens = min(req.record.InputTokens+req.record.OutputTokens, s.model.KVCacheTokens)
+	s.waiting = append(s.waiting, req)
+	s.servedTokens += req.record.InputTokens + req.record.OutputTokens
+	s.requests++
+}
+
+// busy returns whether the server has requests to serve.
+func (s *server) busy() bool {
+	return len(s.waiting) > 0 || len(s.running) > 0
+}
+
+// step runs a step of the server, reporting the first tokens and the completions to the hooks.
+func (s *server) step(hooks serverHooks) {
+	for len(s.waiting) > 0 && len(s.running) < s.model.MaxRunningRequests &&
+		s.usedKVTokens+s.waiting[0].kvTokens <= s.model.KVCacheTokens {
+		req := s.waiting[0]
+		s.waiting = s.waiting[1:]
+		s.admit(req)
+	}
+	if len(s.running) == 0 {
+		return
+	}
+
+	duration := s.model.StepTime
+	for _, req := range s.running {
+		if req.prefilled {
+			duration += s.model.DecodeTimePerRequest
+		} else {
+			duration += time.Duration(req.record.InputTokens-req.cachedTokens) * s.model.PrefillTimePerToken
+		}
+	}
+	s.clock += duration
+
+	running := s.running[:0]
+	for _, req := range s.running {
+		if !req.prefilled {
+			req.prefilled = true
+			req.firstToken = s.clock
+			hooks.firstToken(req)
+		}
+		req.generated++
+		if req.generated < req.record.OutputTokens {
+			running = append(running, req)
+			continue
+		}
+		req.finish = s.clock
+		s.usedKVTokens -= req.kvTokens
+		hooks.completed(req)
+	}
+	clear(s.running[len(running):])
+	s.running = running
+}
+
+// admit moves a request into the batch, reusing the cached blocks of the longest prefix of its prompt.
+func (s *server) admit(req *simRequest) {
+	matched := 0
+	for _, block := range req.blocks {
+		if _, ok := s.prefixCache.Get(block); !ok {
+			break
+		}
+		matched++
+	}
+	req.cachedTokens = min(matched*s.model.BlockSize, req.record.InputTokens)
+	for _, block := range req.blocks {
+		s.prefixCache.Add(block, struct{}{})
+	}
+	s.usedKVTokens += req.kvTokens
+	s.running = append(s.running, req)
+}
+
+// promptBlocks returns the chained hashes of the full blocks of the prompt of the request.
+func promptBlocks(record *Record, blockSize int) []uint64 {
+	blockChars := blockSize * charactersPerToken
+	prompt := record.Prompt
+	blocks := make([]uint64, 0, len(prompt)/blockChars)
+	hasher := fnv.New64a()
+	_, _ = hasher.Write([]byte(record.Model))
+	for start := 0; start+blockChars <= len(prompt); start += blockChars {
+		_, _ = hasher.Write([]byte(prompt[start : start+blockChars]))
+		blocks = append(blocks, hasher.Sum64())
+	}
+	return blocks
+}
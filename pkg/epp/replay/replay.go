@@ -0,0 +1,282 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config/loader"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	fwkrc "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/requestcontrol"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/saturationdetector/framework/plugins/utilizationdetector"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/scheduling"
+)
+
+// Configuration is an EPP configuration to replay a trace against.
+type Configuration struct {
+	// Name identifies the configuration in the reports.
+	Name string
+	// Text is the EndpointPickerConfig. The default configuration of the EPP is used when it is empty.
+	Text []byte
+}
+
+// trackingScheduler is the scheduler of a configuration, noting whether it failed the last request, to
+// tell the requests the director could not schedule from the ones it rejected.
+type trackingScheduler struct {
+	*scheduling.Scheduler
+	failed bool
+}
+
+func (s *trackingScheduler) Schedule(ctx context.Context, request *fwksched.LLMRequest,
+	candidates []fwksched.Endpoint) (*fwksched.SchedulingResult, error) {
+	result, err := s.Scheduler.Schedule(ctx, request, candidates)
+	s.failed = err != nil
+	return result, err
+}
+
+// simulation replays a trace against a configuration.
+type simulation struct {
+	ctx       context.Context
+	logger    logr.Logger
+	director  *requestcontrol.Director
+	scheduler *trackingScheduler
+	datastore *fleetDatastore
+	servers   []*server
+	byName    map[k8stypes.NamespacedName]*server
+	results   []requestResult
+}
+
+// Run replays the trace, as returned by ReadTrace, through the director of the EPP with the plugins of the configuration
+// against a fleet of model servers simulated by the serving model, and reports the results.
+//
+// The fleet runs on the simulated time of the trace, while plugins relying on the wall clock see
+// the whole replay happen in a short time.
+func Run(ctx context.Context, trace []Record, model ServingModel, configuration Configuration) (*Report, error) {
+	if err := model.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid serving model - %w", err)
+	}
+	if len(trace) == 0 {
+		return nil, errors.New("the trace has no records")
+	}
+	// Plugins may run background routines bound to the context, which end with the replay.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	logger := log.FromContext(ctx).WithValues("configuration", configuration.Name)
+	ctx = log.IntoContext(ctx, logger)
+
+	sim := &simulation{
+		ctx:     ctx,
+		logger:  logger,
+		byName:  map[k8stypes.NamespacedName]*server{},
+		results: make([]requestResult, 0, len(trace)),
+	}
+	for i := range model.Endpoints {
+		s := newServer(model, i)
+		sim.servers = append(sim.servers, s)
+		sim.byName[s.metadata.NamespacedName] = s
+	}
+	sim.datastore = newFleetDatastore(sim.servers)
+
+	rawConfig, featureGates, err := loader.LoadRawConfig(configuration.Text, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse configuration %q - %w", configuration.Name, err)
+	}
+	handle := fwkplugin.NewEppHandle(ctx, sim.endpointNames)
+	cfg, err := loader.InstantiateAndConfigure(rawConfig, handle, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration %q - %w", configuration.Name, err)
+	}
+
+	// The request control plugins are set up as the EPP does, with the producers of data running
+	// before its consumers.
+	requestControlConfig := requestcontrol.NewConfig()
+	requestControlConfig.AddPlugins(handle.GetAllPlugins()...)
+	order, err := datalayer.ValidateAndOrderDataDependencies(handle.GetAllPlugins())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration %q - %w", configuration.Name, err)
+	}
+	if !featureGates[datalayer.PrepareDataPluginsFeatureGate] {
+		requestControlConfig.WithPrepareDataPlugins()
+	}
+	requestControlConfig.OrderPrepareDataPlugins(order)
+	requestControlConfig.WithPrepareDataConfig(cfg.PrepareDataConfig)
+	for _, plugin := range handle.GetAllPlugins() {
+		if consumer, ok := plugin.(fwkdl.EndpointListConsumer); ok {
+			consumer.SetEndpointList(func() []fwkdl.Endpoint {
+				return sim.datastore.PodList(datastore.AllPodsPredicate)
+			})
+		}
+	}
+
+	sim.scheduler = &trackingScheduler{Scheduler: scheduling.NewSchedulerWithConfig(cfg.SchedulerConfig)}
+	locator := requestcontrol.NewDatastorePodLocator(sim.datastore)
+	admissionController := requestcontrol.NewLegacyAdmissionController(
+		utilizationdetector.NewDetector(cfg.SaturationDetectorConfig, logger), locator)
+	sim.director = requestcontrol.NewDirectorWithConfig(sim.datastore, sim.scheduler, admissionController,
+		handlers.NewParser(cfg.ParserConfig), locator, requestControlConfig)
+
+	for i := range trace {
+		record := &trace[i]
+		sim.advance(record.arrival())
+		sim.dispatch(record)
+	}
+	sim.advance(time.Duration(math.MaxInt64))
+
+	return newReport(configuration.Name, sim.results, sim.servers), nil
+}
+
+// endpointNames lists the simulated model servers for the plugin handle.
+func (sim *simulation) endpointNames() []k8stypes.NamespacedName {
+	names := make([]k8stypes.NamespacedName, 0, len(sim.servers))
+	for _, s := range sim.servers {
+		names = append(names, s.metadata.NamespacedName)
+	}
+	return names
+}
+
+// advance runs the steps of the model servers starting before the given time, in time order, so
+// that the plugins observe the responses in the order they complete.
+func (sim *simulation) advance(until time.Duration) {
+	for {
+		var next *server
+		for _, s := range sim.servers {
+			if s.busy() && s.clock < until && (next == nil || s.clock < next.clock) {
+				next = s
+			}
+		}
+		if next == nil {
+			return
+		}
+		next.step(sim)
+	}
+}
+
+// completionsBody is the body of the requests of the trace, sent to the completions API.
+type completionsBody struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+}
+
+// dispatch hands a request to the director, and sends it to the model server it picked.
+func (sim *simulation) dispatch(record *Record) {
+	headers := maps.Clone(record.Headers)
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	headers[reqcommon.RequestIdHeaderKey] = record.RequestID
+	if record.TTFTSLOMs > 0 {
+		headers[metadata.TTFTSLOKey] = strconv.FormatFloat(record.TTFTSLOMs, 'f', -1, 64)
+	}
+	if record.TPOTSLOMs > 0 {
+		headers[metadata.TPOTSLOKey] = strconv.FormatFloat(record.TPOTSLOMs, 'f', -1, 64)
+	}
+	// Marshaling a struct of strings does not fail.
+	body, _ := json.Marshal(completionsBody{Model: record.Model, Prompt: record.Prompt})
+	reqCtx := &handlers.RequestContext{
+		ObjectiveKey:             sim.datastore.objectiveKey(record.Priority),
+		RequestReceivedTimestamp: time.Now(),
+		Request: &handlers.Request{
+			Headers:  headers,
+			RawBody:  body,
+			Metadata: map[string]any{},
+		},
+		Response: &handlers.Response{Headers: map[string]string{}},
+	}
+
+	sim.scheduler.failed = false
+	reqCtx, err := sim.director.HandleRequest(sim.ctx, reqCtx)
+	if err != nil {
+		result := requestResult{record: record, outcome: outcomeRejected}
+		if sim.scheduler.failed {
+			result.outcome = outcomeUnscheduled
+		}
+		sim.logger.V(1).Info("The director did not route the request", "request", record.RequestID, "error", err)
+		sim.results = append(sim.results, result)
+		return
+	}
+	s, ok := sim.byName[reqCtx.TargetPod.NamespacedName]
+	if !ok {
+		sim.results = append(sim.results, requestResult{record: record, outcome: outcomeUnscheduled})
+		return
+	}
+	s.enqueue(&simRequest{record: record, reqCtx: reqCtx}, record.arrival())
+}
+
+// firstToken hands the response headers and its first chunk to the director.
+func (sim *simulation) firstToken(req *simRequest) {
+	reqCtx := req.reqCtx
+	reqCtx.Response.StatusCode = 200
+	if _, err := sim.director.HandleResponseReceived(sim.ctx, reqCtx); err != nil {
+		sim.logger.Error(err, "error in HandleResponseReceived", "request", req.record.RequestID)
+	}
+	reqCtx.FirstChunkTimestamp = time.Now()
+	if _, err := sim.director.HandleResponseBodyStreaming(sim.ctx, reqCtx); err != nil {
+		sim.logger.Error(err, "error in HandleResponseBodyStreaming", "request", req.record.RequestID)
+	}
+}
+
+// completed hands the end of the response to the director and records the result of the request.
+func (sim *simulation) completed(req *simRequest) {
+	reqCtx := req.reqCtx
+	reqCtx.ResponseComplete = true
+	reqCtx.ResponseCompleteTimestamp = time.Now()
+	reqCtx.Usage = fwkrc.Usage{
+		PromptTokens:       req.record.InputTokens,
+		CompletionTokens:   req.record.OutputTokens,
+		TotalTokens:        req.record.InputTokens + req.record.OutputTokens,
+		PromptTokenDetails: &fwkrc.PromptTokenDetails{CachedTokens: req.cachedTokens},
+	}
+	reqCtx.Termination = fwkrc.Termination{Reason: fwkrc.TerminationCompleted, StatusCode: reqCtx.Response.StatusCode}
+	if _, err := sim.director.HandleResponseBodyStreaming(sim.ctx, reqCtx); err != nil {
+		sim.logger.Error(err, "error in HandleResponseBodyStreaming", "request", req.record.RequestID)
+	}
+	if _, err := sim.director.HandleResponseBodyComplete(sim.ctx, reqCtx); err != nil {
+		sim.logger.Error(err, "error in HandleResponseBodyComplete", "request", req.record.RequestID)
+	}
+
+	arrival := req.record.arrival()
+	result := requestResult{
+		record:       req.record,
+		outcome:      outcomeCompleted,
+		ttft:         req.firstToken - arrival,
+		cachedTokens: req.cachedTokens,
+		finish:       req.finish,
+	}
+	if req.record.OutputTokens > 1 {
+		result.tpot = (req.finish - req.firstToken) / time.Duration(req.record.OutputTokens-1)
+	}
+	sim.results = append(sim.results, result)
+}
@@ -0,0 +1,307 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/config/loader"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/fairness"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/framework/plugins/ordering"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	fwkrc "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/openai"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/picker"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/profile"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/prefix"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/scheduling/scorer/queuedepth"
+)
+
+const (
+	testPluginType            = "replay-test-plugin"
+	testPrepareDataPluginType = "replay-test-prepare-data"
+)
+
+// testPlugin denies the requests with the x-reject header and counts the completed responses.
+type testPlugin struct {
+	name      string
+	completed *int
+}
+
+func (p *testPlugin) TypedName() fwkplugin.TypedName {
+	return fwkplugin.TypedName{Type: testPluginType, Name: p.name}
+}
+
+func (p *testPlugin) AdmitRequest(_ context.Context, request *fwksched.LLMRequest, _ []fwksched.Endpoint) error {
+	if request.Headers["x-reject"] != "" {
+		return errors.New("rejected")
+	}
+	return nil
+}
+
+func (p *testPlugin) ResponseComplete(_ context.Context, _ *fwksched.LLMRequest, _ *fwkrc.Response, termination fwkrc.Termination, _ *fwkdl.EndpointMetadata) {
+	if termination.Reason == fwkrc.TerminationCompleted {
+		*p.completed++
+	}
+}
+
+// testPrepareDataPlugin fails the PrepareData phase of the requests with the x-fail-prepare header.
+type testPrepareDataPlugin struct {
+	name string
+}
+
+func (p *testPrepareDataPlugin) TypedName() fwkplugin.TypedName {
+	return fwkplugin.TypedName{Type: testPrepareDataPluginType, Name: p.name}
+}
+
+func (p *testPrepareDataPlugin) PrepareRequestData(_ context.Context, request *fwksched.LLMRequest, _ []fwksched.Endpoint) error {
+	if request.Headers["x-fail-prepare"] != "" {
+		return errors.New("prepare failed")
+	}
+	return nil
+}
+
+func (p *testPrepareDataPlugin) Produces() map[string]any { return nil }
+
+func (p *testPrepareDataPlugin) Consumes() map[string]any { return nil }
+
+func registerTestPlugins(completed *int) {
+	fwkplugin.Register(prefix.PrefixCachePluginType, prefix.PrefixCachePluginFactory)
+	fwkplugin.Register(queuedepth.QueueScorerType, queuedepth.QueueScorerFactory)
+	fwkplugin.Register(picker.MaxScorePickerType, picker.MaxScorePickerFactory)
+	fwkplugin.Register(picker.RandomPickerType, picker.RandomPickerFactory)
+	fwkplugin.Register(profile.SingleProfileHandlerType, profile.SingleProfileHandlerFactory)
+	fwkplugin.Register(fairness.GlobalStrictFairnessPolicyType, fairness.GlobalStrictFairnessPolicyFactory)
+	fwkplugin.Register(ordering.FCFSOrderingPolicyType, ordering.FCFSOrderingPolicyFactory)
+	fwkplugin.Register(openai.OpenAIParserType, openai.OpenAIParserPluginFactory)
+	fwkplugin.Register(testPluginType, func(name string, _ json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+		return &testPlugin{name: name, completed: completed}, nil
+	})
+	fwkplugin.Register(testPrepareDataPluginType, func(name string, _ json.RawMessage, _ fwkplugin.Handle) (fwkplugin.Plugin, error) {
+		return &testPrepareDataPlugin{name: name}, nil
+	})
+}
+
+type recordingHooks struct {
+	firstTokens, completions []*simRequest
+}
+
+func (h *recordingHooks) firstToken(req *simRequest) { h.firstTokens = append(h.firstTokens, req) }
+func (h *recordingHooks) completed(req *simRequest)  { h.completions = append(h.completions, req) }
+
+func TestServerStep(t *testing.T) {
+	model := ServingModel{
+		Endpoints:            1,
+		MaxRunningRequests:   1,
+		KVCacheTokens:        1024,
+		BlockSize:            16,
+		StepTime:             10 * time.Millisecond,
+		DecodeTimePerRequest: time.Millisecond,
+		PrefillTimePerToken:  100 * time.Microsecond,
+	}
+	s := newServer(model, 0)
+	hooks := &recordingHooks{}
+	prompt := strings.Repeat("a", 64*charactersPerToken)
+	first := &Record{RequestID: "first", Prompt: prompt, InputTokens: 64, OutputTokens: 3}
+	second := &Record{RequestID: "second", Timestamp: 0.001, Prompt: prompt, InputTokens: 64, OutputTokens: 2}
+	s.enqueue(&simRequest{record: first}, first.arrival())
+	s.enqueue(&simRequest{record: second}, second.arrival())
+
+	for s.busy() {
+		s.step(hooks)
+	}
+
+	if len(hooks.completions) != 2 || len(hooks.firstTokens) != 2 {
+		t.Fatalf("got %d first tokens and %d completions, want 2 of each", len(hooks.firstTokens), len(hooks.completions))
+	}
+	// The first request is prefilled in a step, then decodes its two remaining tokens.
+	got := hooks.completions[0]
+	if want := 10*time.Millisecond + 64*100*time.Microsecond; got.firstToken != want {
+		t.Errorf("first token of the first request at %s, want %s", got.firstToken, want)
+	}
+	if want := got.firstToken + 2*11*time.Millisecond; got.finish != want {
+		t.Errorf("first request finished at %s, want %s", got.finish, want)
+	}
+	// The batch holds a single request, so the second one waits and then hits the prefix cache.
+	got = hooks.completions[1]
+	if got.cachedTokens != 64 {
+		t.Errorf("second request has %d cached tokens, want 64", got.cachedTokens)
+	}
+	if want := hooks.completions[0].finish + 10*time.Millisecond; got.firstToken != want {
+		t.Errorf("first token of the second request at %s, want %s", got.firstToken, want)
+	}
+	if s.usedKVTokens != 0 {
+		t.Errorf("%d KV cache tokens still in use after the requests completed", s.usedKVTokens)
+	}
+}
+
+const prefixAwareConfig = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: prefix-cache-scorer
+- type: queue-scorer
+- type: replay-test-plugin
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: prefix-cache-scorer
+    weight: 3
+  - pluginRef: queue-scorer
+`
+
+const randomConfig = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+plugins:
+- type: random-picker
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: random-picker
+`
+
+const prepareDataConfig = `
+apiVersion: inference.networking.x-k8s.io/v1alpha1
+kind: EndpointPickerConfig
+featureGates:
+- prepareDataPlugins
+plugins:
+- type: random-picker
+- type: replay-test-prepare-data
+schedulingProfiles:
+- name: default
+  plugins:
+  - pluginRef: random-picker
+prepareData:
+  plugins:
+  - pluginRef: replay-test-prepare-data
+    failurePolicy: %s
+`
+
+func TestRun(t *testing.T) {
+	var completed int
+	registerTestPlugins(&completed)
+
+	var trace strings.Builder
+	for i := range 400 {
+		fmt.Fprintf(&trace, `{"timestamp": %v, "model": "m", "prefix_group": "g%d", "prefix_tokens": 1024, "input_tokens": 1280, "output_tokens": 32, "ttft_slo_ms": 1000}`+"\n",
+			float64(i)*0.05, i%8)
+	}
+	trace.WriteString(`{"timestamp": 30, "prompt": "rejected", "output_tokens": 1, "headers": {"x-reject": "true"}}` + "\n")
+	records, err := ReadTrace(strings.NewReader(trace.String()))
+	if err != nil {
+		t.Fatalf("ReadTrace() returned an unexpected error: %v", err)
+	}
+
+	model := DefaultServingModel()
+	model.KVCacheTokens = 8192
+	prefixAware, err := Run(context.Background(), records, model, Configuration{Name: "prefix", Text: []byte(prefixAwareConfig)})
+	if err != nil {
+		t.Fatalf("Run() returned an unexpected error: %v", err)
+	}
+	random, err := Run(context.Background(), records, model, Configuration{Name: "random", Text: []byte(randomConfig)})
+	if err != nil {
+		t.Fatalf("Run() returned an unexpected error: %v", err)
+	}
+
+	if prefixAware.Configuration != "prefix" || prefixAware.Requests != 401 || prefixAware.Completed != 400 || prefixAware.Rejected != 1 {
+		t.Errorf("prefix report: %d requests, %d completed, %d rejected, want 401, 400 and 1",
+			prefixAware.Requests, prefixAware.Completed, prefixAware.Rejected)
+	}
+	if completed != 400 {
+		t.Errorf("ResponseComplete plugins observed %d completions, want 400", completed)
+	}
+	if random.Completed != 401 || random.Rejected != 0 {
+		t.Errorf("random report: %d completed, %d rejected, want 401 and 0", random.Completed, random.Rejected)
+	}
+	// Every endpoint only caches a couple of prefix groups, so routing by prefix pays off.
+	if prefixAware.PrefixHitRate <= random.PrefixHitRate {
+		t.Errorf("prefix hit rate of the prefix aware configuration %.2f is not above the random one %.2f",
+			prefixAware.PrefixHitRate, random.PrefixHitRate)
+	}
+	if prefixAware.SLORequests != 400 || prefixAware.SLOAttainment <= 0 || prefixAware.SLOAttainment > 1 {
+		t.Errorf("SLO attainment %.2f over %d requests, want a fraction over 400 requests", prefixAware.SLOAttainment, prefixAware.SLORequests)
+	}
+	if prefixAware.TTFT.P50 <= 0 || prefixAware.TTFT.P50 > prefixAware.TTFT.P99 || prefixAware.TPOT.P50 <= 0 {
+		t.Errorf("invalid latency distributions TTFT %+v TPOT %+v", prefixAware.TTFT, prefixAware.TPOT)
+	}
+	if prefixAware.MaxLoadRatio < 1 || prefixAware.LoadImbalance < 0 {
+		t.Errorf("invalid load imbalance %.2f and max load ratio %.2f", prefixAware.LoadImbalance, prefixAware.MaxLoadRatio)
+	}
+
+	var table strings.Builder
+	if err := WriteReports(&table, []*Report{prefixAware, random}); err != nil {
+		t.Fatalf("WriteReports() returned an unexpected error: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(table.String()), "\n"); len(lines) != 3 {
+		t.Errorf("the report table has %d lines, want a header and 2 rows:\n%s", len(lines), table.String())
+	}
+}
+
+func TestRunPrepareDataFailurePolicies(t *testing.T) {
+	var completed int
+	registerTestPlugins(&completed)
+	loader.RegisterFeatureGate(datalayer.PrepareDataPluginsFeatureGate)
+
+	records, err := ReadTrace(strings.NewReader(
+		`{"timestamp": 0, "prompt": "served", "output_tokens": 1}` + "\n" +
+			`{"timestamp": 1, "prompt": "failed", "output_tokens": 1, "headers": {"x-fail-prepare": "true"}}` + "\n"))
+	if err != nil {
+		t.Fatalf("ReadTrace() returned an unexpected error: %v", err)
+	}
+
+	for _, tc := range []struct {
+		policy       string
+		wantRejected int
+	}{
+		{policy: "Skip", wantRejected: 0},
+		{policy: "FailRequest", wantRejected: 1},
+	} {
+		t.Run(tc.policy, func(t *testing.T) {
+			config := fmt.Sprintf(prepareDataConfig, tc.policy)
+			report, err := Run(context.Background(), records, DefaultServingModel(), Configuration{Name: tc.policy, Text: []byte(config)})
+			if err != nil {
+				t.Fatalf("Run() returned an unexpected error: %v", err)
+			}
+			if report.Rejected != tc.wantRejected || report.Completed != 2-tc.wantRejected {
+				t.Errorf("%d completed and %d rejected, want %d and %d", report.Completed, report.Rejected, 2-tc.wantRejected, tc.wantRejected)
+			}
+		})
+	}
+}
+
+func TestRunInvalidConfiguration(t *testing.T) {
+	records := []Record{{RequestID: "r", Prompt: "hello", InputTokens: 1, OutputTokens: 1}}
+	if _, err := Run(context.Background(), records, DefaultServingModel(), Configuration{Name: "bad", Text: []byte("plugins: [")}); err == nil {
+		t.Error("Run() succeeded with a malformed configuration, want an error")
+	}
+	model := DefaultServingModel()
+	model.Endpoints = 0
+	if _, err := Run(context.Background(), records, model, Configuration{Name: "default"}); err == nil {
+		t.Error("Run() succeeded without endpoints, want an error")
+	}
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"text/tabwriter"
+	"time"
+)
+
+type outcome int
+
+const (
+	outcomeCompleted outcome = iota
+	// outcomeRejected marks a request the director did not schedule, such as a request denied by
+	// admission control or an admission plugin, or failed by a PrepareData plugin with the
+	// FailRequest failure policy.
+	outcomeRejected
+	// outcomeUnscheduled marks a request for which the scheduler found no endpoint.
+	outcomeUnscheduled
+)
+
+// requestResult is the result of the replay of a request.
+type requestResult struct {
+	record       *Record
+	outcome      outcome
+	ttft         time.Duration
+	tpot         time.Duration
+	cachedTokens int
+	finish       time.Duration
+}
+
+// metSLO returns whether a completed request met its latency objectives.
+func (r *requestResult) metSLO() bool {
+	if r.record.TTFTSLOMs > 0 && r.ttft > r.record.ttftSLO() {
+		return false
+	}
+	if r.record.TPOTSLOMs > 0 && r.record.OutputTokens > 1 && r.tpot > r.record.tpotSLO() {
+		return false
+	}
+	return true
+}
+
+// Distribution summarizes a latency distribution, in milliseconds.
+type Distribution struct {
+	Mean float64 `json:"mean"`
+	P50  float64 `json:"p50"`
+	P90  float64 `json:"p90"`
+	P99  float64 `json:"p99"`
+	Max  float64 `json:"max"`
+}
+
+// newDistribution summarizes the given latencies.
+func newDistribution(latencies []time.Duration) Distribution {
+	if len(latencies) == 0 {
+		return Distribution{}
+	}
+	slices.Sort(latencies)
+	var sum time.Duration
+	for _, latency := range latencies {
+		sum += latency
+	}
+	quantile := func(q float64) float64 {
+		index := int(math.Ceil(q*float64(len(latencies)))) - 1
+		return milliseconds(latencies[max(index, 0)])
+	}
+	return Distribution{
+		Mean: milliseconds(sum) / float64(len(latencies)),
+		P50:  quantile(0.5),
+		P90:  quantile(0.9),
+		P99:  quantile(0.99),
+		Max:  milliseconds(latencies[len(latencies)-1]),
+	}
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// Report is the result of the replay of a trace against a configuration.
+type Report struct {
+	// Configuration is the name of the replayed configuration.
+	Configuration string `json:"configuration"`
+	// Requests is the number of requests of the trace.
+	Requests int `json:"requests"`
+	// Completed is the number of requests served by the fleet.
+	Completed int `json:"completed"`
+	// Rejected is the number of requests denied by admission plugins or failed by PrepareData plugins.
+	Rejected int `json:"rejected"`
+	// Unscheduled is the number of requests for which the scheduler found no endpoint.
+	Unscheduled int `json:"unscheduled"`
+	// TTFT is the distribution of the time to first token of the completed requests.
+	TTFT Distribution `json:"ttftMs"`
+	// TPOT is the distribution of the time per output token of the completed requests.
+	TPOT Distribution `json:"tpotMs"`
+	// PrefixHitRate is the fraction of the prompt tokens of the completed requests found in the
+	// prefix cache of their model server.
+	PrefixHitRate float64 `json:"prefixHitRate"`
+	// LoadImbalance is the coefficient of variation of the tokens sent to each model server. It is
+	// zero when the load is perfectly balanced.
+	LoadImbalance float64 `json:"loadImbalance"`
+	// MaxLoadRatio is the ratio of the tokens sent to the busiest model server to the mean.
+	MaxLoadRatio float64 `json:"maxLoadRatio"`
+	// SLORequests is the number of requests with latency objectives.
+	SLORequests int `json:"sloRequests"`
+	// SLOAttainment is the fraction of the requests with latency objectives completed within them.
+	SLOAttainment float64 `json:"sloAttainment"`
+	// Makespan is the simulated time until the last request completed.
+	Makespan time.Duration `json:"makespan"`
+}
+
+// newReport aggregates the results of the requests of a replay.
+func newReport(configuration string, results []requestResult, servers []*server) *Report {
+	report := &Report{Configuration: configuration, Requests: len(results)}
+	var ttfts, tpots []time.Duration
+	var inputTokens, cachedTokens, metSLO int
+	for i := range results {
+		result := &results[i]
+		if result.record.hasSLO() {
+			report.SLORequests++
+		}
+		switch result.outcome {
+		case outcomeRejected:
+			report.Rejected++
+			continue
+		case outcomeUnscheduled:
+			report.Unscheduled++
+			continue
+		}
+		report.Completed++
+		ttfts = append(ttfts, result.ttft)
+		if result.record.OutputTokens > 1 {
+			tpots = append(tpots, result.tpot)
+		}
+		inputTokens += result.record.InputTokens
+		cachedTokens += result.cachedTokens
+		if result.record.hasSLO() && result.metSLO() {
+			metSLO++
+		}
+		report.Makespan = max(report.Makespan, result.finish)
+	}
+	report.TTFT = newDistribution(ttfts)
+	report.TPOT = newDistribution(tpots)
+	if inputTokens > 0 {
+		report.PrefixHitRate = float64(cachedTokens) / float64(inputTokens)
+	}
+	if report.SLORequests > 0 {
+		report.SLOAttainment = float64(metSLO) / float64(report.SLORequests)
+	}
+
+	var sum, busiest float64
+	for _, s := range servers {
+		sum += float64(s.servedTokens)
+		busiest = max(busiest, float64(s.servedTokens))
+	}
+	if mean := sum / float64(len(servers)); mean > 0 {
+		var variance float64
+		for _, s := range servers {
+			variance += math.Pow(float64(s.servedTokens)-mean, 2)
+		}
+		report.LoadImbalance = math.Sqrt(variance/float64(len(servers))) / mean
+		report.MaxLoadRatio = busiest / mean
+	}
+	return report
+}
+
+// WriteReports writes the reports as a table, one configuration per row.
+func WriteReports(writer io.Writer, reports []*Report) error {
+	w := tabwriter.NewWriter(writer, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "CONFIGURATION\tREQUESTS\tCOMPLETED\tREJECTED\tUNSCHEDULED\t"+
+		"TTFT P50\tTTFT P90\tTTFT P99\tTPOT P50\tTPOT P90\tTPOT P99\t"+
+		"PREFIX HIT\tLOAD CV\tMAX/MEAN LOAD\tSLO ATTAINMENT\tMAKESPAN\t")
+	for _, r := range reports {
+		slo := "-"
+		if r.SLORequests > 0 {
+			slo = fmt.Sprintf("%.1f%%", 100*r.SLOAttainment)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%.1fms\t%.1fms\t%.1fms\t%.2fms\t%.2fms\t%.2fms\t%.1f%%\t%.3f\t%.2f\t%s\t%s\t\n",
+			r.Configuration, r.Requests, r.Completed, r.Rejected, r.Unscheduled,
+			r.TTFT.P50, r.TTFT.P90, r.TTFT.P99, r.TPOT.P50, r.TPOT.P90, r.TPOT.P99,
+			100*r.PrefixHitRate, r.LoadImbalance, r.MaxLoadRatio, slo, r.Makespan.Round(time.Millisecond))
+	}
+	return w.Flush()
+}
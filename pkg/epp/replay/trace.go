@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replay replays a trace of requests through the director of the EPP, with the scheduler and
+// the request control plugins of an EPP configuration, against a simulated fleet of model servers, to
+// compare configurations offline before deploying them.
+package replay
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// charactersPerToken approximates the number of prompt characters per token, as the prefix cache
+	// plugin does.
+	charactersPerToken = 4
+	// maxTraceLineSize bounds the size of a single trace record.
+	maxTraceLineSize = 64 * 1024 * 1024
+)
+
+// Record is a request of a trace, read from a line of a JSONL file.
+type Record struct {
+	// Timestamp is the arrival time of the request, in seconds since the start of the trace.
+	Timestamp float64 `json:"timestamp"`
+	// RequestID identifies the request. It defaults to the line number of the record.
+	RequestID string `json:"request_id,omitempty"`
+	// Model is the target model of the request.
+	Model string `json:"model,omitempty"`
+	// Prompt is the prompt of the request. A record without a prompt gets a synthetic one of
+	// InputTokens tokens, starting with the shared prefix of its PrefixGroup if any.
+	Prompt string `json:"prompt,omitempty"`
+	// PrefixGroup names the shared prefix of the synthetic prompt of the request.
+	PrefixGroup string `json:"prefix_group,omitempty"`
+	// PrefixTokens is the length in tokens of the shared prefix of the synthetic prompt.
+	PrefixTokens int `json:"prefix_tokens,omitempty"`
+	// InputTokens is the number of prompt tokens. It defaults to an estimate from the prompt length.
+	InputTokens int `json:"input_tokens,omitempty"`
+	// OutputTokens is the number of tokens generated for the request.
+	OutputTokens int `json:"output_tokens"`
+	// Headers are the request headers.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Priority is the priority of the request objective.
+	Priority int `json:"priority,omitempty"`
+	// TTFTSLOMs is the time to first token objective of the request in milliseconds, or zero if it has none.
+	TTFTSLOMs float64 `json:"ttft_slo_ms,omitempty"`
+	// TPOTSLOMs is the time per output token objective of the request in milliseconds, or zero if it has none.
+	TPOTSLOMs float64 `json:"tpot_slo_ms,omitempty"`
+}
+
+// arrival returns the arrival time of the request since the start of the trace.
+func (r *Record) arrival() time.Duration {
+	return time.Duration(r.Timestamp * float64(time.Second))
+}
+
+// ttftSLO returns the time to first token objective of the request.
+func (r *Record) ttftSLO() time.Duration {
+	return time.Duration(r.TTFTSLOMs * float64(time.Millisecond))
+}
+
+// tpotSLO returns the time per output token objective of the request.
+func (r *Record) tpotSLO() time.Duration {
+	return time.Duration(r.TPOTSLOMs * float64(time.Millisecond))
+}
+
+// hasSLO returns whether the request has a latency objective.
+func (r *Record) hasSLO() bool {
+	return r.TTFTSLOMs > 0 || r.TPOTSLOMs > 0
+}
+
+// complete validates the record and fills in its defaults.
+func (r *Record) complete(line int) error {
+	if r.Timestamp < 0 {
+		return fmt.Errorf("negative timestamp %v", r.Timestamp)
+	}
+	if r.OutputTokens <= 0 {
+		return fmt.Errorf("output_tokens must be positive, got %d", r.OutputTokens)
+	}
+	if r.InputTokens < 0 || r.PrefixTokens < 0 || r.TTFTSLOMs < 0 || r.TPOTSLOMs < 0 {
+		return errors.New("token counts and objectives must not be negative")
+	}
+	if r.RequestID == "" {
+		r.RequestID = fmt.Sprintf("request-%d", line)
+	}
+	if r.Prompt == "" {
+		if r.InputTokens == 0 {
+			return errors.New("a record needs a prompt or input_tokens")
+		}
+		r.Prompt = syntheticPrompt(r.RequestID, r.PrefixGroup, r.PrefixTokens, r.InputTokens)
+	}
+	if r.InputTokens == 0 {
+		r.InputTokens = max(1, len(r.Prompt)/charactersPerToken)
+	}
+	return nil
+}
+
+// syntheticPrompt generates a prompt of the given number of tokens, starting with the shared prefix
+// of the given group and continuing with text unique to the request.
+func syntheticPrompt(requestID, group string, prefixTokens, tokens int) string {
+	var sb strings.Builder
+	prefixTokens = min(prefixTokens, tokens)
+	if group != "" {
+		writeFiller(&sb, "prefix "+group+" ", prefixTokens*charactersPerToken)
+	} else {
+		prefixTokens = 0
+	}
+	writeFiller(&sb, "request "+requestID+" ", (tokens-prefixTokens)*charactersPerToken)
+	return sb.String()
+}
+
+// writeFiller writes n characters repeating the given seed.
+func writeFiller(sb *strings.Builder, seed string, n int) {
+	for n > 0 {
+		chunk := seed[:min(len(seed), n)]
+		sb.WriteString(chunk)
+		n -= len(chunk)
+	}
+}
+
+// ReadTrace reads a JSONL trace, one Record per line, and returns its records ordered by arrival time.
+// Empty lines are skipped.
+func ReadTrace(reader io.Reader) ([]Record, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxTraceLineSize)
+	var records []Record
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var record Record
+		if err := json.Unmarshal([]byte(text), &record); err != nil {
+			return nil, fmt.Errorf("failed to parse trace line %d - %w", line, err)
+		}
+		if err := record.complete(line); err != nil {
+			return nil, fmt.Errorf("invalid trace line %d - %w", line, err)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read trace - %w", err)
+	}
+	if len(records) == 0 {
+		return nil, errors.New("the trace has no records")
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Timestamp < records[j].Timestamp
+	})
+	return records, nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadTrace(t *testing.T) {
+	trace := `{"timestamp": 1.5, "request_id": "b", "model": "m", "prompt": "` + strings.Repeat("x", 400) + `", "output_tokens": 10}
+
+{"timestamp": 0.5, "prefix_group": "system", "prefix_tokens": 50, "input_tokens": 80, "output_tokens": 5, "ttft_slo_ms": 200}
+`
+	records, err := ReadTrace(strings.NewReader(trace))
+	if err != nil {
+		t.Fatalf("ReadTrace() returned an unexpected error: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("ReadTrace() returned %d records, want 2", len(records))
+	}
+
+	synthetic, given := records[0], records[1]
+	if synthetic.RequestID != "request-3" {
+		t.Errorf("RequestID = %q, want the line number default request-3", synthetic.RequestID)
+	}
+	if len(synthetic.Prompt) != 80*charactersPerToken || !strings.HasPrefix(synthetic.Prompt, "prefix system ") {
+		t.Errorf("synthetic prompt %q does not have 80 tokens starting with the shared prefix", synthetic.Prompt)
+	}
+	if !synthetic.hasSLO() || synthetic.ttftSLO().Milliseconds() != 200 {
+		t.Errorf("TTFT objective = %s, want 200ms", synthetic.ttftSLO())
+	}
+	if given.RequestID != "b" || given.InputTokens != 100 {
+		t.Errorf("record b has InputTokens = %d, want 100 estimated from its prompt", given.InputTokens)
+	}
+
+	// Synthetic prompts of the same group share their prefix and differ afterwards.
+	first := syntheticPrompt("1", "system", 50, 80)
+	second := syntheticPrompt("2", "system", 50, 80)
+	prefix := 50 * charactersPerToken
+	if first[:prefix] != second[:prefix] || first[prefix:] == second[prefix:] {
+		t.Errorf("synthetic prompts of a group must share exactly their prefix:\n%q\n%q", first, second)
+	}
+}
+
+func TestReadTraceErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		trace string
+	}{
+		{name: "empty trace", trace: "\n\n"},
+		{name: "malformed record", trace: `{"timestamp": 0`},
+		{name: "no output tokens", trace: `{"timestamp": 0, "prompt": "hello"}`},
+		{name: "no prompt nor input tokens", trace: `{"timestamp": 0, "output_tokens": 3}`},
+		{name: "negative timestamp", trace: `{"timestamp": -1, "prompt": "hello", "output_tokens": 3}`},
+		{name: "negative objective", trace: `{"timestamp": 0, "prompt": "hello", "output_tokens": 3, "ttft_slo_ms": -5}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := ReadTrace(strings.NewReader(test.trace)); err == nil {
+				t.Error("ReadTrace() succeeded, want an error")
+			}
+		})
+	}
+}
@@ -22,12 +22,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"math/rand"
 	"net"
 	"strings"
 	"time"
 
 	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
@@ -44,13 +46,8 @@ import (
 	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
-)
-
-const (
-	// TODO(https://github.com/kubernetes-sigs/gateway-api-inference-extension/issues/2081):
-	// Make this timeout configurable per-plugin or globally via the Director configuration to support plugins with
-	// varying latency profiles.
-	prepareDataTimeout = 400 * time.Millisecond
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/objectivestats"
+	requtil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
 )
 
 // Datastore defines the interface required by the Director.
@@ -58,8 +55,9 @@ type Datastore interface {
 	PoolGet() (*datalayer.EndpointPool, error)
 	ObjectiveGet(objectiveName string) *v1alpha2.InferenceObjective
 	PodList(predicate func(backendmetrics.PodMetrics) bool) []backendmetrics.PodMetrics
-	// ModelRewriteGet returns the rewrite rule for a given model name and the name of the InferenceModelRewrite object.
-	ModelRewriteGet(modelName string) (*v1alpha2.InferenceModelRewriteRule, string)
+	// ModelRewriteGet returns the rewrite rule for a given model name and request headers and the name of the
+	// InferenceModelRewrite object.
+	ModelRewriteGet(modelName string, headers map[string]string) (*v1alpha2.InferenceModelRewriteRule, string)
 }
 
 // Scheduler defines the interface required by the Director for scheduling.
@@ -107,6 +105,22 @@ type Director struct {
 	// and value types cannot be nil
 	defaultPriority int
 	parser          fwkrh.Parser
+	spillover       *Spillover
+	objectiveStats  *objectivestats.Tracker
+}
+
+// WithSpillover enables spilling requests over to the fallback targets of their
+// InferenceModelRewrite rule when the pool of the Director is saturated.
+func (d *Director) WithSpillover(spillover *Spillover) *Director {
+	d.spillover = spillover
+	return d
+}
+
+// WithObjectiveStats enables recording the statistics of the requests of each InferenceObjective
+// in the given Tracker.
+func (d *Director) WithObjectiveStats(tracker *objectivestats.Tracker) *Director {
+	d.objectiveStats = tracker
+	return d
 }
 
 // getInferenceObjective fetches the inferenceObjective from the datastore otherwise creates a new one based on reqCtx.
@@ -129,16 +143,25 @@ func (d *Director) getInferenceObjective(ctx context.Context, reqCtx *handlers.R
 // HandleRequest orchestrates the request lifecycle.
 // It always returns the requestContext even in the error case, as the request context is used in error handling.
 func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestContext) (*handlers.RequestContext, error) {
+	llmRequestBody, err := parseRequestBody(ctx, reqCtx, d.parser)
+	if err != nil {
+		return reqCtx, err
+	}
+	return d.handleParsedRequest(ctx, reqCtx, llmRequestBody)
+}
+
+// handleParsedRequest orchestrates the lifecycle of a request whose body is already parsed.
+func (d *Director) handleParsedRequest(ctx context.Context, reqCtx *handlers.RequestContext, llmRequestBody *fwksched.LLMRequestBody) (*handlers.RequestContext, error) {
 	logger := log.FromContext(ctx)
 
-	// Parse, mutate, and extract the request body
-	llmRequestBody, err := d.processRequestBody(ctx, reqCtx, d.parser)
-	if err != nil {
+	// Mutate and extract the request body
+	if err := d.processRequestBody(reqCtx, llmRequestBody); err != nil {
 		return reqCtx, err
 	}
 
 	infObjective := d.getInferenceObjective(ctx, reqCtx)
-	requestObjectives := fwksched.RequestObjectives{Priority: *infObjective.Spec.Priority}
+	d.objectiveStats.RecordRequest(d.objectiveStatsKey(reqCtx))
+	requestObjectives := requestObjectives(ctx, infObjective, reqCtx.Request.Headers)
 
 	reqCtx.SchedulingRequest = &fwksched.LLMRequest{
 		RequestId:   reqCtx.Request.Headers[reqcommon.RequestIdHeaderKey],
@@ -152,8 +175,17 @@ func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestCo
 	ctx = log.IntoContext(ctx, logger)
 	logger.V(logutil.DEBUG).Info("LLM request assembled")
 
-	if err := d.admissionController.Admit(ctx, reqCtx, *infObjective.Spec.Priority); err != nil {
+	target := d.spill(ctx, reqCtx, llmRequestBody, *infObjective.Spec.Priority)
+	return target.admitAndSchedule(ctx, reqCtx, *infObjective.Spec.Priority)
+}
+
+// admitAndSchedule runs admission control and schedules the request on the candidate pods of the
+// Director.
+func (d *Director) admitAndSchedule(ctx context.Context, reqCtx *handlers.RequestContext, priority int) (*handlers.RequestContext, error) {
+	logger := log.FromContext(ctx)
+	if err := d.admissionController.Admit(ctx, reqCtx, priority); err != nil {
 		logger.V(logutil.DEFAULT).Info("Request rejected by admission control", "error", err)
+		d.recordRejection(reqCtx, err)
 		return reqCtx, err
 	}
 	candidatePods := d.podLocator.Locate(ctx, reqCtx.Request.Metadata)
@@ -166,10 +198,11 @@ func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestCo
 	snapshotOfCandidatePods := d.toSchedulerPodMetrics(candidatePods)
 
 	// Prepare per request data by running PrepareData plugins.
-	err = d.runPrepareDataPlugins(ctx, reqCtx.SchedulingRequest, snapshotOfCandidatePods)
-	if err != nil {
-		// Don't fail the request if PrepareData plugins fail.
+	// The failures of the plugins are handled according to their failure policies, and only fail the request when
+	// the policy of a failed plugin says so.
+	if err := d.runPrepareDataPlugins(ctx, reqCtx.SchedulingRequest, snapshotOfCandidatePods); err != nil {
 		logger.V(logutil.DEFAULT).Error(err, "failed to prepare per request data")
+		return reqCtx, errcommon.Error{Code: errcommon.Internal, Msg: fmt.Errorf("failed to prepare per request data: %w", err).Error()}
 	}
 
 	// Run admit request plugins
@@ -194,45 +227,98 @@ func (d *Director) HandleRequest(ctx context.Context, reqCtx *handlers.RequestCo
 	return reqCtx, nil
 }
 
-func (d *Director) processRequestBody(ctx context.Context, reqCtx *handlers.RequestContext, parser fwkrh.Parser) (*fwksched.LLMRequestBody, error) {
-	llmRequestBody, err := parser.ParseRequest(ctx, reqCtx.Request.RawBody, reqCtx.Request.Headers)
-	if err != nil {
-		return nil, errcommon.Error{Code: errcommon.BadRequest, Msg: err.Error()}
+// spill moves the request to the first usable fallback of its InferenceModelRewrite rule when the
+// pool of the Director is saturated and the priority of the request is eligible for spillover. It
+// returns the Director that serves the request, whose admission control still applies, including
+// to a fallback that keeps the request in the saturated pool.
+func (d *Director) spill(ctx context.Context, reqCtx *handlers.RequestContext, body *fwksched.LLMRequestBody, priority int) *Director {
+	if d.spillover == nil || !d.spillover.eligible(priority) {
+		return d
+	}
+	rule, _ := d.datastore.ModelRewriteGet(reqCtx.IncomingModelName, reqCtx.Request.Headers)
+	if rule == nil || len(rule.Fallbacks) == 0 {
+		return d
+	}
+	bodyMap, ok := body.ParsedBody.(map[string]any)
+	if !ok {
+		return d
+	}
+	if !d.spillover.saturated(ctx, d, reqCtx.Request.Metadata) {
+		return d
 	}
 
-	switch v := llmRequestBody.ParsedBody.(type) {
-	case proto.Message:
-		// Protos are not currently mutated, return as-is.
-		reqCtx.RequestSize = len(reqCtx.Request.RawBody)
-	case map[string]any:
-		if err := d.mutateAndRepackage(ctx, reqCtx, v); err != nil {
-			return nil, err
+	logger := log.FromContext(ctx)
+	source := d.poolName()
+	for _, fallback := range rule.Fallbacks {
+		target, targetPool := d, source
+		if fallback.PoolRef != nil && string(fallback.PoolRef.Name) != source.Name {
+			targetPool = types.NamespacedName{Namespace: source.Namespace, Name: string(fallback.PoolRef.Name)}
+			var found bool
+			// The InferenceModelRewrite reconciler drops the fallbacks to pools that are not served, and
+			// reports them in the status of the rewrite.
+			if target, found = d.spillover.pools[targetPool]; !found {
+				continue
+			}
+			if d.spillover.saturated(ctx, target, reqCtx.Request.Metadata) {
+				logger.V(logutil.DEBUG).Info("Skipping saturated fallback pool", "fallbackPool", targetPool)
+				continue
+			}
 		}
-	default:
-		return nil, errcommon.Error{Code: errcommon.BadRequest, Msg: "Unsupported llmRequest parsedBody"}
+
+		model := reqCtx.TargetModelName
+		if fallback.ModelRewrite != "" {
+			model = fallback.ModelRewrite
+		}
+		d.rewriteTargetModel(reqCtx, bodyMap, model)
+		if target != d {
+			reqCtx.Pool = targetPool
+		}
+		metrics.RecordInferencePoolSpillover(source.Name, targetPool.Name, reqCtx.IncomingModelName, model)
+		logger.V(logutil.DEBUG).Info("Request spilled over", "fallbackPool", targetPool, "fallbackModel", model)
+		return target
 	}
-	return llmRequestBody, nil
+	return d
 }
 
-func (d *Director) mutateAndRepackage(ctx context.Context, reqCtx *handlers.RequestContext, bodyMap map[string]any) error {
-	logger := log.FromContext(ctx)
+// rewriteTargetModel replaces the target model of an already processed request body.
+func (d *Director) rewriteTargetModel(reqCtx *handlers.RequestContext, bodyMap map[string]any, model string) {
+	bodyMap["model"] = model
+	reqCtx.BodyMutated = true
+	reqCtx.TargetModelName = model
+	reqCtx.SchedulingRequest.TargetModel = model
+}
 
-	// Mutate the model name inside the map
-	_, err := d.mutateModel(reqCtx, bodyMap)
-	if err != nil {
-		return err
+// poolName returns the name of the pool of the Director, or an empty name if it is not set yet.
+func (d *Director) poolName() types.NamespacedName {
+	pool, err := d.datastore.PoolGet()
+	if err != nil || pool == nil {
+		return types.NamespacedName{}
 	}
+	return types.NamespacedName{Namespace: pool.Namespace, Name: pool.Name}
+}
 
-	// Marshal back to bytes so downstream ExtProc filters see the updated model
-	requestBodyBytes, err := json.Marshal(bodyMap)
+// parseRequestBody parses the raw body of the request. Parse errors are bad requests.
+func parseRequestBody(ctx context.Context, reqCtx *handlers.RequestContext, parser fwkrh.Parser) (*fwksched.LLMRequestBody, error) {
+	llmRequestBody, err := parser.ParseRequest(ctx, reqCtx.Request.RawBody, reqCtx.Request.Headers)
 	if err != nil {
-		logger.V(logutil.DEFAULT).Error(err, "Error marshalling request body")
-		return errcommon.Error{Code: errcommon.Internal, Msg: "Error marshalling request body"}
+		return nil, errcommon.Error{Code: errcommon.BadRequest, Msg: err.Error()}
 	}
+	return llmRequestBody, nil
+}
 
-	reqCtx.Request.RawBody = requestBodyBytes
-	reqCtx.RequestSize = len(requestBodyBytes)
-
+func (d *Director) processRequestBody(reqCtx *handlers.RequestContext, llmRequestBody *fwksched.LLMRequestBody) error {
+	// The request size is updated by serializeBody if the body is mutated.
+	reqCtx.RequestSize = len(reqCtx.Request.RawBody)
+	switch v := llmRequestBody.ParsedBody.(type) {
+	case proto.Message:
+		// Protos are not currently mutated, return as-is.
+	case map[string]any:
+		if _, err := d.mutateModel(reqCtx, v); err != nil {
+			return err
+		}
+	default:
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: "Unsupported llmRequest parsedBody"}
+	}
 	return nil
 }
 
@@ -247,20 +333,56 @@ func (d *Director) mutateModel(reqCtx *handlers.RequestContext, bodyMap map[stri
 		reqCtx.TargetModelName = reqCtx.IncomingModelName
 	}
 	d.applyWeightedModelRewrite(reqCtx)
-	bodyMap["model"] = reqCtx.TargetModelName
+	if reqCtx.TargetModelName != reqCtx.IncomingModelName {
+		bodyMap["model"] = reqCtx.TargetModelName
+		reqCtx.BodyMutated = true
+	}
 	return reqCtx, nil
 }
 
 func (d *Director) applyWeightedModelRewrite(reqCtx *handlers.RequestContext) {
-	rewriteRule, modelRewriteName := d.datastore.ModelRewriteGet(reqCtx.IncomingModelName)
+	var headers map[string]string
+	if reqCtx.Request != nil {
+		headers = reqCtx.Request.Headers
+	}
+	rewriteRule, modelRewriteName := d.datastore.ModelRewriteGet(reqCtx.IncomingModelName, headers)
 	if rewriteRule == nil {
 		return
 	}
-	reqCtx.TargetModelName = d.selectWeightedModel(rewriteRule.Targets)
+	if key, ok := stickyKey(rewriteRule, headers); ok {
+		reqCtx.TargetModelName = d.selectStickyModel(rewriteRule.Targets, modelRewriteName+"/"+key)
+	} else {
+		reqCtx.TargetModelName = d.selectWeightedModel(rewriteRule.Targets)
+	}
 	metrics.RecordInferenceModelRewriteDecision(modelRewriteName, reqCtx.IncomingModelName, reqCtx.TargetModelName)
 }
 
+// stickyKey returns the value of the sticky split header of the rule, if the rule has a sticky
+// split and the request carries the header.
+func stickyKey(rule *v1alpha2.InferenceModelRewriteRule, headers map[string]string) (string, bool) {
+	if rule.StickySplit == nil {
+		return "", false
+	}
+	return requtil.HeaderValue(headers, rule.StickySplit.HeaderName)
+}
+
 func (d *Director) selectWeightedModel(models []v1alpha2.TargetModel) string {
+	return pickWeightedModel(models, rand.Intn)
+}
+
+// selectStickyModel selects a target model by weight from the hash of the key, so that requests
+// with the same key are always rewritten to the same model, across requests and replicas.
+func (d *Director) selectStickyModel(models []v1alpha2.TargetModel, key string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	sum := h.Sum64()
+	return pickWeightedModel(models, func(n int) int {
+		return int(sum % uint64(n))
+	})
+}
+
+// pickWeightedModel selects a target model by weight, using pick to draw a number in [0, n).
+func pickWeightedModel(models []v1alpha2.TargetModel, pick func(n int) int) string {
 	if len(models) == 0 {
 		return ""
 	}
@@ -272,10 +394,10 @@ func (d *Director) selectWeightedModel(models []v1alpha2.TargetModel) string {
 
 	if totalWeight == 0 {
 		// If total weight is 0, distribute evenly
-		return models[rand.Intn(len(models))].ModelRewrite
+		return models[pick(len(models))].ModelRewrite
 	}
 
-	randomNum := rand.Intn(int(totalWeight))
+	randomNum := pick(int(totalWeight))
 	var currentWeight int32
 	for _, model := range models {
 		currentWeight += model.Weight
@@ -307,6 +429,15 @@ func (d *Director) prepareRequest(ctx context.Context, reqCtx *handlers.RequestC
 	}
 
 	multiEndpointString := strings.Join(targetEndpoints, ",")
+
+	// The body is mutated before the target pod is set, so that a failed mutation does not run the completion
+	// hooks of a request which was never dispatched.
+	if err := d.runRequestBodyMutationPlugins(ctx, reqCtx, result); err != nil {
+		return reqCtx, err
+	}
+	if err := serializeBody(ctx, reqCtx); err != nil {
+		return reqCtx, err
+	}
 	logger.V(logutil.VERBOSE).Info("Request handled", "objectiveKey", reqCtx.ObjectiveKey, "incomingModelName", reqCtx.IncomingModelName, "targetModel", reqCtx.TargetModelName, "endpoint", multiEndpointString)
 
 	reqCtx.TargetPod = targetMetadatas[0]
@@ -355,7 +486,9 @@ func (d *Director) HandleResponseBodyStreaming(ctx context.Context, reqCtx *hand
 	return reqCtx, nil
 }
 
-// HandleResponseBodyComplete is called when the response body is fully received.
+// HandleResponseBodyComplete is called when the response body is fully received, or when the request terminates
+// before its response completed. The termination of the request context is passed to the plugins, and defaults to
+// completed when unset.
 func (d *Director) HandleResponseBodyComplete(ctx context.Context, reqCtx *handlers.RequestContext) (*handlers.RequestContext, error) {
 	logger := log.FromContext(ctx).WithValues("stage", "bodyChunk")
 	logger.V(logutil.DEBUG).Info("Entering HandleResponseBodyComplete")
@@ -365,15 +498,41 @@ func (d *Director) HandleResponseBodyComplete(ctx context.Context, reqCtx *handl
 		DynamicMetadata: reqCtx.Response.DynamicMetadata,
 		Usage:           reqCtx.Usage,
 	}
+	termination := reqCtx.Termination
+	if termination.Reason == "" {
+		termination.Reason = fwk.TerminationCompleted
+	}
+	logger.V(logutil.DEBUG).Info("Request terminated", "reason", termination.Reason, "statusCode", termination.StatusCode,
+		"error", termination.Err)
 
-	d.runResponseCompletePlugins(ctx, reqCtx.SchedulingRequest, response, reqCtx.TargetPod)
+	d.runResponseCompletePlugins(ctx, reqCtx.SchedulingRequest, response, termination, reqCtx.TargetPod)
+	d.recordCompletion(reqCtx)
 
 	logger.V(logutil.DEBUG).Info("Exiting HandleResponseBodyComplete")
 	return reqCtx, nil
 }
 
+// servesModel reports whether the pool of the Director is known to serve the model, either
+// because an InferenceModelRewrite of the pool matches it or because one of its endpoints
+// reports it as an active or waiting model.
+func (d *Director) servesModel(model string, headers map[string]string) bool {
+	if rule, _ := d.datastore.ModelRewriteGet(model, headers); rule != nil {
+		return true
+	}
+	pods := d.datastore.PodList(func(pm backendmetrics.PodMetrics) bool {
+		m := pm.GetMetrics()
+		if m == nil {
+			return false
+		}
+		_, active := m.ActiveModels[model]
+		_, waiting := m.WaitingModels[model]
+		return active || waiting
+	})
+	return len(pods) > 0
+}
+
 func (d *Director) GetRandomEndpoint() *fwkdl.EndpointMetadata {
-	pods := d.datastore.PodList(datastore.AllPodsPredicate)
+	pods := d.datastore.PodList(datastore.SchedulablePodsPredicate)
 	if len(pods) == 0 {
 		return nil
 	}
@@ -382,6 +541,52 @@ func (d *Director) GetRandomEndpoint() *fwkdl.EndpointMetadata {
 	return pod.GetMetadata()
 }
 
+// runRequestBodyMutationPlugins lets the RequestBodyMutation plugins mutate the parsed body of the request, which is
+// serialized afterwards by serializeBody.
+func (d *Director) runRequestBodyMutationPlugins(ctx context.Context, reqCtx *handlers.RequestContext,
+	schedulingResult *fwksched.SchedulingResult) error {
+	if len(d.requestControlPlugins.requestBodyMutationPlugins) == 0 || reqCtx.SchedulingRequest.Body == nil {
+		return nil
+	}
+	bodyMap, ok := reqCtx.SchedulingRequest.Body.ParsedBody.(map[string]any)
+	if !ok {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+	loggerDebug := logger.V(logutil.DEBUG)
+	for _, plugin := range d.requestControlPlugins.requestBodyMutationPlugins {
+		loggerDebug.Info("Running RequestBodyMutation plugin", "plugin", plugin.TypedName())
+		before := time.Now()
+		err := plugin.MutateRequestBody(ctx, reqCtx.SchedulingRequest, schedulingResult, bodyMap)
+		metrics.RecordPluginProcessingLatency(fwk.RequestBodyMutationExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, time.Since(before))
+		if err != nil {
+			logger.V(logutil.DEFAULT).Error(err, "Failed to mutate the request body", "plugin", plugin.TypedName())
+			return errcommon.Error{Code: errcommon.Internal, Msg: fmt.Errorf("failed to mutate the request body: %w", err).Error()}
+		}
+		loggerDebug.Info("Completed running RequestBodyMutation plugin successfully", "plugin", plugin.TypedName())
+	}
+	reqCtx.BodyMutated = true
+	return nil
+}
+
+// serializeBody marshals the parsed body of the request back into its raw body if it was mutated, so that the
+// downstream ExtProc filters and the model server see the mutations, and updates the request size, from which the
+// Content-Length header is set. The body is serialized once, after all the mutations.
+func serializeBody(ctx context.Context, reqCtx *handlers.RequestContext) error {
+	if !reqCtx.BodyMutated || reqCtx.SchedulingRequest == nil || reqCtx.SchedulingRequest.Body == nil {
+		return nil
+	}
+	requestBodyBytes, err := json.Marshal(reqCtx.SchedulingRequest.Body.ParsedBody)
+	if err != nil {
+		log.FromContext(ctx).V(logutil.DEFAULT).Error(err, "Error marshalling request body")
+		return errcommon.Error{Code: errcommon.Internal, Msg: "Error marshalling request body"}
+	}
+	reqCtx.Request.RawBody = requestBodyBytes
+	reqCtx.RequestSize = len(requestBodyBytes)
+	reqCtx.BodyMutated = false
+	return nil
+}
+
 func (d *Director) runPreRequestPlugins(ctx context.Context, request *fwksched.LLMRequest,
 	schedulingResult *fwksched.SchedulingResult) {
 	loggerDebug := log.FromContext(ctx).V(logutil.DEBUG)
@@ -399,7 +604,7 @@ func (d *Director) runPrepareDataPlugins(ctx context.Context,
 	if len(d.requestControlPlugins.prepareDataPlugins) == 0 {
 		return nil
 	}
-	return prepareDataPluginsWithTimeout(prepareDataTimeout, d.requestControlPlugins.prepareDataPlugins, ctx, request, endpoints)
+	return PrepareDataPluginsWithTimeout(d.requestControlPlugins.prepareDataConfig, d.requestControlPlugins.prepareDataPlugins, ctx, request, endpoints)
 }
 
 func (d *Director) runAdmissionPlugins(ctx context.Context,
@@ -438,12 +643,13 @@ func (d *Director) runResponseStreamingPlugins(ctx context.Context, request *fwk
 	}
 }
 
-func (d *Director) runResponseCompletePlugins(ctx context.Context, request *fwksched.LLMRequest, response *fwk.Response, targetEndpoint *fwkdl.EndpointMetadata) {
+func (d *Director) runResponseCompletePlugins(ctx context.Context, request *fwksched.LLMRequest, response *fwk.Response,
+	termination fwk.Termination, targetEndpoint *fwkdl.EndpointMetadata) {
 	loggerDebug := log.FromContext(ctx).V(logutil.DEBUG)
 	for _, plugin := range d.requestControlPlugins.responseCompletePlugins {
 		loggerDebug.Info("Running ResponseComplete plugin", "plugin", plugin.TypedName())
 		before := time.Now()
-		plugin.ResponseComplete(ctx, request, response, targetEndpoint)
+		plugin.ResponseComplete(ctx, request, response, termination, targetEndpoint)
 		metrics.RecordPluginProcessingLatency(fwk.ResponseCompleteExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, time.Since(before))
 		loggerDebug.Info("Completed running ResponseComplete plugin successfully", "plugin", plugin.TypedName())
 	}
@@ -52,6 +52,7 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/datalayer/source/mocks"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/openai"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
 	poolutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/pool"
 	testutil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/testing"
 )
@@ -87,12 +88,13 @@ func (m *mockScheduler) Schedule(_ context.Context, _ *fwksched.LLMRequest, endp
 }
 
 type mockDatastore struct {
+	pool     *datalayer.EndpointPool
 	pods     []backendmetrics.PodMetrics
 	rewrites []*v1alpha2.InferenceModelRewrite
 }
 
 func (ds *mockDatastore) PoolGet() (*datalayer.EndpointPool, error) {
-	return nil, nil
+	return ds.pool, nil
 }
 func (ds *mockDatastore) ObjectiveGet(_ string) *v1alpha2.InferenceObjective {
 	return nil
@@ -168,7 +170,7 @@ func (m mockProducedDataType) Clone() fwkdl.Cloneable {
 	return mockProducedDataType{value: m.value}
 }
 
-func (ds *mockDatastore) ModelRewriteGet(modelName string) (*v1alpha2.InferenceModelRewriteRule, string) {
+func (ds *mockDatastore) ModelRewriteGet(modelName string, _ map[string]string) (*v1alpha2.InferenceModelRewriteRule, string) {
 	// This mock implementation simulates the precedence logic for simplicity.
 	// It finds the oldest rewrite that has a rule matching the modelName.
 	var matchingRewrites []*v1alpha2.InferenceModelRewrite
@@ -298,6 +300,7 @@ func TestDirector_HandleRequest(t *testing.T) {
 	tests := []struct {
 		name                    string
 		reqBodyMap              map[string]any
+		headers                 map[string]string // Additional request headers.
 		mockAdmissionController *mockAdmissionController
 		inferenceObjectiveName  string
 		schedulerMockSetup      func(m *mockScheduler)
@@ -334,6 +337,31 @@ func TestDirector_HandleRequest(t *testing.T) {
 			},
 			wantMutatedBodyModel:   model,
 			inferenceObjectiveName: objectiveName,
+		}, {
+			name: "malformed latency target header ignored",
+			reqBodyMap: map[string]any{
+				"model":  model,
+				"prompt": "critical prompt",
+			},
+			headers:                 map[string]string{metadata.TTFTSLOKey: "fast"},
+			mockAdmissionController: &mockAdmissionController{admitErr: nil},
+			schedulerMockSetup: func(m *mockScheduler) {
+				m.scheduleResults = defaultSuccessfulScheduleResults
+			},
+			initialTargetModelName: model,
+			wantReqCtx: &handlers.RequestContext{
+				ObjectiveKey:    objectiveName,
+				TargetModelName: model,
+				TargetPod: &fwkdl.EndpointMetadata{
+					NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod1"},
+					Address:        "192.168.1.100",
+					Port:           "8000",
+					MetricsHost:    "192.168.1.100:8000",
+				},
+				TargetEndpoint: "192.168.1.100:8000,192.168.2.100:8000,192.168.4.100:8000",
+			},
+			wantMutatedBodyModel:   model,
+			inferenceObjectiveName: objectiveName,
 		}, {
 			name: "successful request with model rewrite",
 			reqBodyMap: map[string]any{
@@ -617,7 +645,9 @@ func TestDirector_HandleRequest(t *testing.T) {
 		ds.ObjectiveSet(ioFoodReview)
 		ds.ObjectiveSet(ioFoodReviewResolve)
 		ds.ObjectiveSet(ioFoodReviewSheddable)
-		ds.ModelRewriteSet(rewrite)
+		if err := ds.ModelRewriteSet(rewrite); err != nil {
+			t.Fatalf("Error while setting inference model rewrite: %v", err)
+		}
 
 		scheme := runtime.NewScheme()
 		_ = clientgoscheme.AddToScheme(scheme)
@@ -676,6 +706,9 @@ func TestDirector_HandleRequest(t *testing.T) {
 					ObjectiveKey:    test.inferenceObjectiveName,
 					TargetModelName: test.initialTargetModelName,
 				}
+				for k, v := range test.headers {
+					reqCtx.Request.Headers[k] = v
+				}
 				var err error
 				reqCtx.Request.RawBody, err = json.Marshal(test.reqBodyMap)
 				if err != nil {
@@ -1063,6 +1096,46 @@ func TestDirector_SelectWeightedModel(t *testing.T) {
 	}
 }
 
+func TestDirector_StickyModelRewrite(t *testing.T) {
+	rewrite := &v1alpha2.InferenceModelRewrite{
+		ObjectMeta: metav1.ObjectMeta{Name: "sticky"},
+		Spec: v1alpha2.InferenceModelRewriteSpec{
+			Rules: []v1alpha2.InferenceModelRewriteRule{{
+				Matches: []v1alpha2.Match{{Model: &v1alpha2.ModelMatch{Value: "model"}}},
+				Targets: []v1alpha2.TargetModel{
+					{ModelRewrite: "model-v1", Weight: 80},
+					{ModelRewrite: "model-v2", Weight: 20},
+				},
+				StickySplit: &v1alpha2.StickySplit{HeaderName: "X-User-Id"},
+			}},
+		},
+	}
+	director := NewDirectorWithConfig(&mockDatastore{rewrites: []*v1alpha2.InferenceModelRewrite{rewrite}}, &mockScheduler{}, &mockAdmissionController{},
+		nil, nil, NewConfig())
+
+	rewriteFor := func(user string) string {
+		reqCtx := &handlers.RequestContext{
+			IncomingModelName: "model",
+			Request:           &handlers.Request{Headers: map[string]string{"x-user-id": user}},
+		}
+		director.applyWeightedModelRewrite(reqCtx)
+		return reqCtx.TargetModelName
+	}
+
+	counter := make(map[string]int)
+	numUsers := 1000
+	for i := range numUsers {
+		user := fmt.Sprintf("user-%d", i)
+		first := rewriteFor(user)
+		for range 5 {
+			assert.Equal(t, first, rewriteFor(user), "user %s should stay on the same target", user)
+		}
+		counter[first]++
+	}
+	assert.InDelta(t, 800, counter["model-v1"], 100, "Distribution for model-v1 is off")
+	assert.InDelta(t, 200, counter["model-v2"], 100, "Distribution for model-v2 is off")
+}
+
 func TestDirector_HandleResponseReceived(t *testing.T) {
 	pr1 := newTestResponseReceived("pr1")
 
@@ -1180,6 +1253,159 @@ func TestDirector_HandleResponseComplete(t *testing.T) {
 	if diff := cmp.Diff("namespace1/test-pod-name", pc1.lastTargetPodOnComplete); diff != "" {
 		t.Errorf("Scheduler.OnComplete TargetPodName mismatch (-want +got):\n%s", diff)
 	}
+	if diff := cmp.Diff(fwk.Termination{Reason: fwk.TerminationCompleted}, pc1.lastTerminationOnComplete); diff != "" {
+		t.Errorf("Scheduler.OnComplete Termination mismatch (-want +got):\n%s", diff)
+	}
+
+	reqCtx.Termination = fwk.Termination{Reason: fwk.TerminationUpstreamError, StatusCode: 503}
+	if _, err := director.HandleResponseBodyComplete(ctx, reqCtx); err != nil {
+		t.Fatalf("HandleResponseBodyComplete() returned unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(reqCtx.Termination, pc1.lastTerminationOnComplete); diff != "" {
+		t.Errorf("Scheduler.OnComplete Termination mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDirector_RequestBodyMutation(t *testing.T) {
+	result := &fwksched.SchedulingResult{
+		ProfileResults: map[string]*fwksched.ProfileRunResult{
+			"default": {TargetEndpoints: []fwksched.Endpoint{fwksched.NewEndpoint(&fwkdl.EndpointMetadata{
+				Address:        "192.168.1.100",
+				Port:           "8000",
+				NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod1"},
+			}, nil, nil)}},
+		},
+		PrimaryProfileName: "default",
+	}
+
+	tests := []struct {
+		name          string
+		parsedBody    any
+		bodyMutated   bool
+		mutations     []func(result *fwksched.SchedulingResult, body map[string]any) error
+		wantErr       bool
+		wantBody      string
+		wantCalls     []string
+		wantScheduled bool
+	}{
+		{
+			name:       "mutations are serialized once",
+			parsedBody: map[string]any{"model": "m", "prompt": "hello", "max_tokens": 1000},
+			mutations: []func(*fwksched.SchedulingResult, map[string]any) error{
+				func(result *fwksched.SchedulingResult, body map[string]any) error {
+					body["cache_salt"] = result.ProfileResults["default"].TargetEndpoints[0].GetMetadata().NamespacedName.Name
+					return nil
+				},
+				func(_ *fwksched.SchedulingResult, body map[string]any) error {
+					body["max_tokens"] = 100
+					return nil
+				},
+			},
+			wantBody:      `{"cache_salt":"pod1","max_tokens":100,"model":"m","prompt":"hello"}`,
+			wantCalls:     []string{"mutate-0", "mutate-1", "pre-request-0", "pre-request-1"},
+			wantScheduled: true,
+		},
+		{
+			name:       "failed mutation fails the request before dispatch",
+			parsedBody: map[string]any{"model": "m", "prompt": "hello"},
+			mutations: []func(*fwksched.SchedulingResult, map[string]any) error{
+				func(*fwksched.SchedulingResult, map[string]any) error { return errors.New("invalid body") },
+				func(*fwksched.SchedulingResult, map[string]any) error { return nil },
+			},
+			wantErr:   true,
+			wantBody:  `original`,
+			wantCalls: []string{"mutate-0"},
+		},
+		{
+			name:          "body mutated before scheduling is serialized without mutation plugins",
+			parsedBody:    map[string]any{"model": "rewritten", "prompt": "hello"},
+			bodyMutated:   true,
+			wantBody:      `{"model":"rewritten","prompt":"hello"}`,
+			wantScheduled: true,
+		},
+		{
+			name:          "unmutated body is not serialized again",
+			parsedBody:    map[string]any{"model": "m", "prompt": "hello"},
+			wantBody:      `original`,
+			wantScheduled: true,
+		},
+		{
+			name:       "body which is not a JSON object is not mutated",
+			parsedBody: nil,
+			mutations: []func(*fwksched.SchedulingResult, map[string]any) error{
+				func(*fwksched.SchedulingResult, map[string]any) error { return nil },
+			},
+			wantBody:      `original`,
+			wantCalls:     []string{"pre-request-0"},
+			wantScheduled: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := logutil.NewTestLoggerIntoContext(context.Background())
+			var calls []string
+			config := NewConfig()
+			for i, mutate := range test.mutations {
+				config.AddPlugins(&testRequestBodyMutation{
+					typedName: fwkplugin.TypedName{Type: testRequestBodyMutationType, Name: fmt.Sprintf("p%d", i)},
+					index:     i,
+					mutate:    mutate,
+					calls:     &calls,
+				})
+			}
+			ds := datastore.NewDatastore(t.Context(), nil, 0)
+			locator := NewCachedPodLocator(context.Background(), NewDatastorePodLocator(ds), time.Minute)
+			director := NewDirectorWithConfig(ds, &mockScheduler{}, nil, nil, locator, config)
+
+			reqCtx := &handlers.RequestContext{
+				Request:     &handlers.Request{Headers: map[string]string{}, RawBody: []byte("original")},
+				RequestSize: len("original"),
+				BodyMutated: test.bodyMutated,
+				SchedulingRequest: &fwksched.LLMRequest{
+					Body: &fwksched.LLMRequestBody{ParsedBody: test.parsedBody},
+				},
+			}
+
+			_, err := director.prepareRequest(ctx, reqCtx, result)
+			if test.wantErr {
+				var e errcommon.Error
+				if assert.ErrorAs(t, err, &e) {
+					assert.Equal(t, errcommon.Internal, e.Code)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.wantBody, string(reqCtx.Request.RawBody))
+			assert.Equal(t, len(test.wantBody), reqCtx.RequestSize)
+			assert.Equal(t, test.wantCalls, calls)
+			assert.Equal(t, test.wantScheduled, reqCtx.TargetPod != nil)
+		})
+	}
+}
+
+const testRequestBodyMutationType = "test-request-body-mutation"
+
+// testRequestBodyMutation records its calls, both as a RequestBodyMutation and as a PreRequest plugin.
+type testRequestBodyMutation struct {
+	typedName fwkplugin.TypedName
+	index     int
+	mutate    func(result *fwksched.SchedulingResult, body map[string]any) error
+	calls     *[]string
+}
+
+func (p *testRequestBodyMutation) TypedName() fwkplugin.TypedName {
+	return p.typedName
+}
+
+func (p *testRequestBodyMutation) MutateRequestBody(_ context.Context, _ *fwksched.LLMRequest, result *fwksched.SchedulingResult,
+	body map[string]any) error {
+	*p.calls = append(*p.calls, fmt.Sprintf("mutate-%d", p.index))
+	return p.mutate(result, body)
+}
+
+func (p *testRequestBodyMutation) PreRequest(_ context.Context, _ *fwksched.LLMRequest, _ *fwksched.SchedulingResult) {
+	*p.calls = append(*p.calls, fmt.Sprintf("pre-request-%d", p.index))
 }
 
 const (
@@ -1201,9 +1427,10 @@ type testResponseStreaming struct {
 }
 
 type testResponseComplete struct {
-	typedName               fwkplugin.TypedName
-	lastRespOnComplete      *fwk.Response
-	lastTargetPodOnComplete string
+	typedName                 fwkplugin.TypedName
+	lastRespOnComplete        *fwk.Response
+	lastTerminationOnComplete fwk.Termination
+	lastTargetPodOnComplete   string
 }
 
 func newTestResponseReceived(name string) *testResponseReceived {
@@ -1246,7 +1473,9 @@ func (p *testResponseStreaming) ResponseStreaming(_ context.Context, _ *fwksched
 	p.lastTargetPodOnStreaming = targetPod.NamespacedName.String()
 }
 
-func (p *testResponseComplete) ResponseComplete(_ context.Context, _ *fwksched.LLMRequest, response *fwk.Response, targetPod *fwkdl.EndpointMetadata) {
+func (p *testResponseComplete) ResponseComplete(_ context.Context, _ *fwksched.LLMRequest, response *fwk.Response, termination fwk.Termination,
+	targetPod *fwkdl.EndpointMetadata) {
 	p.lastRespOnComplete = response
+	p.lastTerminationOnComplete = termination
 	p.lastTargetPodOnComplete = targetPod.NamespacedName.String()
 }
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcontrol
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+)
+
+// maxTargetMilliseconds is the largest latency target, in milliseconds, that fits in a
+// time.Duration.
+const maxTargetMilliseconds = float64(math.MaxInt64 / int64(time.Millisecond))
+
+// requestObjectives returns the scheduling objectives of a request of the given objective. The
+// latency targets of the objective can be tightened, but not loosened, by the request headers.
+func requestObjectives(ctx context.Context, infObjective *v1alpha2.InferenceObjective, headers map[string]string) fwksched.RequestObjectives {
+	objectives := fwksched.RequestObjectives{Priority: *infObjective.Spec.Priority}
+	targets := infObjective.Spec.LatencyTargets
+	if targets == nil {
+		targets = &v1alpha2.LatencyTargets{}
+	}
+	objectives.TTFT = latencyTarget(ctx, targets.TTFT, headers, metadata.TTFTSLOKey)
+	objectives.TPOT = latencyTarget(ctx, targets.TPOT, headers, metadata.TPOTSLOKey)
+	objectives.E2E = latencyTarget(ctx, targets.E2E, headers, metadata.E2ESLOKey)
+	return objectives
+}
+
+// latencyTarget returns the tighter of the objective target and the target set in the given
+// header, or zero if neither is set.
+func latencyTarget(ctx context.Context, objectiveTarget *metav1.Duration, headers map[string]string, key string) time.Duration {
+	var target time.Duration
+	if objectiveTarget != nil {
+		target = objectiveTarget.Duration
+	}
+	if headerTarget := sloTarget(ctx, headers, key); headerTarget > 0 && (target <= 0 || headerTarget < target) {
+		target = headerTarget
+	}
+	return target
+}
+
+// sloTarget returns the latency target, in milliseconds, set in the given header, or zero if it is
+// not set or not positive. A header which is not a finite number, or too large for a duration, is
+// logged and ignored.
+func sloTarget(ctx context.Context, headers map[string]string, key string) time.Duration {
+	value, ok := headers[key]
+	if !ok {
+		return 0
+	}
+	ms, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(ms) || ms > maxTargetMilliseconds {
+		log.FromContext(ctx).V(logutil.DEBUG).Info("Ignoring invalid latency target header", "header", key, "value", value)
+		return 0
+	}
+	if ms <= 0 {
+		return 0
+	}
+	return time.Duration(ms * float64(time.Millisecond))
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcontrol
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+)
+
+func TestRequestObjectives(t *testing.T) {
+	objectiveTargets := &v1alpha2.LatencyTargets{
+		TTFT: &metav1.Duration{Duration: 500 * time.Millisecond},
+		TPOT: &metav1.Duration{Duration: 50 * time.Millisecond},
+	}
+	tests := []struct {
+		name    string
+		targets *v1alpha2.LatencyTargets
+		headers map[string]string
+		want    fwksched.RequestObjectives
+	}{
+		{
+			name:    "no targets",
+			headers: map[string]string{},
+			want:    fwksched.RequestObjectives{Priority: 1},
+		},
+		{
+			name:    "header targets",
+			headers: map[string]string{metadata.TTFTSLOKey: "200", metadata.E2ESLOKey: "3000"},
+			want:    fwksched.RequestObjectives{Priority: 1, TTFT: 200 * time.Millisecond, E2E: 3 * time.Second},
+		},
+		{
+			name:    "objective targets",
+			targets: objectiveTargets,
+			headers: map[string]string{},
+			want:    fwksched.RequestObjectives{Priority: 1, TTFT: 500 * time.Millisecond, TPOT: 50 * time.Millisecond},
+		},
+		{
+			name:    "headers tighten objective targets",
+			targets: objectiveTargets,
+			headers: map[string]string{metadata.TTFTSLOKey: "200", metadata.E2ESLOKey: "3000"},
+			want:    fwksched.RequestObjectives{Priority: 1, TTFT: 200 * time.Millisecond, TPOT: 50 * time.Millisecond, E2E: 3 * time.Second},
+		},
+		{
+			name:    "headers do not loosen objective targets",
+			targets: objectiveTargets,
+			headers: map[string]string{metadata.TTFTSLOKey: "1000", metadata.TPOTSLOKey: "0"},
+			want:    fwksched.RequestObjectives{Priority: 1, TTFT: 500 * time.Millisecond, TPOT: 50 * time.Millisecond},
+		},
+		{
+			name:    "malformed headers ignored",
+			targets: objectiveTargets,
+			headers: map[string]string{metadata.TTFTSLOKey: "200", metadata.TPOTSLOKey: "fast", metadata.E2ESLOKey: "Inf"},
+			want:    fwksched.RequestObjectives{Priority: 1, TTFT: 200 * time.Millisecond, TPOT: 50 * time.Millisecond},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			infObjective := &v1alpha2.InferenceObjective{
+				Spec: v1alpha2.InferenceObjectiveSpec{Priority: ptr.To(1), LatencyTargets: test.targets},
+			}
+			got := requestObjectives(context.Background(), infObjective, test.headers)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("requestObjectives() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSLOTarget(t *testing.T) {
+	headers := map[string]string{
+		"valid":     "150.5",
+		"invalid":   "fast",
+		"negative":  "-1",
+		"nan":       "NaN",
+		"infinite":  "+Inf",
+		"overflow":  "1e300",
+		"-infinite": "-Inf",
+	}
+	tests := map[string]time.Duration{
+		"valid":     150*time.Millisecond + 500*time.Microsecond,
+		"invalid":   0,
+		"negative":  0,
+		"nan":       0,
+		"infinite":  0,
+		"overflow":  0,
+		"-infinite": 0,
+		"missing":   0,
+	}
+	for key, want := range tests {
+		if got := sloTarget(context.Background(), headers, key); got != want {
+			t.Errorf("sloTarget(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
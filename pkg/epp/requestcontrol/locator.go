@@ -90,7 +90,7 @@ func NewDatastorePodLocator(ds Datastore, opts ...LocatorOption) *DatastorePodLo
 }
 
 // Locate retrieves the list of candidate pods from the datastore that match the criteria defined in the request
-// metadata.
+// metadata. Draining pods are never candidates.
 //
 // It supports:
 // 1. Returning all pods if no specific subset filter is present.
@@ -101,24 +101,24 @@ func (d *DatastorePodLocator) Locate(ctx context.Context, requestMetadata map[st
 	// If the user explicitly disabled subset filtering, return the default pool (all pods).
 	if d.config.DisableEndpointSubsetFilter {
 		loggerTrace.Info("endpoint subset filtering is explicitly disabled, returning all pods")
-		return d.datastore.PodList(datastore.AllPodsPredicate)
+		return d.datastore.PodList(datastore.SchedulablePodsPredicate)
 	}
 
 	// Check if the subset filter namespace exists in metadata.
 	// If not, we assume the request targets the default pool (all pods).
 	if requestMetadata == nil {
-		return d.datastore.PodList(datastore.AllPodsPredicate)
+		return d.datastore.PodList(datastore.SchedulablePodsPredicate)
 	}
 
 	subsetMap, found := requestMetadata[metadata.SubsetFilterNamespace].(map[string]any)
 	if !found {
-		return d.datastore.PodList(datastore.AllPodsPredicate)
+		return d.datastore.PodList(datastore.SchedulablePodsPredicate)
 	}
 
 	// Check if the specific endpoint key exists within the subset map.
 	endpointSubsetList, found := subsetMap[metadata.SubsetFilterKey].([]any)
 	if !found {
-		return d.datastore.PodList(datastore.AllPodsPredicate)
+		return d.datastore.PodList(datastore.SchedulablePodsPredicate)
 	}
 
 	// If the filter key exists but the list is empty, it implies a filter that matched nothing upstream (or malformed
@@ -150,6 +150,9 @@ func (d *DatastorePodLocator) Locate(ctx context.Context, requestMetadata map[st
 	podTotalCount := 0
 	podFilteredList := d.datastore.PodList(func(pm backendmetrics.PodMetrics) bool {
 		podTotalCount++
+		if !datastore.SchedulablePodsPredicate(pm) {
+			return false
+		}
 		// If the pod's IP is in our allowed map, include it.
 		// Note: We use GetIPAddress() which should align with the subset address.
 		if pod := pm.GetMetadata(); pod != nil {
@@ -150,6 +150,20 @@ func TestDatastorePodLocator_Locate(t *testing.T) {
 	}
 }
 
+func TestDatastorePodLocator_SkipsDrainingPods(t *testing.T) {
+	t.Parallel()
+
+	drainingPod := makeMockPodMetrics("pod-b", "10.0.0.2")
+	drainingPod.GetMetadata().Draining = true
+	mockDS := &mockDatastore{pods: []backendmetrics.PodMetrics{makeMockPodMetrics("pod-a", "10.0.0.1"), drainingPod}}
+
+	for _, requestMetadata := range []map[string]any{nil, makeMetadataWithSubset([]any{"10.0.0.1:8080", "10.0.0.2:8080"})} {
+		result := NewDatastorePodLocator(mockDS).Locate(context.Background(), requestMetadata)
+		require.Len(t, result, 1)
+		assert.Equal(t, "10.0.0.1", result[0].GetMetadata().GetIPAddress(), "Locate returned a draining pod")
+	}
+}
+
 // --- CachedPodLocator Tests ---
 
 func TestCachedPodLocator_CachingBehavior(t *testing.T) {
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcontrol
+
+import (
+	"errors"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/objectivestats"
+)
+
+// objectiveStatsKey returns the key of the statistics of the objective of the request in the pool of
+// the Director. It returns an empty key, which is not recorded, if statistics are disabled, the pool
+// is not synced yet or the objective is unknown, as there is no status to report them in.
+func (d *Director) objectiveStatsKey(reqCtx *handlers.RequestContext) objectivestats.Key {
+	if d.objectiveStats == nil {
+		return objectivestats.Key{}
+	}
+	pool, err := d.datastore.PoolGet()
+	if err != nil {
+		return objectivestats.Key{}
+	}
+	objective := d.datastore.ObjectiveGet(reqCtx.ObjectiveKey)
+	if objective == nil {
+		return objectivestats.Key{}
+	}
+	return objectivestats.Key{
+		Pool:      types.NamespacedName{Namespace: pool.Namespace, Name: pool.Name},
+		Objective: types.NamespacedName{Namespace: objective.Namespace, Name: objective.Name},
+	}
+}
+
+// recordRejection records a request rejected by admission control in the statistics of its objective.
+// Requests rejected because the pool is saturated or the queue is full are shed, while requests that
+// timed out in the queue or whose client disconnected are evicted.
+func (d *Director) recordRejection(reqCtx *handlers.RequestContext, err error) {
+	var admissionErr errcommon.Error
+	if !errors.As(err, &admissionErr) {
+		return
+	}
+	switch admissionErr.Code {
+	case errcommon.ResourceExhausted:
+		d.objectiveStats.RecordShed(d.objectiveStatsKey(reqCtx))
+	case errcommon.ServiceUnavailable:
+		d.objectiveStats.RecordQueueEvicted(d.objectiveStatsKey(reqCtx))
+	}
+}
+
+// recordCompletion records a request whose response completed successfully in the statistics of its
+// objective. The latencies are measured from the time the request was received.
+func (d *Director) recordCompletion(reqCtx *handlers.RequestContext) {
+	if d.objectiveStats == nil || !reqCtx.ResponseComplete || reqCtx.ResponseStatusCode != "" || reqCtx.SchedulingRequest == nil {
+		return
+	}
+	objectives := reqCtx.SchedulingRequest.Objectives
+	completion := objectivestats.Completion{
+		E2E:        reqCtx.ResponseCompleteTimestamp.Sub(reqCtx.RequestReceivedTimestamp),
+		TTFTTarget: objectives.TTFT,
+		TPOTTarget: objectives.TPOT,
+		E2ETarget:  objectives.E2E,
+	}
+	if !reqCtx.FirstChunkTimestamp.IsZero() {
+		completion.TTFT = reqCtx.FirstChunkTimestamp.Sub(reqCtx.RequestReceivedTimestamp)
+		if tokens := reqCtx.Usage.CompletionTokens; tokens > 1 {
+			completion.TPOT = reqCtx.ResponseCompleteTimestamp.Sub(reqCtx.FirstChunkTimestamp) / time.Duration(tokens-1)
+		}
+	}
+	d.objectiveStats.RecordCompletion(d.objectiveStatsKey(reqCtx), completion)
+}
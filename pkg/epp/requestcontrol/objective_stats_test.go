@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcontrol
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	fwkrq "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/objectivestats"
+)
+
+// chatKey is the key of the statistics of the chat objective served by objectiveStatsDatastore.
+var chatKey = objectivestats.Key{
+	Pool:      types.NamespacedName{Namespace: "default", Name: "pool"},
+	Objective: types.NamespacedName{Namespace: "default", Name: "chat"},
+}
+
+// objectiveStatsDatastore serves the pool and the chat objective of chatKey.
+type objectiveStatsDatastore struct {
+	Datastore
+}
+
+func (objectiveStatsDatastore) PoolGet() (*datalayer.EndpointPool, error) {
+	return datalayer.NewEndpointPool(chatKey.Pool.Namespace, chatKey.Pool.Name), nil
+}
+
+func (objectiveStatsDatastore) ObjectiveGet(name string) *v1alpha2.InferenceObjective {
+	if name != chatKey.Objective.Name {
+		return nil
+	}
+	return &v1alpha2.InferenceObjective{ObjectMeta: metav1.ObjectMeta{Namespace: chatKey.Objective.Namespace, Name: name}}
+}
+
+func TestRecordRejection(t *testing.T) {
+	tracker := objectivestats.NewTracker()
+	director := &Director{datastore: objectiveStatsDatastore{}, objectiveStats: tracker}
+	reqCtx := &handlers.RequestContext{ObjectiveKey: "chat"}
+
+	director.recordRejection(reqCtx, errcommon.Error{Code: errcommon.ResourceExhausted})
+	director.recordRejection(reqCtx, errcommon.Error{Code: errcommon.ResourceExhausted})
+	director.recordRejection(reqCtx, errcommon.Error{Code: errcommon.ServiceUnavailable})
+	director.recordRejection(reqCtx, errcommon.Error{Code: errcommon.Internal})
+	director.recordRejection(reqCtx, errors.New("not an admission error"))
+	director.recordRejection(&handlers.RequestContext{ObjectiveKey: "unknown"}, errcommon.Error{Code: errcommon.ResourceExhausted})
+
+	stats, _ := tracker.Flush(time.Now())
+	if len(stats) != 1 {
+		t.Errorf("Recorded %d objectives, want only the known one", len(stats))
+	}
+	got := stats[chatKey]
+	if got.Shed != 2 {
+		t.Errorf("Shed = %d, want 2", got.Shed)
+	}
+	if got.QueueEvicted != 1 {
+		t.Errorf("QueueEvicted = %d, want 1", got.QueueEvicted)
+	}
+}
+
+func TestRecordCompletion(t *testing.T) {
+	received := time.Now()
+	tests := []struct {
+		name           string
+		reqCtx         *handlers.RequestContext
+		wantRecorded   bool
+		wantTTFT       time.Duration
+		wantTPOT       time.Duration
+		wantAttainment int32
+	}{
+		{
+			name: "streamed response meeting its targets",
+			reqCtx: &handlers.RequestContext{
+				SchedulingRequest: &fwksched.LLMRequest{Objectives: fwksched.RequestObjectives{
+					TTFT: 500 * time.Millisecond,
+					TPOT: 50 * time.Millisecond,
+				}},
+				RequestReceivedTimestamp:  received,
+				FirstChunkTimestamp:       received.Add(200 * time.Millisecond),
+				ResponseCompleteTimestamp: received.Add(1200 * time.Millisecond),
+				ResponseComplete:          true,
+				Usage:                     fwkrq.Usage{CompletionTokens: 51},
+			},
+			wantRecorded:   true,
+			wantTTFT:       200 * time.Millisecond,
+			wantTPOT:       20 * time.Millisecond,
+			wantAttainment: 100,
+		},
+		{
+			name: "streamed response missing its targets",
+			reqCtx: &handlers.RequestContext{
+				SchedulingRequest:         &fwksched.LLMRequest{Objectives: fwksched.RequestObjectives{TTFT: 100 * time.Millisecond}},
+				RequestReceivedTimestamp:  received,
+				FirstChunkTimestamp:       received.Add(200 * time.Millisecond),
+				ResponseCompleteTimestamp: received.Add(200 * time.Millisecond),
+				ResponseComplete:          true,
+				Usage:                     fwkrq.Usage{CompletionTokens: 1},
+			},
+			wantRecorded:   true,
+			wantTTFT:       200 * time.Millisecond,
+			wantAttainment: 0,
+		},
+		{
+			name: "non-streamed response missing its E2E target",
+			reqCtx: &handlers.RequestContext{
+				SchedulingRequest:         &fwksched.LLMRequest{Objectives: fwksched.RequestObjectives{E2E: time.Second}},
+				RequestReceivedTimestamp:  received,
+				ResponseCompleteTimestamp: received.Add(2 * time.Second),
+				ResponseComplete:          true,
+			},
+			wantRecorded:   true,
+			wantAttainment: 0,
+		},
+		{
+			name: "failed response",
+			reqCtx: &handlers.RequestContext{
+				SchedulingRequest:  &fwksched.LLMRequest{},
+				ResponseComplete:   true,
+				ResponseStatusCode: "503",
+			},
+		},
+		{
+			name: "incomplete response",
+			reqCtx: &handlers.RequestContext{
+				SchedulingRequest: &fwksched.LLMRequest{},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tracker := objectivestats.NewTracker()
+			director := &Director{datastore: objectiveStatsDatastore{}, objectiveStats: tracker}
+			test.reqCtx.ObjectiveKey = "chat"
+
+			director.recordCompletion(test.reqCtx)
+
+			stats, _ := tracker.Flush(time.Now())
+			got, ok := stats[chatKey]
+			if ok != test.wantRecorded {
+				t.Fatalf("recorded = %v, want %v", ok, test.wantRecorded)
+			}
+			if !ok {
+				return
+			}
+			if test.wantTTFT == 0 {
+				if got.TTFT != nil {
+					t.Errorf("TTFT = %v, want none", got.TTFT)
+				}
+			} else if got.TTFT == nil || got.TTFT.P50.Duration != test.wantTTFT {
+				t.Errorf("TTFT = %v, want p50 %v", got.TTFT, test.wantTTFT)
+			}
+			if test.wantTPOT == 0 {
+				if got.TPOT != nil {
+					t.Errorf("TPOT = %v, want none", got.TPOT)
+				}
+			} else if got.TPOT == nil || got.TPOT.P50.Duration != test.wantTPOT {
+				t.Errorf("TPOT = %v, want p50 %v", got.TPOT, test.wantTPOT)
+			}
+			if got.SLOAttainmentPercent == nil || *got.SLOAttainmentPercent != test.wantAttainment {
+				t.Errorf("SLOAttainmentPercent = %v, want %d", got.SLOAttainmentPercent, test.wantAttainment)
+			}
+		})
+	}
+}
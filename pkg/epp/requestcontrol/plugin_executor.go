@@ -19,38 +19,271 @@ package requestcontrol
 import (
 	"context"
 	"errors"
+	"maps"
+	"slices"
+	"sync"
 	"time"
 
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	fwk "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
 	schedulingtypes "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+// The reasons of PrepareData plugin failures.
+const (
+	prepareDataFailureError      = "error"
+	prepareDataFailureTimeout    = "timeout"
+	prepareDataFailureDependency = "dependency"
 )
 
+// errPrepareDataTimeout is the cause of the contexts of PrepareData plugins timing out.
+var errPrepareDataTimeout = errors.New("prepare data plugin timed out")
+
+// prepareDataFailure is the failure of a PrepareData plugin.
+type prepareDataFailure struct {
+	plugin fwk.PrepareDataPlugin
+	reason string
+	err    error
+}
+
+func (f *prepareDataFailure) Error() string {
+	return "prepare data plugin " + f.plugin.TypedName().String() + " failed: " + f.err.Error()
+}
+
+func (f *prepareDataFailure) Unwrap() error {
+	return f.err
+}
+
 // executePluginsAsDAG executes PrepareData plugins as a DAG based on their dependencies asynchronously.
-// So, a plugin is executed only after all its dependencies have been executed.
-// If there is a cycle or any plugin fails with error, it returns an error.
-func executePluginsAsDAG(plugins []fwk.PrepareDataPlugin, ctx context.Context, request *schedulingtypes.LLMRequest, endpoints []schedulingtypes.Endpoint) error {
-	for _, plugin := range plugins {
-		if err := plugin.PrepareRequestData(ctx, request, endpoints); err != nil {
-			return errors.New("prepare data plugin " + plugin.TypedName().String() + " failed: " + err.Error())
+// So, a plugin is executed only after all its dependencies have been executed, and independent plugins
+// are executed in parallel. Each plugin is bounded by its own timeout, and a plugin is not executed when
+// one of its dependencies failed. It returns the failures of the plugins, in the order of the plugins.
+func executePluginsAsDAG(plugins []fwk.PrepareDataPlugin, ctx context.Context, config *PrepareDataConfig,
+	request *schedulingtypes.LLMRequest, endpoints []schedulingtypes.Endpoint) []*prepareDataFailure {
+	dependencies := dataDependencies(plugins)
+	failures := make([]*prepareDataFailure, len(plugins))
+	done := make([]chan struct{}, len(plugins))
+	for i := range plugins {
+		done[i] = make(chan struct{})
+	}
+
+	var wg sync.WaitGroup
+	for i, plugin := range plugins {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(done[i])
+			for _, dependency := range dependencies[i] {
+				select {
+				case <-done[dependency]:
+				case <-ctx.Done():
+					failures[i] = newPrepareDataFailure(plugin, context.Cause(ctx))
+					return
+				}
+				if failures[dependency] != nil {
+					failures[i] = &prepareDataFailure{plugin: plugin, reason: prepareDataFailureDependency,
+						err: errors.New("dependency " + plugins[dependency].TypedName().String() + " failed")}
+					return
+				}
+			}
+			failures[i] = runPrepareDataPlugin(plugin, ctx, config.pluginConfig(plugin).Timeout, request, endpoints)
+		}()
+	}
+	wg.Wait()
+
+	result := make([]*prepareDataFailure, 0, len(failures))
+	for _, failure := range failures {
+		if failure != nil {
+			result = append(result, failure)
 		}
 	}
-	return nil
+	return result
 }
 
-// prepareDataPluginsWithTimeout executes the PrepareRequestData plugins with retries and timeout.
-func prepareDataPluginsWithTimeout(timeout time.Duration, plugins []fwk.PrepareDataPlugin,
-	ctx context.Context, request *schedulingtypes.LLMRequest, endpoints []schedulingtypes.Endpoint) error {
+// dataDependencies returns the indexes of the plugins producing the data consumed by each plugin.
+func dataDependencies(plugins []fwk.PrepareDataPlugin) [][]int {
+	dependencies := make([][]int, len(plugins))
+	for i, consumer := range plugins {
+		for j, producer := range plugins {
+			if i == j {
+				continue
+			}
+			for key := range consumer.Consumes() {
+				if _, ok := producer.Produces()[key]; ok {
+					dependencies[i] = append(dependencies[i], j)
+					break
+				}
+			}
+		}
+	}
+	return dependencies
+}
+
+// runPrepareDataPlugin executes a PrepareData plugin within the given timeout, and returns its failure if any.
+// The plugin writes the data of the endpoints to staged copies, which are applied to the endpoints when the
+// plugin returns. When the timeout expires, it returns without waiting for the plugin to return, and the data
+// the plugin writes afterwards is discarded.
+func runPrepareDataPlugin(plugin fwk.PrepareDataPlugin, ctx context.Context, timeout time.Duration,
+	request *schedulingtypes.LLMRequest, endpoints []schedulingtypes.Endpoint) *prepareDataFailure {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, timeout, errPrepareDataTimeout)
+		defer cancel()
+	}
+
+	staged := make([]*stagedEndpoint, len(endpoints))
+	pluginEndpoints := make([]schedulingtypes.Endpoint, len(endpoints))
+	for i, endpoint := range endpoints {
+		staged[i] = newStagedEndpoint(endpoint)
+		pluginEndpoints[i] = staged[i]
+	}
+
+	before := time.Now()
 	errCh := make(chan error, 1)
 	go func() {
-		errCh <- executePluginsAsDAG(plugins, ctx, request, endpoints)
+		errCh <- plugin.PrepareRequestData(ctx, request, pluginEndpoints)
 	}()
 
+	var err error
 	select {
+	case err = <-errCh:
+		metrics.RecordPluginProcessingLatency(fwk.PrepareDataExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, time.Since(before))
+		for _, endpoint := range staged {
+			endpoint.apply()
+		}
 	case <-ctx.Done():
-		return ctx.Err()
-	case err := <-errCh:
-		return err
-	case <-time.After(timeout):
-		return errors.New("prepare data plugin timed out")
 	}
+	if ctx.Err() != nil {
+		// The error of a plugin returning on the context being done is reported as the cause of the context.
+		return newPrepareDataFailure(plugin, context.Cause(ctx))
+	}
+	if err != nil {
+		return &prepareDataFailure{plugin: plugin, reason: prepareDataFailureError, err: err}
+	}
+	return nil
+}
+
+// stagedEndpoint records the attribute writes of a PrepareData plugin to an endpoint, and applies them to
+// the endpoint only when the plugin returns, so that a plugin that timed out cannot alter the endpoint. It
+// implements fwkdl.AttributeDeleter, so that plugins can remove attributes.
+type stagedEndpoint struct {
+	schedulingtypes.Endpoint
+
+	mu      sync.Mutex
+	puts    map[string]fwkdl.Cloneable
+	deletes map[string]struct{}
+}
+
+var _ fwkdl.AttributeDeleter = &stagedEndpoint{}
+
+func newStagedEndpoint(endpoint schedulingtypes.Endpoint) *stagedEndpoint {
+	return &stagedEndpoint{
+		Endpoint: endpoint,
+		puts:     map[string]fwkdl.Cloneable{},
+		deletes:  map[string]struct{}{},
+	}
+}
+
+func (e *stagedEndpoint) Get(key string) (fwkdl.Cloneable, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if value, ok := e.puts[key]; ok {
+		return value, true
+	}
+	if _, ok := e.deletes[key]; ok {
+		return nil, false
+	}
+	return e.Endpoint.Get(key)
+}
+
+func (e *stagedEndpoint) Put(key string, value fwkdl.Cloneable) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.deletes, key)
+	e.puts[key] = value
+}
+
+func (e *stagedEndpoint) Delete(key string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.puts, key)
+	e.deletes[key] = struct{}{}
+}
+
+func (e *stagedEndpoint) Keys() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	keys := map[string]struct{}{}
+	for _, key := range e.Endpoint.Keys() {
+		if _, ok := e.deletes[key]; !ok {
+			keys[key] = struct{}{}
+		}
+	}
+	for key := range e.puts {
+		keys[key] = struct{}{}
+	}
+	return slices.Collect(maps.Keys(keys))
+}
+
+// apply applies the staged writes to the endpoint.
+func (e *stagedEndpoint) apply() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for key := range e.deletes {
+		deleteAttribute(e.Endpoint, key)
+	}
+	for key, value := range e.puts {
+		e.Endpoint.Put(key, value)
+	}
+}
+
+// deleteAttribute removes an attribute of the endpoint, when the endpoint supports it.
+func deleteAttribute(endpoint schedulingtypes.Endpoint, key string) {
+	if deleter, ok := endpoint.(fwkdl.AttributeDeleter); ok {
+		deleter.Delete(key)
+	}
+}
+
+func newPrepareDataFailure(plugin fwk.PrepareDataPlugin, cause error) *prepareDataFailure {
+	reason := prepareDataFailureError
+	if errors.Is(cause, errPrepareDataTimeout) {
+		reason = prepareDataFailureTimeout
+	}
+	return &prepareDataFailure{plugin: plugin, reason: reason, err: cause}
+}
+
+// PrepareDataPluginsWithTimeout executes the PrepareRequestData plugins within the timeout of the PrepareData phase,
+// and handles their failures according to their failure policies. It returns an error when the request must fail.
+func PrepareDataPluginsWithTimeout(config *PrepareDataConfig, plugins []fwk.PrepareDataPlugin,
+	ctx context.Context, request *schedulingtypes.LLMRequest, endpoints []schedulingtypes.Endpoint) error {
+	if config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, config.Timeout, errPrepareDataTimeout)
+		defer cancel()
+	}
+
+	logger := log.FromContext(ctx)
+	var errs []error
+	for _, failure := range executePluginsAsDAG(plugins, ctx, config, request, endpoints) {
+		metrics.RecordPrepareDataPluginFailure(failure.plugin.TypedName().Type, failure.plugin.TypedName().Name, failure.reason)
+		switch policy := config.pluginConfig(failure.plugin).FailurePolicy; policy {
+		case configapi.PrepareDataFailurePolicyFailRequest:
+			errs = append(errs, failure)
+		case configapi.PrepareDataFailurePolicyMarkMissing:
+			for key := range failure.plugin.Produces() {
+				for _, endpoint := range endpoints {
+					deleteAttribute(endpoint, key)
+				}
+			}
+			logger.V(logutil.DEFAULT).Error(failure, "Marked the data of a failed PrepareData plugin as missing", "plugin", failure.plugin.TypedName())
+		default:
+			logger.V(logutil.DEFAULT).Error(failure, "Skipped a failed PrepareData plugin", "plugin", failure.plugin.TypedName())
+		}
+	}
+	return errors.Join(errs...)
 }
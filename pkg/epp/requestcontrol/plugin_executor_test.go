@@ -24,6 +24,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
 	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 	fwk "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
 	schedulingtypes "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
@@ -144,7 +148,11 @@ func TestPrepareDataPluginsWithTimeout(t *testing.T) {
 			ctx, cancel := tc.ctxFn()
 			defer cancel()
 
-			err := prepareDataPluginsWithTimeout(tc.timeout, tc.plugins, ctx, &schedulingtypes.LLMRequest{}, nil)
+			config := &PrepareDataConfig{Timeout: tc.timeout, Plugins: map[string]PrepareDataPluginConfig{}}
+			for _, p := range tc.plugins {
+				config.Plugins[p.TypedName().Name] = PrepareDataPluginConfig{FailurePolicy: configapi.PrepareDataFailurePolicyFailRequest}
+			}
+			err := PrepareDataPluginsWithTimeout(config, tc.plugins, ctx, &schedulingtypes.LLMRequest{}, nil)
 
 			if tc.expectSuccess {
 				assert.NoError(t, err)
@@ -280,12 +288,12 @@ func TestExecutePluginsAsDAG(t *testing.T) {
 				plugin.execTime = time.Time{}
 			}
 
-			err := executePluginsAsDAG(tc.plugins, context.Background(), &schedulingtypes.LLMRequest{}, nil)
+			failures := executePluginsAsDAG(tc.plugins, context.Background(), NewPrepareDataConfig(), &schedulingtypes.LLMRequest{}, nil)
 
 			if tc.expectErr {
-				assert.Error(t, err)
+				assert.NotEmpty(t, failures)
 			} else {
-				assert.NoError(t, err)
+				assert.Empty(t, failures)
 			}
 
 			if tc.checkFunc != nil {
@@ -294,3 +302,201 @@ func TestExecutePluginsAsDAG(t *testing.T) {
 		})
 	}
 }
+
+// barrierPlugin waits for the other plugins sharing its barrier to start, so that it only completes when
+// it is executed in parallel with them.
+type barrierPlugin struct {
+	mockPrepareRequestDataPlugin
+	barrier *sync.WaitGroup
+}
+
+func (p *barrierPlugin) PrepareRequestData(ctx context.Context, _ *schedulingtypes.LLMRequest, _ []schedulingtypes.Endpoint) error {
+	p.barrier.Done()
+	waited := make(chan struct{})
+	go func() {
+		p.barrier.Wait()
+		close(waited)
+	}()
+	select {
+	case <-waited:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestExecutePluginsAsDAGInParallel(t *testing.T) {
+	barrier := &sync.WaitGroup{}
+	barrier.Add(2)
+	plugins := []fwk.PrepareDataPlugin{
+		&barrierPlugin{mockPrepareRequestDataPlugin: mockPrepareRequestDataPlugin{name: "p1"}, barrier: barrier},
+		&barrierPlugin{mockPrepareRequestDataPlugin: mockPrepareRequestDataPlugin{name: "p2"}, barrier: barrier},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	failures := executePluginsAsDAG(plugins, ctx, NewPrepareDataConfig(), &schedulingtypes.LLMRequest{}, nil)
+	assert.Empty(t, failures, "independent plugins should be executed in parallel")
+}
+
+func TestExecutePluginsAsDAGFailureReasons(t *testing.T) {
+	slow := &dagTestPlugin{
+		mockPrepareRequestDataPlugin: mockPrepareRequestDataPlugin{name: "slow", delay: time.Second},
+		produces:                     map[string]any{"keySlow": nil},
+	}
+	dependent := &dagTestPlugin{
+		mockPrepareRequestDataPlugin: mockPrepareRequestDataPlugin{name: "dependent"},
+		consumes:                     map[string]any{"keySlow": nil},
+	}
+	failing := &dagTestPlugin{
+		mockPrepareRequestDataPlugin: mockPrepareRequestDataPlugin{name: "failing", returnErr: errors.New("plugin failed")},
+	}
+	config := NewPrepareDataConfig()
+	config.Plugins["slow"] = PrepareDataPluginConfig{Timeout: 20 * time.Millisecond}
+
+	failures := executePluginsAsDAG([]fwk.PrepareDataPlugin{slow, dependent, failing}, context.Background(), config,
+		&schedulingtypes.LLMRequest{}, nil)
+
+	reasons := map[string]string{}
+	for _, failure := range failures {
+		reasons[failure.plugin.TypedName().Name] = failure.reason
+	}
+	assert.Equal(t, map[string]string{
+		"slow":      prepareDataFailureTimeout,
+		"dependent": prepareDataFailureDependency,
+		"failing":   prepareDataFailureError,
+	}, reasons)
+	assert.False(t, dependent.executed, "Plugin depending on a timed out plugin should not be executed")
+}
+
+// producingPlugin puts its data on the endpoints, then returns its error.
+type producingPlugin struct {
+	mockPrepareRequestDataPlugin
+	key string
+}
+
+func (p *producingPlugin) PrepareRequestData(_ context.Context, _ *schedulingtypes.LLMRequest, endpoints []schedulingtypes.Endpoint) error {
+	for _, endpoint := range endpoints {
+		endpoint.Put(p.key, mockProducedDataType{value: 1})
+	}
+	return p.returnErr
+}
+
+func (p *producingPlugin) Produces() map[string]any {
+	return map[string]any{p.key: mockProducedDataType{}}
+}
+
+func TestPrepareDataFailurePolicies(t *testing.T) {
+	testCases := []struct {
+		name          string
+		policy        configapi.PrepareDataFailurePolicy
+		expectErr     bool
+		expectMissing bool
+	}{
+		{
+			name: "skip by default",
+		},
+		{
+			name:   "skip",
+			policy: configapi.PrepareDataFailurePolicySkip,
+		},
+		{
+			name:      "fail request",
+			policy:    configapi.PrepareDataFailurePolicyFailRequest,
+			expectErr: true,
+		},
+		{
+			name:          "mark missing",
+			policy:        configapi.PrepareDataFailurePolicyMarkMissing,
+			expectMissing: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugin := &producingPlugin{
+				mockPrepareRequestDataPlugin: mockPrepareRequestDataPlugin{name: "producer", returnErr: errors.New("plugin failed")},
+				key:                          "data",
+			}
+			endpoints := []schedulingtypes.Endpoint{
+				schedulingtypes.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, &fwkdl.Metrics{}, nil),
+				schedulingtypes.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod2"}}, &fwkdl.Metrics{}, nil),
+			}
+			config := NewPrepareDataConfig()
+			if tc.policy != "" {
+				config.Plugins["producer"] = PrepareDataPluginConfig{FailurePolicy: tc.policy}
+			}
+
+			err := PrepareDataPluginsWithTimeout(config, []fwk.PrepareDataPlugin{plugin}, context.Background(),
+				&schedulingtypes.LLMRequest{}, endpoints)
+
+			if tc.expectErr {
+				assert.ErrorContains(t, err, "prepare data plugin producer/mock failed: plugin failed")
+			} else {
+				assert.NoError(t, err)
+			}
+			for _, endpoint := range endpoints {
+				_, ok := endpoint.Get("data")
+				assert.Equal(t, !tc.expectMissing, ok, "unexpected presence of the data on %s", endpoint.GetMetadata().NamespacedName)
+			}
+		})
+	}
+}
+
+// lateProducingPlugin puts its data on the endpoints after its context is done and it is released.
+type lateProducingPlugin struct {
+	producingPlugin
+	release chan struct{}
+	done    chan struct{}
+}
+
+func (p *lateProducingPlugin) PrepareRequestData(ctx context.Context, _ *schedulingtypes.LLMRequest, endpoints []schedulingtypes.Endpoint) error {
+	defer close(p.done)
+	<-ctx.Done()
+	<-p.release
+	for _, endpoint := range endpoints {
+		endpoint.Put(p.key, mockProducedDataType{value: 1})
+	}
+	return nil
+}
+
+func TestPrepareDataTimedOutPluginDoesNotAlterEndpoints(t *testing.T) {
+	plugin := &lateProducingPlugin{
+		producingPlugin: producingPlugin{mockPrepareRequestDataPlugin: mockPrepareRequestDataPlugin{name: "producer"}, key: "data"},
+		release:         make(chan struct{}),
+		done:            make(chan struct{}),
+	}
+	endpoint := schedulingtypes.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, &fwkdl.Metrics{}, nil)
+	config := NewPrepareDataConfig()
+	config.Plugins["producer"] = PrepareDataPluginConfig{Timeout: 10 * time.Millisecond, FailurePolicy: configapi.PrepareDataFailurePolicyMarkMissing}
+
+	err := PrepareDataPluginsWithTimeout(config, []fwk.PrepareDataPlugin{plugin}, context.Background(),
+		&schedulingtypes.LLMRequest{}, []schedulingtypes.Endpoint{endpoint})
+	assert.NoError(t, err)
+
+	close(plugin.release)
+	<-plugin.done
+	_, ok := endpoint.Get("data")
+	assert.False(t, ok, "the data put by a plugin after its timeout must not reach the endpoint")
+}
+
+func TestStagedEndpoint(t *testing.T) {
+	endpoint := schedulingtypes.NewEndpoint(&fwkdl.EndpointMetadata{NamespacedName: k8stypes.NamespacedName{Name: "pod1"}}, &fwkdl.Metrics{}, nil)
+	endpoint.Put("kept", mockProducedDataType{value: 1})
+	endpoint.Put("deleted", mockProducedDataType{value: 2})
+
+	staged := newStagedEndpoint(endpoint)
+	staged.Put("added", mockProducedDataType{value: 3})
+	staged.Delete("deleted")
+
+	_, ok := staged.Get("deleted")
+	assert.False(t, ok)
+	value, ok := staged.Get("added")
+	assert.True(t, ok)
+	assert.Equal(t, mockProducedDataType{value: 3}, value)
+	assert.ElementsMatch(t, []string{"kept", "added"}, staged.Keys())
+	assert.ElementsMatch(t, []string{"kept", "deleted"}, endpoint.Keys(), "the endpoint must not change before the writes are applied")
+
+	staged.apply()
+	assert.ElementsMatch(t, []string{"kept", "added"}, endpoint.Keys())
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcontrol
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metrics"
+)
+
+// PoolRoute binds an InferencePool to the Director that serves its requests.
+type PoolRoute struct {
+	Pool     types.NamespacedName
+	Director *Director
+}
+
+// NewPoolRouter creates a PoolRouter over the given routes. The order of the routes is the
+// order in which pools are considered when the pool is selected by model name.
+func NewPoolRouter(parser fwkrh.Parser, routes ...PoolRoute) *PoolRouter {
+	byPool := make(map[types.NamespacedName]*Director, len(routes))
+	for _, route := range routes {
+		byPool[route.Pool] = route.Director
+	}
+	return &PoolRouter{
+		parser: parser,
+		routes: routes,
+		byPool: byPool,
+	}
+}
+
+// PoolRouter is used by an Endpoint Picker that serves several InferencePools. It selects the
+// pool of each request and hands the request over to the Director of that pool. The pool is
+// selected by, in order:
+// - The pool hint in the request metadata set by the proxy for the matched route.
+// - The first pool that serves the requested model.
+// - The only pool, when there is a single one.
+type PoolRouter struct {
+	parser fwkrh.Parser
+	routes []PoolRoute
+	byPool map[types.NamespacedName]*Director
+}
+
+// HandleRequest selects the pool of the request and delegates to its Director. The request body is
+// parsed once, to select the pool by the requested model and to be handled by the Director.
+func (r *PoolRouter) HandleRequest(ctx context.Context, reqCtx *handlers.RequestContext) (*handlers.RequestContext, error) {
+	var body *fwksched.LLMRequestBody
+	if r.parser != nil {
+		var err error
+		if body, err = parseRequestBody(ctx, reqCtx, r.parser); err != nil {
+			return reqCtx, err
+		}
+	}
+	pool, err := r.selectPool(ctx, reqCtx, body)
+	if err != nil {
+		return reqCtx, err
+	}
+	reqCtx.Pool = pool
+	ctx = log.IntoContext(ctx, log.FromContext(ctx).WithValues("pool", pool.String()))
+
+	if body != nil {
+		reqCtx, err = r.byPool[pool].handleParsedRequest(ctx, reqCtx, body)
+	} else {
+		reqCtx, err = r.byPool[pool].HandleRequest(ctx, reqCtx)
+	}
+	if err == nil {
+		metrics.RecordInferencePoolRequest(pool.Name, reqCtx.IncomingModelName, reqCtx.TargetModelName)
+	}
+	return reqCtx, err
+}
+
+func (r *PoolRouter) selectPool(ctx context.Context, reqCtx *handlers.RequestContext, body *fwksched.LLMRequestBody) (types.NamespacedName, error) {
+	logger := log.FromContext(ctx)
+
+	if hint, ok := poolHint(reqCtx.Request.Metadata); ok {
+		for _, route := range r.routes {
+			if hint == route.Pool.Name || hint == route.Pool.String() {
+				logger.V(logutil.DEBUG).Info("Selected pool from the request metadata", "pool", route.Pool)
+				return route.Pool, nil
+			}
+		}
+		return types.NamespacedName{}, errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("unknown InferencePool %q", hint)}
+	}
+
+	if model := requestedModel(body); model != "" {
+		for _, route := range r.routes {
+			if route.Director.servesModel(model, reqCtx.Request.Headers) {
+				logger.V(logutil.DEBUG).Info("Selected pool from the model name", "pool", route.Pool, "model", model)
+				return route.Pool, nil
+			}
+		}
+	}
+
+	if len(r.routes) == 1 {
+		return r.routes[0].Pool, nil
+	}
+	return types.NamespacedName{}, errcommon.Error{Code: errcommon.BadRequest, Msg: "failed to select an InferencePool for the request"}
+}
+
+// poolHint returns the pool hint set in the request metadata, if any.
+func poolHint(requestMetadata map[string]any) (string, bool) {
+	hintMap, ok := requestMetadata[metadata.PoolHintNamespace].(map[string]any)
+	if !ok {
+		return "", false
+	}
+	hint, ok := hintMap[metadata.PoolHintKey].(string)
+	if !ok {
+		return "", false
+	}
+	hint = strings.TrimSpace(hint)
+	return hint, hint != ""
+}
+
+// requestedModel returns the model of the parsed request body, or an empty string if the body is
+// not parsed or names no model.
+func requestedModel(body *fwksched.LLMRequestBody) string {
+	if body == nil {
+		return ""
+	}
+	bodyMap, ok := body.ParsedBody.(map[string]any)
+	if !ok {
+		return ""
+	}
+	model, _ := bodyMap["model"].(string)
+	return model
+}
+
+// HandleResponseReceived delegates to the Director of the pool that served the request.
+func (r *PoolRouter) HandleResponseReceived(ctx context.Context, reqCtx *handlers.RequestContext) (*handlers.RequestContext, error) {
+	if director, ok := r.byPool[reqCtx.Pool]; ok {
+		return director.HandleResponseReceived(ctx, reqCtx)
+	}
+	return reqCtx, nil
+}
+
+// HandleResponseBodyStreaming delegates to the Director of the pool that served the request.
+func (r *PoolRouter) HandleResponseBodyStreaming(ctx context.Context, reqCtx *handlers.RequestContext) (*handlers.RequestContext, error) {
+	if director, ok := r.byPool[reqCtx.Pool]; ok {
+		return director.HandleResponseBodyStreaming(ctx, reqCtx)
+	}
+	return reqCtx, nil
+}
+
+// HandleResponseBodyComplete delegates to the Director of the pool that served the request.
+func (r *PoolRouter) HandleResponseBodyComplete(ctx context.Context, reqCtx *handlers.RequestContext) (*handlers.RequestContext, error) {
+	if director, ok := r.byPool[reqCtx.Pool]; ok {
+		return director.HandleResponseBodyComplete(ctx, reqCtx)
+	}
+	return reqCtx, nil
+}
+
+// GetRandomEndpoint returns a random endpoint of a random pool that has endpoints.
+func (r *PoolRouter) GetRandomEndpoint() *fwkdl.EndpointMetadata {
+	if len(r.routes) == 0 {
+		return nil
+	}
+	start := rand.Intn(len(r.routes))
+	for i := range r.routes {
+		if endpoint := r.routes[(start+i)%len(r.routes)].Director.GetRandomEndpoint(); endpoint != nil {
+			return endpoint
+		}
+	}
+	return nil
+}
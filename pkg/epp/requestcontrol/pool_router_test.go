@@ -0,0 +1,248 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcontrol
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	fwkdl "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/datalayer"
+	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/openai"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+)
+
+func newTestPoolDirector(ds *mockDatastore, config *Config) *Director {
+	locator := NewCachedPodLocator(context.Background(), NewDatastorePodLocator(ds), time.Minute)
+	return NewDirectorWithConfig(ds, &mockScheduler{}, &mockAdmissionController{}, openai.NewOpenAIParser(), locator, config)
+}
+
+func newTestPoolPod(name string, activeModels ...string) backendmetrics.PodMetrics {
+	metrics := fwkdl.NewMetrics()
+	for _, model := range activeModels {
+		metrics.ActiveModels[model] = 1
+	}
+	return &backendmetrics.FakePodMetrics{
+		Metadata:   &fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Name: name, Namespace: "default"}},
+		Metrics:    metrics,
+		Attributes: &fwkdl.Attributes{},
+	}
+}
+
+func TestPoolRouter_SelectPool(t *testing.T) {
+	poolA := types.NamespacedName{Name: "pool-a", Namespace: "default"}
+	poolB := types.NamespacedName{Name: "pool-b", Namespace: "default"}
+
+	rewrite := &v1alpha2.InferenceModelRewrite{
+		ObjectMeta: metav1.ObjectMeta{Name: "rewrite"},
+		Spec: v1alpha2.InferenceModelRewriteSpec{
+			Rules: []v1alpha2.InferenceModelRewriteRule{{
+				Matches: []v1alpha2.Match{{Model: &v1alpha2.ModelMatch{Value: "alias"}}},
+				Targets: []v1alpha2.TargetModel{{ModelRewrite: "model-b", Weight: 1}},
+			}},
+		},
+	}
+	directorA := newTestPoolDirector(&mockDatastore{pods: []backendmetrics.PodMetrics{newTestPoolPod("a1", "adapter-a")}}, NewConfig())
+	directorB := newTestPoolDirector(&mockDatastore{
+		pods:     []backendmetrics.PodMetrics{newTestPoolPod("b1", "adapter-b")},
+		rewrites: []*v1alpha2.InferenceModelRewrite{rewrite},
+	}, NewConfig())
+	router := NewPoolRouter(openai.NewOpenAIParser(),
+		PoolRoute{Pool: poolA, Director: directorA},
+		PoolRoute{Pool: poolB, Director: directorB},
+	)
+
+	tests := []struct {
+		name     string
+		metadata map[string]any
+		body     string
+		wantPool types.NamespacedName
+		wantErr  bool
+	}{
+		{
+			name:     "pool hint by name",
+			metadata: map[string]any{metadata.PoolHintNamespace: map[string]any{metadata.PoolHintKey: "pool-b"}},
+			body:     `{"model": "adapter-a", "prompt": "hi"}`,
+			wantPool: poolB,
+		},
+		{
+			name:     "pool hint by namespaced name",
+			metadata: map[string]any{metadata.PoolHintNamespace: map[string]any{metadata.PoolHintKey: "default/pool-a"}},
+			body:     `{"model": "adapter-b", "prompt": "hi"}`,
+			wantPool: poolA,
+		},
+		{
+			name:     "unknown pool hint",
+			metadata: map[string]any{metadata.PoolHintNamespace: map[string]any{metadata.PoolHintKey: "pool-c"}},
+			body:     `{"model": "adapter-a", "prompt": "hi"}`,
+			wantErr:  true,
+		},
+		{
+			name:     "model served by an endpoint",
+			body:     `{"model": "adapter-b", "prompt": "hi"}`,
+			wantPool: poolB,
+		},
+		{
+			name:     "model matched by a rewrite",
+			body:     `{"model": "alias", "prompt": "hi"}`,
+			wantPool: poolB,
+		},
+		{
+			name:    "model not served by any pool",
+			body:    `{"model": "unknown", "prompt": "hi"}`,
+			wantErr: true,
+		},
+		{
+			name:    "unparsable body",
+			body:    `not json`,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reqCtx := &handlers.RequestContext{
+				Request: &handlers.Request{
+					Headers:  map[string]string{},
+					RawBody:  []byte(test.body),
+					Metadata: test.metadata,
+				},
+			}
+			// Unparsable bodies are rejected by HandleRequest, and name no model here.
+			body, _ := parseRequestBody(context.Background(), reqCtx, router.parser)
+			pool, err := router.selectPool(context.Background(), reqCtx, body)
+			if test.wantErr {
+				require.Error(t, err)
+				var e errcommon.Error
+				require.ErrorAs(t, err, &e)
+				assert.Equal(t, errcommon.BadRequest, e.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.wantPool, pool)
+		})
+	}
+}
+
+func TestPoolRouter_SinglePool(t *testing.T) {
+	pool := types.NamespacedName{Name: "pool-a", Namespace: "default"}
+	router := NewPoolRouter(openai.NewOpenAIParser(), PoolRoute{Pool: pool, Director: newTestPoolDirector(&mockDatastore{}, NewConfig())})
+
+	reqCtx := &handlers.RequestContext{
+		Request: &handlers.Request{
+			Headers: map[string]string{},
+			RawBody: []byte(`{"model": "unknown", "prompt": "hi"}`),
+		},
+	}
+	got, err := router.selectPool(context.Background(), reqCtx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, pool, got)
+}
+
+// countingParser counts the request bodies it parses.
+type countingParser struct {
+	fwkrh.Parser
+	parsed int
+}
+
+func (p *countingParser) ParseRequest(ctx context.Context, body []byte, headers map[string]string) (*fwksched.LLMRequestBody, error) {
+	p.parsed++
+	return p.Parser.ParseRequest(ctx, body, headers)
+}
+
+func TestPoolRouter_HandleRequestParsesOnce(t *testing.T) {
+	parser := &countingParser{Parser: openai.NewOpenAIParser()}
+	newDirector := func(name string) *Director {
+		ds := &mockDatastore{pods: []backendmetrics.PodMetrics{newTestPoolPod(name, "model-"+name)}}
+		locator := NewCachedPodLocator(context.Background(), NewDatastorePodLocator(ds), time.Minute)
+		return NewDirectorWithConfig(ds, &mockScheduler{}, &mockAdmissionController{}, parser, locator, NewConfig())
+	}
+	poolA := types.NamespacedName{Name: "pool-a", Namespace: "default"}
+	poolB := types.NamespacedName{Name: "pool-b", Namespace: "default"}
+	router := NewPoolRouter(parser, PoolRoute{Pool: poolA, Director: newDirector("a")}, PoolRoute{Pool: poolB, Director: newDirector("b")})
+
+	reqCtx := &handlers.RequestContext{
+		Request: &handlers.Request{
+			Headers: map[string]string{},
+			RawBody: []byte(`{"model": "model-b", "prompt": "hi"}`),
+		},
+	}
+	// The mock scheduler returns no result, which fails the request after it was parsed and handed over.
+	_, _ = router.HandleRequest(context.Background(), reqCtx)
+	assert.Equal(t, poolB, reqCtx.Pool)
+	assert.Equal(t, "model-b", reqCtx.IncomingModelName)
+	assert.Equal(t, 1, parser.parsed, "the request body must be parsed once")
+
+	reqCtx.Request.RawBody = []byte(`not json`)
+	_, err := router.HandleRequest(context.Background(), reqCtx)
+	var e errcommon.Error
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, errcommon.BadRequest, e.Code)
+}
+
+func TestPoolRouter_ResponseDelegation(t *testing.T) {
+	poolA := types.NamespacedName{Name: "pool-a", Namespace: "default"}
+	poolB := types.NamespacedName{Name: "pool-b", Namespace: "default"}
+	pluginA := newTestResponseComplete("complete-a")
+	pluginB := newTestResponseComplete("complete-b")
+	router := NewPoolRouter(nil,
+		PoolRoute{Pool: poolA, Director: newTestPoolDirector(&mockDatastore{}, NewConfig().WithResponseCompletePlugins(pluginA))},
+		PoolRoute{Pool: poolB, Director: newTestPoolDirector(&mockDatastore{}, NewConfig().WithResponseCompletePlugins(pluginB))},
+	)
+
+	reqCtx := &handlers.RequestContext{
+		Pool: poolB,
+		Request: &handlers.Request{
+			Headers: map[string]string{"x-request-id": "test-request-id"},
+		},
+		Response:  &handlers.Response{Headers: map[string]string{}},
+		TargetPod: &fwkdl.EndpointMetadata{NamespacedName: types.NamespacedName{Name: "b1", Namespace: "default"}},
+	}
+	_, err := router.HandleResponseBodyComplete(context.Background(), reqCtx)
+	require.NoError(t, err)
+	assert.Nil(t, pluginA.lastRespOnComplete, "pool-a should not see the response of pool-b")
+	assert.NotNil(t, pluginB.lastRespOnComplete, "pool-b should see its response")
+
+	reqCtx.Pool = types.NamespacedName{Name: "unknown", Namespace: "default"}
+	_, err = router.HandleResponseBodyComplete(context.Background(), reqCtx)
+	require.NoError(t, err)
+}
+
+func TestPoolRouter_GetRandomEndpoint(t *testing.T) {
+	router := NewPoolRouter(nil,
+		PoolRoute{Pool: types.NamespacedName{Name: "empty"}, Director: newTestPoolDirector(&mockDatastore{}, NewConfig())},
+		PoolRoute{Pool: types.NamespacedName{Name: "pool-b"}, Director: newTestPoolDirector(&mockDatastore{pods: []backendmetrics.PodMetrics{newTestPoolPod("b1")}}, NewConfig())},
+	)
+	for range 10 {
+		endpoint := router.GetRandomEndpoint()
+		require.NotNil(t, endpoint)
+		assert.Equal(t, "b1", endpoint.NamespacedName.Name)
+	}
+
+	assert.Nil(t, NewPoolRouter(nil).GetRandomEndpoint())
+}
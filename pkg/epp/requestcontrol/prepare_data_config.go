@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcontrol
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	configapi "sigs.k8s.io/gateway-api-inference-extension/apix/config/v1alpha1"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	fwk "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requestcontrol"
+)
+
+const (
+	// DefaultPrepareDataTimeout is the default timeout of the PrepareData phase of a request.
+	DefaultPrepareDataTimeout = 400 * time.Millisecond
+)
+
+// PrepareDataConfig configures the execution of the PrepareData plugins.
+type PrepareDataConfig struct {
+	// Timeout bounds the PrepareData phase of each request. 0 means no timeout.
+	Timeout time.Duration
+	// Plugins configures individual PrepareData plugins, keyed by plugin name.
+	Plugins map[string]PrepareDataPluginConfig
+}
+
+// PrepareDataPluginConfig configures the execution of a PrepareData plugin.
+type PrepareDataPluginConfig struct {
+	// Timeout bounds the execution of the plugin, within the timeout of the phase. 0 means that the
+	// plugin is bounded by the timeout of the phase only.
+	Timeout time.Duration
+	// FailurePolicy specifies how a failure of the plugin is handled.
+	FailurePolicy configapi.PrepareDataFailurePolicy
+}
+
+// NewPrepareDataConfig returns a PrepareDataConfig with the default timeout, where all plugins use
+// the Skip failure policy.
+func NewPrepareDataConfig() *PrepareDataConfig {
+	return &PrepareDataConfig{
+		Timeout: DefaultPrepareDataTimeout,
+		Plugins: map[string]PrepareDataPluginConfig{},
+	}
+}
+
+// NewPrepareDataConfigFromAPI creates a new PrepareDataConfig by translating the API configuration.
+// The plugins referenced by the configuration must be PrepareData plugins of the handle.
+func NewPrepareDataConfigFromAPI(apiConfig *configapi.PrepareDataConfig, handle plugin.Handle) (*PrepareDataConfig, error) {
+	cfg := NewPrepareDataConfig()
+	if apiConfig == nil {
+		return cfg, nil
+	}
+
+	var errs []error
+	if apiConfig.Timeout != nil {
+		if apiConfig.Timeout.Duration <= 0 {
+			errs = append(errs, errors.New("timeout must be positive"))
+		}
+		cfg.Timeout = apiConfig.Timeout.Duration
+	}
+	for _, pluginConfig := range apiConfig.Plugins {
+		if _, ok := handle.Plugin(pluginConfig.PluginRef).(fwk.PrepareDataPlugin); !ok {
+			errs = append(errs, fmt.Errorf("the plugin %s is not a PrepareData plugin", pluginConfig.PluginRef))
+			continue
+		}
+		if _, ok := cfg.Plugins[pluginConfig.PluginRef]; ok {
+			errs = append(errs, fmt.Errorf("the plugin %s is configured more than once", pluginConfig.PluginRef))
+			continue
+		}
+		current := PrepareDataPluginConfig{FailurePolicy: configapi.PrepareDataFailurePolicySkip}
+		if pluginConfig.Timeout != nil {
+			if pluginConfig.Timeout.Duration <= 0 {
+				errs = append(errs, fmt.Errorf("the timeout of the plugin %s must be positive", pluginConfig.PluginRef))
+			}
+			current.Timeout = pluginConfig.Timeout.Duration
+		}
+		switch pluginConfig.FailurePolicy {
+		case "":
+		case configapi.PrepareDataFailurePolicySkip, configapi.PrepareDataFailurePolicyFailRequest, configapi.PrepareDataFailurePolicyMarkMissing:
+			current.FailurePolicy = pluginConfig.FailurePolicy
+		default:
+			errs = append(errs, fmt.Errorf("unknown failurePolicy '%s' of the plugin %s", pluginConfig.FailurePolicy, pluginConfig.PluginRef))
+		}
+		cfg.Plugins[pluginConfig.PluginRef] = current
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// pluginConfig returns the configuration of the given plugin.
+func (c *PrepareDataConfig) pluginConfig(p plugin.Plugin) PrepareDataPluginConfig {
+	if current, ok := c.Plugins[p.TypedName().Name]; ok {
+		return current
+	}
+	return PrepareDataPluginConfig{FailurePolicy: configapi.PrepareDataFailurePolicySkip}
+}
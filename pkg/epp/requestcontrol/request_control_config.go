@@ -24,23 +24,34 @@ import (
 // NewConfig creates a new Config object and returns its pointer.
 func NewConfig() *Config {
 	return &Config{
-		admissionPlugins:         []fwk.AdmissionPlugin{},
-		prepareDataPlugins:       []fwk.PrepareDataPlugin{},
-		preRequestPlugins:        []fwk.PreRequest{},
-		responseReceivedPlugins:  []fwk.ResponseReceived{},
-		responseStreamingPlugins: []fwk.ResponseStreaming{},
-		responseCompletePlugins:  []fwk.ResponseComplete{},
+		admissionPlugins:           []fwk.AdmissionPlugin{},
+		prepareDataPlugins:         []fwk.PrepareDataPlugin{},
+		requestBodyMutationPlugins: []fwk.RequestBodyMutation{},
+		preRequestPlugins:          []fwk.PreRequest{},
+		responseReceivedPlugins:    []fwk.ResponseReceived{},
+		responseStreamingPlugins:   []fwk.ResponseStreaming{},
+		responseCompletePlugins:    []fwk.ResponseComplete{},
+		prepareDataConfig:          NewPrepareDataConfig(),
 	}
 }
 
 // Config provides a configuration for the requestcontrol plugins.
 type Config struct {
-	admissionPlugins         []fwk.AdmissionPlugin
-	prepareDataPlugins       []fwk.PrepareDataPlugin
-	preRequestPlugins        []fwk.PreRequest
-	responseReceivedPlugins  []fwk.ResponseReceived
-	responseStreamingPlugins []fwk.ResponseStreaming
-	responseCompletePlugins  []fwk.ResponseComplete
+	admissionPlugins           []fwk.AdmissionPlugin
+	prepareDataPlugins         []fwk.PrepareDataPlugin
+	requestBodyMutationPlugins []fwk.RequestBodyMutation
+	preRequestPlugins          []fwk.PreRequest
+	responseReceivedPlugins    []fwk.ResponseReceived
+	responseStreamingPlugins   []fwk.ResponseStreaming
+	responseCompletePlugins    []fwk.ResponseComplete
+	prepareDataConfig          *PrepareDataConfig
+}
+
+// WithRequestBodyMutationPlugins sets the given plugins as the RequestBodyMutation plugins.
+// If the Config has RequestBodyMutation plugins already, this call replaces the existing plugins with the given ones.
+func (c *Config) WithRequestBodyMutationPlugins(plugins ...fwk.RequestBodyMutation) *Config {
+	c.requestBodyMutationPlugins = plugins
+	return c
 }
 
 // WithPreRequestPlugins sets the given plugins as the PreRequest plugins.
@@ -77,6 +88,12 @@ func (c *Config) WithPrepareDataPlugins(plugins ...fwk.PrepareDataPlugin) *Confi
 	return c
 }
 
+// WithPrepareDataConfig sets the configuration of the execution of the PrepareData plugins.
+func (c *Config) WithPrepareDataConfig(config *PrepareDataConfig) *Config {
+	c.prepareDataConfig = config
+	return c
+}
+
 // WithAdmissionPlugins sets the given plugins as the AdmitRequest plugins.
 func (c *Config) WithAdmissionPlugins(plugins ...fwk.AdmissionPlugin) *Config {
 	c.admissionPlugins = plugins
@@ -88,6 +105,9 @@ func (c *Config) WithAdmissionPlugins(plugins ...fwk.AdmissionPlugin) *Config {
 // If a plugin implements multiple plugin interfaces, it will be added to each corresponding list.
 func (c *Config) AddPlugins(pluginObjects ...plugin.Plugin) {
 	for _, plugin := range pluginObjects {
+		if requestBodyMutationPlugin, ok := plugin.(fwk.RequestBodyMutation); ok {
+			c.requestBodyMutationPlugins = append(c.requestBodyMutationPlugins, requestBodyMutationPlugin)
+		}
 		if preRequestPlugin, ok := plugin.(fwk.PreRequest); ok {
 			c.preRequestPlugins = append(c.preRequestPlugins, preRequestPlugin)
 		}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcontrol
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/flowcontrol/contracts"
+	requtil "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/util/request"
+)
+
+// Spillover holds what a Director needs to spill requests over from its saturated pool to the
+// fallback targets of the InferenceModelRewrite rule that matched them.
+type Spillover struct {
+	saturationDetector contracts.SaturationDetector
+	priorities         sets.Set[int]
+	pools              map[types.NamespacedName]*Director
+}
+
+// NewSpillover creates a Spillover. Sheddable requests are always eligible for spillover, and
+// requests with one of the given priorities are eligible as well.
+func NewSpillover(sd contracts.SaturationDetector, priorities ...int) *Spillover {
+	return &Spillover{
+		saturationDetector: sd,
+		priorities:         sets.New(priorities...),
+		pools:              map[types.NamespacedName]*Director{},
+	}
+}
+
+// WithPool registers the Director of a pool served by this Endpoint Picker, so that requests can
+// spill over to it.
+func (s *Spillover) WithPool(pool types.NamespacedName, director *Director) *Spillover {
+	s.pools[pool] = director
+	return s
+}
+
+// eligible reports whether requests of the given priority may spill over.
+func (s *Spillover) eligible(priority int) bool {
+	return requtil.IsSheddable(priority) || s.priorities.Has(priority)
+}
+
+// saturated reports whether the candidate pods of the Director for the request are saturated.
+func (s *Spillover) saturated(ctx context.Context, d *Director, requestMetadata map[string]any) bool {
+	return s.saturationDetector.Saturation(ctx, d.podLocator.Locate(ctx, requestMetadata)) >= 1.0
+}
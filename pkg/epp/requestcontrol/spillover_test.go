@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcontrol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api-inference-extension/apix/v1alpha2"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	backendmetrics "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/backend/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datalayer"
+	fwksched "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/scheduling"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/plugins/requesthandling/parsers/openai"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
+)
+
+// mockSaturationDetector reports saturation when any of the candidate pods is saturated.
+type mockSaturationDetector struct {
+	saturated map[string]bool
+}
+
+func (m *mockSaturationDetector) Saturation(_ context.Context, candidatePods []backendmetrics.PodMetrics) float64 {
+	for _, pod := range candidatePods {
+		if m.saturated[pod.GetMetadata().NamespacedName.Name] {
+			return 1.0
+		}
+	}
+	return 0.5
+}
+
+func newSpilloverRewrite(fallbacks ...v1alpha2.FallbackTarget) *v1alpha2.InferenceModelRewrite {
+	return &v1alpha2.InferenceModelRewrite{
+		ObjectMeta: metav1.ObjectMeta{Name: "rewrite"},
+		Spec: v1alpha2.InferenceModelRewriteSpec{
+			Rules: []v1alpha2.InferenceModelRewriteRule{{
+				Matches:   []v1alpha2.Match{{Model: &v1alpha2.ModelMatch{Value: "model"}}},
+				Targets:   []v1alpha2.TargetModel{{ModelRewrite: "model", Weight: 1}},
+				Fallbacks: fallbacks,
+			}},
+		},
+	}
+}
+
+func poolFallback(pool, model string) v1alpha2.FallbackTarget {
+	return v1alpha2.FallbackTarget{ModelRewrite: model, PoolRef: &v1alpha2.PoolObjectReference{Name: v1alpha2.ObjectName(pool)}}
+}
+
+func TestDirector_Spill(t *testing.T) {
+	poolA := types.NamespacedName{Name: "pool-a", Namespace: "default"}
+	poolB := types.NamespacedName{Name: "pool-b", Namespace: "default"}
+
+	tests := []struct {
+		name       string
+		fallbacks  []v1alpha2.FallbackTarget
+		saturated  map[string]bool
+		priority   int
+		priorities []int
+		wantPool   types.NamespacedName
+		wantModel  string
+		wantSpill  bool
+	}{
+		{
+			name:      "pool not saturated",
+			fallbacks: []v1alpha2.FallbackTarget{poolFallback("pool-b", "")},
+			saturated: map[string]bool{},
+			priority:  -1,
+			wantModel: "model",
+		},
+		{
+			name:      "priority not eligible",
+			fallbacks: []v1alpha2.FallbackTarget{poolFallback("pool-b", "")},
+			saturated: map[string]bool{"a1": true},
+			priority:  0,
+			wantModel: "model",
+		},
+		{
+			name:      "no fallbacks",
+			saturated: map[string]bool{"a1": true},
+			priority:  -1,
+			wantModel: "model",
+		},
+		{
+			name:      "spill over to another pool",
+			fallbacks: []v1alpha2.FallbackTarget{poolFallback("pool-b", "")},
+			saturated: map[string]bool{"a1": true},
+			priority:  -1,
+			wantPool:  poolB,
+			wantModel: "model",
+			wantSpill: true,
+		},
+		{
+			name:       "configured priority is eligible",
+			fallbacks:  []v1alpha2.FallbackTarget{poolFallback("pool-b", "model-b")},
+			saturated:  map[string]bool{"a1": true},
+			priority:   1,
+			priorities: []int{1},
+			wantPool:   poolB,
+			wantModel:  "model-b",
+			wantSpill:  true,
+		},
+		{
+			name: "skip saturated and unknown pools",
+			fallbacks: []v1alpha2.FallbackTarget{
+				poolFallback("pool-b", ""),
+				poolFallback("pool-c", ""),
+				{ModelRewrite: "model-small"},
+			},
+			saturated: map[string]bool{"a1": true, "b1": true},
+			priority:  -1,
+			wantModel: "model-small",
+			wantSpill: true,
+		},
+		{
+			name:      "no usable fallback",
+			fallbacks: []v1alpha2.FallbackTarget{poolFallback("pool-b", "")},
+			saturated: map[string]bool{"a1": true, "b1": true},
+			priority:  -1,
+			wantModel: "model",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spillover := NewSpillover(&mockSaturationDetector{saturated: test.saturated}, test.priorities...)
+			directorA := newTestPoolDirector(&mockDatastore{
+				pool:     datalayer.NewEndpointPool(poolA.Namespace, poolA.Name),
+				pods:     []backendmetrics.PodMetrics{newTestPoolPod("a1")},
+				rewrites: []*v1alpha2.InferenceModelRewrite{newSpilloverRewrite(test.fallbacks...)},
+			}, NewConfig()).WithSpillover(spillover)
+			directorB := newTestPoolDirector(&mockDatastore{
+				pool: datalayer.NewEndpointPool(poolB.Namespace, poolB.Name),
+				pods: []backendmetrics.PodMetrics{newTestPoolPod("b1")},
+			}, NewConfig()).WithSpillover(spillover)
+			spillover.WithPool(poolA, directorA).WithPool(poolB, directorB)
+
+			bodyMap := map[string]any{"model": "model", "prompt": "hi"}
+			reqCtx := &handlers.RequestContext{
+				IncomingModelName: "model",
+				TargetModelName:   "model",
+				Request:           &handlers.Request{Headers: map[string]string{}},
+				SchedulingRequest: &fwksched.LLMRequest{TargetModel: "model"},
+			}
+
+			target := directorA.spill(context.Background(), reqCtx, &fwksched.LLMRequestBody{ParsedBody: bodyMap}, test.priority)
+			assert.Equal(t, test.wantPool, reqCtx.Pool)
+			if test.wantPool == poolB {
+				assert.Same(t, directorB, target)
+			} else {
+				assert.Same(t, directorA, target)
+			}
+			assert.Equal(t, test.wantModel, reqCtx.TargetModelName)
+			assert.Equal(t, test.wantModel, reqCtx.SchedulingRequest.TargetModel)
+			assert.Equal(t, test.wantModel, bodyMap["model"])
+			assert.Equal(t, test.wantSpill, reqCtx.BodyMutated, "the body must be marked as mutated only on spillover")
+			assert.Nil(t, reqCtx.Request.RawBody, "the body must be serialized once, when the request is prepared")
+		})
+	}
+}
+
+func TestDirector_SpillKeepsAdmissionInSamePool(t *testing.T) {
+	ds := &mockDatastore{
+		pool:     datalayer.NewEndpointPool("default", "pool-a"),
+		pods:     []backendmetrics.PodMetrics{newTestPoolPod("a1")},
+		rewrites: []*v1alpha2.InferenceModelRewrite{newSpilloverRewrite(v1alpha2.FallbackTarget{ModelRewrite: "model-small"})},
+	}
+	admissionErr := errcommon.Error{Code: errcommon.ResourceExhausted, Msg: "system saturated"}
+	reqCtx := &handlers.RequestContext{
+		Request: &handlers.Request{
+			Headers: map[string]string{},
+			RawBody: []byte(`{"model": "model", "prompt": "hi"}`),
+		},
+	}
+
+	// Requests without an InferenceObjective get the default priority 0.
+	spillover := NewSpillover(&mockSaturationDetector{saturated: map[string]bool{"a1": true}}, 0)
+	director := NewDirectorWithConfig(ds, &mockScheduler{}, &mockAdmissionController{admitErr: admissionErr},
+		openai.NewOpenAIParser(), NewDatastorePodLocator(ds), NewConfig()).WithSpillover(spillover)
+	reqCtx, err := director.HandleRequest(context.Background(), reqCtx)
+	require.ErrorIs(t, err, admissionErr, "the saturated pool should still reject the spilled over request")
+	assert.Equal(t, "model-small", reqCtx.TargetModelName)
+}
@@ -165,11 +165,13 @@ func (d *Detector) PreRequest(_ context.Context, _ *framework.LLMRequest, result
 	d.tracker.inc(result.ProfileResults[result.PrimaryProfileName].TargetEndpoints[0].GetMetadata().NamespacedName.String())
 }
 
-// ResponseComplete decrements the atomic in-flight counter for the target endpoint.
+// ResponseComplete decrements the atomic in-flight counter for the target endpoint, whatever the termination of the
+// request.
 func (d *Detector) ResponseComplete(
 	_ context.Context,
 	_ *framework.LLMRequest,
 	_ *requestcontrol.Response,
+	_ requestcontrol.Termination,
 	targetEndpoint *fwkdl.EndpointMetadata,
 ) {
 	d.tracker.dec(targetEndpoint.NamespacedName.String())
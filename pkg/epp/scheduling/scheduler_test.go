@@ -150,9 +150,17 @@ func TestSchedule(t *testing.T) {
 				t.Errorf("Unexpected error, got %v, want %v", err, test.err)
 			}
 
-			if diff := cmp.Diff(test.wantRes, got, cmp.Comparer(fwksched.ScoredEndpointComparer)); diff != "" {
+			if diff := cmp.Diff(test.wantRes, got, cmp.Comparer(scoredEndpointComparer)); diff != "" {
 				t.Errorf("Unexpected output (-want +got): %v", diff)
 			}
 		})
 	}
 }
+
+// scoredEndpointComparer compares scored endpoints by score, metadata and metrics. Attributes are left
+// out, as scorers reading them change the internal state of the attribute map.
+func scoredEndpointComparer(a, b fwksched.ScoredEndpoint) bool {
+	return a.Score == b.Score &&
+		cmp.Equal(a.GetMetadata(), b.GetMetadata()) &&
+		cmp.Equal(a.GetMetrics(), b.GetMetrics())
+}
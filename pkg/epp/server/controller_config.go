@@ -28,6 +28,11 @@ type ControllerConfig struct {
 	startCrdReconcilers       bool
 	hasInferenceObjective     bool
 	hasInferenceModelRewrites bool
+	// multiPoolGroup is the group of the InferencePools when the Endpoint Picker serves several pools.
+	multiPoolGroup string
+	// discoversEndpoints is set when the endpoints are discovered from a file or DNS, rather than
+	// from the Pods watched through Kubernetes.
+	discoversEndpoints bool
 }
 
 func NewControllerConfig(startCrdReconcilers bool) ControllerConfig {
@@ -36,6 +41,23 @@ func NewControllerConfig(startCrdReconcilers bool) ControllerConfig {
 	}
 }
 
+// NewMultiPoolControllerConfig returns the configuration of an Endpoint Picker serving several
+// InferencePools of the given group, rather than the single pool identified by its GKNN.
+func NewMultiPoolControllerConfig(poolGroup string) ControllerConfig {
+	return ControllerConfig{
+		startCrdReconcilers: true,
+		multiPoolGroup:      poolGroup,
+	}
+}
+
+// NewEndpointDiscoveryControllerConfig returns the configuration of an Endpoint Picker whose
+// endpoints are discovered from a file or DNS, which runs no controller.
+func NewEndpointDiscoveryControllerConfig() ControllerConfig {
+	return ControllerConfig{
+		discoversEndpoints: true,
+	}
+}
+
 func (cc *ControllerConfig) PopulateControllerConfig(cfg *rest.Config) error {
 	if !cc.startCrdReconcilers {
 		return nil
@@ -17,10 +17,13 @@ limitations under the License.
 package server
 
 import (
+	"context"
 	"fmt"
+	"sort"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -59,6 +62,11 @@ func defaultManagerOptions(cfg ControllerConfig, gknn common.GKNN, metricsServer
 		},
 		Metrics: metricsServerOptions,
 	}
+	if cfg.discoversEndpoints {
+		// Nothing is watched, and the manager must not reach the API server to set up the cache.
+		opt.Cache = cache.Options{}
+		return opt, nil
+	}
 	if cfg.startCrdReconcilers {
 		if cfg.hasInferenceObjective {
 			opt.Cache.ByObject[&v1alpha2.InferenceObjective{}] = cache.ByObject{Namespaces: map[string]cache.Config{
@@ -71,21 +79,27 @@ func defaultManagerOptions(cfg ControllerConfig, gknn common.GKNN, metricsServer
 			}}
 		}
 
-		switch gknn.Group {
+		// A single pool is cached by name. Several pools are cached namespace wide and each pool
+		// reconciler filters its own pool.
+		poolGroup := gknn.Group
+		poolCache := cache.Config{FieldSelector: fields.SelectorFromSet(fields.Set{
+			"metadata.name": gknn.Name,
+		})}
+		if cfg.multiPoolGroup != "" {
+			poolGroup = cfg.multiPoolGroup
+			poolCache = cache.Config{}
+		}
+		switch poolGroup {
 		case v1alpha2.GroupName:
 			opt.Cache.ByObject[&v1alpha2.InferencePool{}] = cache.ByObject{
-				Namespaces: map[string]cache.Config{gknn.Namespace: {FieldSelector: fields.SelectorFromSet(fields.Set{
-					"metadata.name": gknn.Name,
-				})}},
+				Namespaces: map[string]cache.Config{gknn.Namespace: poolCache},
 			}
 		case v1.GroupName:
 			opt.Cache.ByObject[&v1.InferencePool{}] = cache.ByObject{
-				Namespaces: map[string]cache.Config{gknn.Namespace: {FieldSelector: fields.SelectorFromSet(fields.Set{
-					"metadata.name": gknn.Name,
-				})}},
+				Namespaces: map[string]cache.Config{gknn.Namespace: poolCache},
 			}
 		default:
-			return ctrl.Options{}, fmt.Errorf("unknown group: %s", gknn.Group)
+			return ctrl.Options{}, fmt.Errorf("unknown group: %s", poolGroup)
 		}
 	}
 
@@ -126,3 +140,41 @@ func NewManagerWithOptions(restConfig *rest.Config, opts manager.Options) (ctrl.
 	}
 	return manager, nil
 }
+
+// ListInferencePoolNames returns the names of the InferencePools of the given group in the
+// namespace that match the label selector. It is used to resolve --startup-pool-selector.
+func ListInferencePoolNames(ctx context.Context, restConfig *rest.Config, group, namespace, selector string) ([]string, error) {
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pool selector %q: %w", selector, err)
+	}
+	c, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	opts := []client.ListOption{client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: sel}}
+
+	var names []string
+	switch group {
+	case v1alpha2.GroupName:
+		list := &v1alpha2.InferencePoolList{}
+		if err := c.List(ctx, list, opts...); err != nil {
+			return nil, fmt.Errorf("failed to list InferencePools: %w", err)
+		}
+		for _, pool := range list.Items {
+			names = append(names, pool.Name)
+		}
+	case v1.GroupName:
+		list := &v1.InferencePoolList{}
+		if err := c.List(ctx, list, opts...); err != nil {
+			return nil, fmt.Errorf("failed to list InferencePools: %w", err)
+		}
+		for _, pool := range list.Items {
+			names = append(names, pool.Name)
+		}
+	default:
+		return nil, fmt.Errorf("unknown group: %s", group)
+	}
+	sort.Strings(names)
+	return names, nil
+}
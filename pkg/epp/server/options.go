@@ -22,9 +22,11 @@ import (
 	"time"
 
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	fwkplugin "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 )
 
 const (
@@ -46,12 +48,31 @@ type Options struct {
 	PoolNamespace string // Namespace of the InferencePool this Endpoint Picker is associated with.
 	PoolName      string // Name of the InferencePool this Endpoint Picker is associated with.
 	//
+	// Multiple InferencePools (in lieu of a single pool name).
+	//
+	PoolNames           []string // Names of the InferencePools this Endpoint Picker serves.
+	StartupPoolSelector string   // Label selector of the InferencePools this Endpoint Picker serves, resolved once at startup.
+	//
+	// Spillover to the fallback targets of InferenceModelRewrite rules.
+	//
+	SpilloverPriorities []int // Priorities, besides the sheddable ones, of requests that may spill over.
+	//
+	// InferenceObjective status reporting.
+	//
+	ObjectiveStatsReportInterval time.Duration // Interval to report request statistics in the status of InferenceObjectives, 0 disables it.
+	//
 	// Endpoints (in lieu of using an InferencePool for service discovery).
 	//
 	EndpointSelector            string // Selector to filter model server pods on, only 'key=value' pairs are supported. (TODO: k8s.Selector, pflag.StringSlice?)
 	EndpointTargetPorts         []int  // Target ports of model server pods.
 	DisableEndpointSubsetFilter bool   // Disables respecting x-gateway-destination-endpoint-subset in EPP.
 	//
+	// Endpoints discovered without Kubernetes (in lieu of Pods).
+	//
+	EndpointsFile               string        // Path of a YAML or JSON file listing the model server endpoints, watched for changes.
+	EndpointsDNSSRV             string        // DNS name whose SRV records are the model server endpoints.
+	EndpointsDNSRefreshInterval time.Duration // Interval to resolve the SRV records of EndpointsDNSSRV again.
+	//
 	// MSP metrics scraping.
 	//
 	ModelServerMetricsScheme         string        // Protocol scheme used in scraping metrics from endpoints.
@@ -94,14 +115,17 @@ func NewOptions() *Options {
 	return &Options{ // "zero" values are no explicitly set
 		GRPCPort:                         DefaultGrpcPort,
 		PoolGroup:                        "inference.networking.k8s.io",
+		PoolNames:                        []string{},
+		SpilloverPriorities:              []int{},
 		EndpointTargetPorts:              []int{},
 		DisableEndpointSubsetFilter:      false,
+		EndpointsDNSRefreshInterval:      30 * time.Second,
 		ModelServerMetricsScheme:         "http",
 		ModelServerMetricsPath:           "/metrics",
 		ModelServerMetricsHTTPSInsecure:  true,
 		RefreshMetricsInterval:           50 * time.Millisecond,
 		RefreshPrometheusMetricsInterval: 5 * time.Second,
-		MetricsStalenessThreshold:        2 * time.Second,
+		MetricsStalenessThreshold:        fwkplugin.DefaultMetricsStalenessThreshold,
 		TotalQueuedRequestsMetric:        "vllm:num_requests_waiting",
 		TotalRunningRequestsMetric:       "vllm:num_requests_running",
 		KVCacheUsagePercentageMetric:     "vllm:kv_cache_usage_perc",
@@ -131,6 +155,17 @@ func (opts *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&opts.PoolNamespace, "pool-namespace", opts.PoolNamespace,
 		"Namespace of the InferencePool this Endpoint Picker is associated with.")
 	fs.StringVar(&opts.PoolName, "pool-name", opts.PoolName, "Name of the InferencePool this Endpoint Picker is associated with.")
+	fs.StringSliceVar(&opts.PoolNames, "pool-names", opts.PoolNames, "Names of the InferencePools this Endpoint Picker serves, all in pool-namespace. "+
+		"Format: a comma-separated list of names without whitespace (e.g., 'llama-pool,qwen-pool').")
+	fs.StringVar(&opts.StartupPoolSelector, "startup-pool-selector", opts.StartupPoolSelector,
+		"Label selector of the InferencePools in pool-namespace this Endpoint Picker serves. The selector is resolved once, at startup, "+
+			"and is not watched: pools created or labeled afterwards are not served until the Endpoint Picker restarts.")
+	fs.IntSliceVar(&opts.SpilloverPriorities, "spillover-priorities", opts.SpilloverPriorities,
+		"Priorities, besides the sheddable (negative) ones, of requests that may spill over to the fallback targets of their "+
+			"InferenceModelRewrite rule when the pool is saturated. Format: a comma-separated list of numbers without whitespace (e.g., '0,1').")
+	fs.DurationVar(&opts.ObjectiveStatsReportInterval, "objective-stats-report-interval", opts.ObjectiveStatsReportInterval,
+		"Interval to report the request rate, latencies and SLO attainment observed for each InferenceObjective in its status. "+
+			"Reporting is disabled if 0.")
 	fs.StringVar(&opts.EndpointSelector, "endpoint-selector", opts.EndpointSelector,
 		"Selector to filter model server pods on, only 'key=value' pairs are supported. "+
 			"Format: a comma-separated list of key=value pairs without whitespace (e.g., 'app=vllm-qwen3-32b,env=prod').")
@@ -138,6 +173,15 @@ func (opts *Options) AddFlags(fs *pflag.FlagSet) {
 		"Format: a comma-separated list of numbers without whitespace (e.g., '3000,3001,3002').")
 	fs.BoolVar(&opts.DisableEndpointSubsetFilter, "disable-endpoint-subset-filter", opts.DisableEndpointSubsetFilter,
 		"Disables respecting the x-gateway-destination-endpoint-subset metadata for dispatching requests in EPP.")
+	fs.StringVar(&opts.EndpointsFile, "endpoints-file", opts.EndpointsFile,
+		"Path of a YAML or JSON file listing the model server endpoints (address, port and labels), which is watched for changes. "+
+			"The Endpoint Picker then runs without Kubernetes.")
+	fs.StringVar(&opts.EndpointsDNSSRV, "endpoints-dns-srv", opts.EndpointsDNSSRV,
+		"DNS name whose SRV records are the model server endpoints (e.g., '_http._tcp.vllm.example.com'). "+
+			"Only the records with the lowest priority are used, and their weights are ignored. "+
+			"The Endpoint Picker then runs without Kubernetes.")
+	fs.DurationVar(&opts.EndpointsDNSRefreshInterval, "endpoints-dns-refresh-interval", opts.EndpointsDNSRefreshInterval,
+		"Interval to resolve the SRV records of endpoints-dns-srv again.")
 	fs.StringVar(&opts.ModelServerMetricsScheme, "model-server-metrics-scheme", opts.ModelServerMetricsScheme,
 		"Protocol scheme used in scraping metrics from endpoints.")
 	_ = fs.MarkDeprecated("model-server-metrics-scheme", "This flag is deprecated. Configure via EndpointPickerConfig data layer plugin parameters instead.")
@@ -197,14 +241,33 @@ func (opts *Options) Complete() error {
 	// from raw string to k8s.LabelSelector, load ConfigFile into ConfigText, etc.
 
 	opts.EndpointTargetPorts = removeDuplicatePorts(opts.EndpointTargetPorts)
+	opts.PoolNames = removeDuplicateNames(opts.PoolNames)
 
 	// Complete logging options.
 	return opts.LoggingOptions.Complete()
 }
 
+// DiscoversEndpoints reports whether the model server endpoints are discovered from a file or DNS,
+// in which case the Endpoint Picker runs without Kubernetes.
+func (opts *Options) DiscoversEndpoints() bool {
+	return opts.EndpointsFile != "" || opts.EndpointsDNSSRV != ""
+}
+
 func (opts *Options) Validate() error {
-	if (opts.PoolName != "" && opts.EndpointSelector != "") || (opts.PoolName == "" && opts.EndpointSelector == "") {
-		return errors.New("either pool-name or endpoint-selector must be set")
+	poolSources := 0
+	for _, set := range []bool{opts.PoolName != "", len(opts.PoolNames) > 0, opts.StartupPoolSelector != "", opts.EndpointSelector != "",
+		opts.EndpointsFile != "", opts.EndpointsDNSSRV != ""} {
+		if set {
+			poolSources++
+		}
+	}
+	if poolSources != 1 {
+		return errors.New("exactly one of pool-name, pool-names, startup-pool-selector, endpoint-selector, endpoints-file or endpoints-dns-srv must be set")
+	}
+	if opts.StartupPoolSelector != "" {
+		if _, err := labels.Parse(opts.StartupPoolSelector); err != nil {
+			return fmt.Errorf("invalid %q value %q: %w", "startup-pool-selector", opts.StartupPoolSelector, err)
+		}
 	}
 	if opts.EndpointSelector != "" {
 		if len(opts.EndpointTargetPorts) == 0 || len(opts.EndpointTargetPorts) > 8 {
@@ -217,6 +280,25 @@ func (opts *Options) Validate() error {
 		}
 	}
 
+	if opts.EndpointsDNSSRV != "" && opts.EndpointsDNSRefreshInterval <= 0 {
+		return fmt.Errorf("flag %q must be positive", "endpoints-dns-refresh-interval")
+	}
+	if opts.DiscoversEndpoints() && opts.EnableLeaderElection {
+		return fmt.Errorf("flag %q is not supported with %q or %q, as leader election requires Kubernetes",
+			"ha-enable-leader-election", "endpoints-file", "endpoints-dns-srv")
+	}
+	if opts.DiscoversEndpoints() && opts.MetricsEndpointAuth {
+		return fmt.Errorf("flag %q must be set to false with %q or %q, as authenticating the metrics endpoint requires Kubernetes",
+			"metrics-endpoint-auth", "endpoints-file", "endpoints-dns-srv")
+	}
+
+	if opts.ObjectiveStatsReportInterval < 0 {
+		return fmt.Errorf("flag %q must not be negative", "objective-stats-report-interval")
+	}
+	if opts.ObjectiveStatsReportInterval > 0 && (opts.EndpointSelector != "" || opts.DiscoversEndpoints()) {
+		return fmt.Errorf("flag %q is not supported in standalone mode, as there are no InferenceObjectives", "objective-stats-report-interval")
+	}
+
 	if opts.ConfigText != "" && opts.ConfigFile != "" {
 		return fmt.Errorf("both the %q and %q flags can not be set at the same time", "configText", "configFile")
 	}
@@ -259,3 +341,16 @@ func removeDuplicatePorts(ports []int) []int {
 	}
 	return unique
 }
+
+func removeDuplicateNames(names []string) []string {
+	seen := sets.New[string]()
+	unique := make([]string, 0, len(names))
+
+	for _, name := range names {
+		if !seen.Has(name) {
+			unique = append(unique, name)
+			seen.Insert(name)
+		}
+	}
+	return unique
+}
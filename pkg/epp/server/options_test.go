@@ -120,3 +120,107 @@ func TestEndpointTargetPorts(t *testing.T) {
 		})
 	}
 }
+
+func TestPoolSources(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		expectError   bool
+		expectedNames []string
+	}{
+		{
+			name:          "Single pool",
+			args:          []string{"--pool-name", "pool-a"},
+			expectedNames: []string{},
+		},
+		{
+			name:          "Pool names with duplicates",
+			args:          []string{"--pool-names", "pool-b,pool-a", "--pool-names", "pool-b"},
+			expectedNames: []string{"pool-b", "pool-a"},
+		},
+		{
+			name:          "Startup pool selector",
+			args:          []string{"--startup-pool-selector", "team=ml"},
+			expectedNames: []string{},
+		},
+		{
+			name:        "Invalid startup pool selector",
+			args:        []string{"--startup-pool-selector", "team in ("},
+			expectError: true,
+		},
+		{
+			name:        "Pool name and pool names",
+			args:        []string{"--pool-name", "pool-a", "--pool-names", "pool-b"},
+			expectError: true,
+		},
+		{
+			name:        "Startup pool selector and endpoint selector",
+			args:        []string{"--startup-pool-selector", "team=ml", "--endpoint-selector", "app=vllm", "--endpoint-target-ports", "8000"},
+			expectError: true,
+		},
+		{
+			name:          "Endpoints file",
+			args:          []string{"--endpoints-file", "endpoints.yaml", "--metrics-endpoint-auth=false"},
+			expectedNames: []string{},
+		},
+		{
+			name:          "Endpoints DNS SRV",
+			args:          []string{"--endpoints-dns-srv", "_http._tcp.vllm.example.com", "--metrics-endpoint-auth=false"},
+			expectedNames: []string{},
+		},
+		{
+			name:        "Endpoints file and endpoints DNS SRV",
+			args:        []string{"--endpoints-file", "endpoints.yaml", "--endpoints-dns-srv", "_http._tcp.vllm.example.com"},
+			expectError: true,
+		},
+		{
+			name:        "Endpoints DNS SRV without refresh interval",
+			args:        []string{"--endpoints-dns-srv", "_http._tcp.vllm.example.com", "--endpoints-dns-refresh-interval", "0s"},
+			expectError: true,
+		},
+		{
+			name:        "Endpoints file with leader election",
+			args:        []string{"--endpoints-file", "endpoints.yaml", "--ha-enable-leader-election"},
+			expectError: true,
+		},
+		{
+			name:        "Endpoints file with metrics endpoint authentication",
+			args:        []string{"--endpoints-file", "endpoints.yaml"},
+			expectError: true,
+		},
+		{
+			name:        "No pool source",
+			args:        []string{},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := pflag.NewFlagSet(tt.name, pflag.ContinueOnError)
+			opts := NewOptions()
+			opts.AddFlags(fs)
+
+			if err := fs.Parse(append([]string{"--config-file", "fake-config.yaml"}, tt.args...)); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+			if err := opts.Complete(); err != nil {
+				t.Fatalf("Complete failed unexpectedly with error: %v", err)
+			}
+
+			err := opts.Validate()
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("Expected a validation error but got none.")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate failed unexpectedly with error: %v", err)
+			}
+			if diff := cmp.Diff(tt.expectedNames, opts.PoolNames); diff != "" {
+				t.Errorf("Resulting pool names mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
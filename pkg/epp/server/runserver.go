@@ -30,6 +30,7 @@ import (
 	healthgrpc "google.golang.org/grpc/health/grpc_health_v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -42,7 +43,6 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/datastore"
 	fwkrh "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/requesthandling"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/handlers"
-	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/requestcontrol"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/saturationdetector/framework/plugins/utilizationdetector"
 )
 
@@ -58,10 +58,19 @@ type ExtProcServerRunner struct {
 	EnableCertReload                 bool
 	RefreshPrometheusMetricsInterval time.Duration
 	MetricsStalenessThreshold        time.Duration
-	Director                         *requestcontrol.Director
+	Director                         handlers.Director
 	Parser                           fwkrh.Parser
 	SaturationDetector               *utilizationdetector.Detector
 	UseExperimentalDatalayerV2       bool // Pluggable data layer feature flag
+	// Pools lists the InferencePools served when the Endpoint Picker serves several pools. When set,
+	// GKNN identifies the Endpoint Picker itself and Director routes requests between the pools.
+	Pools []PoolServer
+}
+
+// PoolServer holds the per-pool state of an Endpoint Picker that serves several InferencePools.
+type PoolServer struct {
+	GKNN      common.GKNN
+	Datastore datastore.Datastore
 }
 
 // NewDefaultExtProcServerRunner creates a runner with default values.
@@ -82,7 +91,7 @@ func NewDefaultExtProcServerRunner() *ExtProcServerRunner {
 	return &ExtProcServerRunner{
 		GrpcPort:                         opts.GRPCPort,
 		GKNN:                             gknn,
-		ControllerCfg:                    ControllerConfig{true, true, true},
+		ControllerCfg:                    ControllerConfig{startCrdReconcilers: true, hasInferenceObjective: true, hasInferenceModelRewrites: true},
 		SecureServing:                    opts.SecureServing,
 		HealthChecking:                   opts.HealthChecking,
 		RefreshPrometheusMetricsInterval: opts.RefreshPrometheusMetricsInterval,
@@ -93,53 +102,103 @@ func NewDefaultExtProcServerRunner() *ExtProcServerRunner {
 
 // SetupWithManager sets up the runner with the given manager.
 func (r *ExtProcServerRunner) SetupWithManager(mgr ctrl.Manager) error {
+	if len(r.Pools) == 0 {
+		return r.setupPoolControllers(mgr, r.GKNN, r.Datastore, "")
+	}
+	for _, pool := range r.Pools {
+		if err := r.setupPoolControllers(mgr, pool.GKNN, pool.Datastore, "-"+pool.GKNN.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setupPoolControllers registers the controllers of a single pool with the manager. The name
+// suffix keeps the controller names unique when several pools share the manager.
+func (r *ExtProcServerRunner) setupPoolControllers(mgr ctrl.Manager, gknn common.GKNN, ds datastore.Datastore, nameSuffix string) error {
+	controllerName := func(name string) string {
+		if nameSuffix == "" {
+			return ""
+		}
+		return name + nameSuffix
+	}
+
 	// Create the controllers and register them with the manager
 	if r.ControllerCfg.startCrdReconcilers {
 		if err := (&controller.InferencePoolReconciler{
-			Datastore: r.Datastore,
-			Reader:    mgr.GetClient(),
-			PoolGKNN:  r.GKNN,
+			Datastore:      ds,
+			Reader:         mgr.GetClient(),
+			PoolGKNN:       gknn,
+			ControllerName: controllerName("inferencepool"),
 		}).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("failed setting up InferencePoolReconciler - %w", err)
 		}
 
 		if r.ControllerCfg.hasInferenceObjective {
 			if err := (&controller.InferenceObjectiveReconciler{
-				Datastore: r.Datastore,
-				Reader:    mgr.GetClient(),
-				PoolGKNN:  r.GKNN,
+				Datastore:      ds,
+				Reader:         mgr.GetClient(),
+				PoolGKNN:       gknn,
+				ControllerName: controllerName("inferenceobjective"),
 			}).SetupWithManager(mgr); err != nil {
 				return fmt.Errorf("failed setting up InferenceObjectiveReconciler - %w", err)
 			}
 		}
 		if r.ControllerCfg.hasInferenceModelRewrites {
 			if err := (&controller.InferenceModelRewriteReconciler{
-				Datastore: r.Datastore,
-				Reader:    mgr.GetClient(),
-				PoolGKNN:  r.GKNN,
+				Datastore:      ds,
+				Client:         mgr.GetClient(),
+				PoolGKNN:       gknn,
+				ServedPools:    r.servedPools(),
+				ControllerName: controllerName("inferencemodelrewrite"),
 			}).SetupWithManager(mgr); err != nil {
 				return fmt.Errorf("failed setting up InferenceModelRewriteReconciler - %w", err)
 			}
 		}
 	}
 
+	if r.ControllerCfg.discoversEndpoints {
+		return nil
+	}
 	if err := (&controller.PodReconciler{
-		Datastore: r.Datastore,
-		Reader:    mgr.GetClient(),
+		Datastore:      ds,
+		Reader:         mgr.GetClient(),
+		ControllerName: controllerName("pod"),
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("failed setting up PodReconciler - %w", err)
 	}
 	return nil
 }
 
+// servedPools returns the names of the InferencePools served by the Endpoint Picker.
+func (r *ExtProcServerRunner) servedPools() sets.Set[types.NamespacedName] {
+	if len(r.Pools) == 0 {
+		return sets.New(r.GKNN.NamespacedName)
+	}
+	pools := sets.New[types.NamespacedName]()
+	for _, pool := range r.Pools {
+		pools.Insert(pool.GKNN.NamespacedName)
+	}
+	return pools
+}
+
 // AsRunnable returns a Runnable that can be used to start the ext-proc gRPC server.
 // The runnable implements LeaderElectionRunnable with leader election disabled.
 func (r *ExtProcServerRunner) AsRunnable(logger logr.Logger) manager.Runnable {
 	return runnable.NoLeaderElection(manager.RunnableFunc(func(ctx context.Context) error {
-		if r.UseExperimentalDatalayerV2 {
-			datalayerlogger.StartMetricsLogger(ctx, r.Datastore, r.RefreshPrometheusMetricsInterval, r.MetricsStalenessThreshold)
-		} else {
-			backendmetrics.StartMetricsLogger(ctx, r.Datastore, r.RefreshPrometheusMetricsInterval, r.MetricsStalenessThreshold)
+		datastores := []datastore.Datastore{r.Datastore}
+		if len(r.Pools) > 0 {
+			datastores = make([]datastore.Datastore, 0, len(r.Pools))
+			for _, pool := range r.Pools {
+				datastores = append(datastores, pool.Datastore)
+			}
+		}
+		for _, ds := range datastores {
+			if r.UseExperimentalDatalayerV2 {
+				datalayerlogger.StartMetricsLogger(ctx, ds, r.RefreshPrometheusMetricsInterval, r.MetricsStalenessThreshold)
+			} else {
+				backendmetrics.StartMetricsLogger(ctx, ds, r.RefreshPrometheusMetricsInterval, r.MetricsStalenessThreshold)
+			}
 		}
 
 		var srv *grpc.Server
@@ -180,7 +239,7 @@ func (r *ExtProcServerRunner) AsRunnable(logger logr.Logger) manager.Runnable {
 			srv = grpc.NewServer()
 		}
 
-		extProcServer := handlers.NewStreamingServer(r.Datastore, r.Director, r.Parser)
+		extProcServer := handlers.NewStreamingServer(datastores[0], r.Director, r.Parser)
 		extProcPb.RegisterExternalProcessorServer(srv, extProcServer)
 
 		if r.HealthChecking {
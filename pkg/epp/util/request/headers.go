@@ -49,3 +49,16 @@ func IsSystemOwnedHeader(key string) bool {
 	k := strings.ToLower(key)
 	return InputControlHeaders.Has(k) || OutputInjectionHeaders.Has(k) || ProtocolHeaders.Has(k)
 }
+
+// HeaderValue returns the value of the header with the given case-insensitive name.
+func HeaderValue(headers map[string]string, name string) (string, bool) {
+	if value, ok := headers[strings.ToLower(name)]; ok {
+		return value, true
+	}
+	for key, value := range headers {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+	return "", false
+}
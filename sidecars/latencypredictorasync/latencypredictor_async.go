@@ -39,6 +39,7 @@ type Predictor struct {
 	cachedMetrics *MetricsResponse
 	modelInfo     *ModelInfo
 	serverStatus  *ServerStatusResponse
+	nativeModels  *nativeModels
 
 	bufferMu sync.Mutex
 	pending  []TrainingEntry
@@ -169,13 +170,18 @@ func (p *Predictor) GetCachedMetrics() (*MetricsResponse, bool) {
 
 // IsXGBoostReady returns true if native XGBoost models are loaded and ready.
 func (p *Predictor) IsXGBoostReady() bool {
-	return p.modelInfo != nil && p.modelInfo.ModelType == xgBoostModelType
+	p.metricsMu.RLock()
+	defer p.metricsMu.RUnlock()
+	return p.nativeModels != nil && p.nativeModels.modelType == xgBoostModelType
 }
 
-// IsLightGBMReady returns true if LightGBM models are available via HTTP.
+// IsLightGBMReady returns true if LightGBM models are loaded natively or available via HTTP.
 func (p *Predictor) IsLightGBMReady() bool {
 	p.metricsMu.RLock()
 	defer p.metricsMu.RUnlock()
+	if p.nativeModels != nil && p.nativeModels.modelType == gbmModelType {
+		return true
+	}
 	return p.modelInfo != nil && p.modelInfo.ModelType == gbmModelType && len(p.config.PredictionURLs) > 0
 }
 
@@ -242,8 +248,8 @@ func (p *Predictor) IsReady() bool {
 	case bayesianRidgeModelType:
 		return p.IsBayesianRidgeReady()
 	case xgBoostModelType:
-		// Ready if we have prediction URLs for HTTP calls
-		return len(p.config.PredictionURLs) > 0
+		// Ready if trees are loaded or we have prediction URLs for HTTP calls
+		return p.IsXGBoostReady() || len(p.config.PredictionURLs) > 0
 	case gbmModelType:
 		// Ready if we have prediction URLs for HTTP calls
 		return p.IsLightGBMReady()
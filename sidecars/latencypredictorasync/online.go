@@ -0,0 +1,574 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latencypredictorasync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+)
+
+const (
+	onlineModelType = "online"
+	// onlineStateVersion is bumped whenever the persisted state is not compatible anymore.
+	onlineStateVersion = 1
+	// maxStandardizedFeature bounds standardized features, so that outliers can't
+	// blow up a single update.
+	maxStandardizedFeature = 5
+	// onlineRidge regularizes the regression, keeping it well defined for
+	// features that never vary, such as tokens in flight that are not tracked.
+	onlineRidge = 1e-3
+	// residualQuantileStep is the step size of the streaming estimate of the
+	// residual quantile, in standard deviations.
+	residualQuantileStep = 0.01
+)
+
+// Features of the online TTFT and TPOT models, indexes into a treeFeatureVector.
+var (
+	onlineTTFTFeatures = []int{
+		featIsQueued, featKVCachePercentage, featInputTokenLength, featNumRequestWaiting, featNumRequestRunning,
+		featPrefixCacheScore, featEffectiveInputTokens, featPrefillTokensInFlight, featDecodeTokensInFlight,
+	}
+	onlineTPOTFeatures = []int{
+		featIsQueued, featKVCachePercentage, featInputTokenLength, featNumRequestWaiting, featNumRequestRunning,
+		featNumTokensGenerated, featPrefillTokensInFlight, featDecodeTokensInFlight,
+	}
+)
+
+// OnlineConfig configures an OnlinePredictor.
+type OnlineConfig struct {
+	// ObjectiveType is ObjectiveQuantile to predict a latency quantile, or ObjectiveMean.
+	ObjectiveType string
+	// Quantile is the latency quantile predicted with the quantile objective, in (0, 1).
+	Quantile float64
+	// ForgettingFactor is the weight kept by past samples each time a sample is
+	// learned, in (0, 1]. The model effectively remembers the last
+	// 1/(1-ForgettingFactor) samples, so that it follows changing latencies.
+	ForgettingFactor float64
+	// MinSamples is the number of samples a model must be trained on before it predicts.
+	MinSamples int
+	// StateFile persists the model state across restarts when set.
+	StateFile string
+	// SaveInterval is how often the state is written to StateFile.
+	SaveInterval time.Duration
+}
+
+func DefaultOnlineConfig() *OnlineConfig {
+	return &OnlineConfig{
+		ObjectiveType:    ObjectiveQuantile,
+		Quantile:         0.9,
+		ForgettingFactor: 0.9995,
+		MinSamples:       100,
+		SaveInterval:     time.Minute,
+	}
+}
+
+func (c *OnlineConfig) validate() error {
+	var errs []error
+	switch c.ObjectiveType {
+	case ObjectiveQuantile:
+		if c.Quantile <= 0 || c.Quantile >= 1 {
+			errs = append(errs, fmt.Errorf("quantile must be in (0, 1), got %f", c.Quantile))
+		}
+	case ObjectiveMean:
+	default:
+		errs = append(errs, fmt.Errorf("objective type must be %q or %q, got %q", ObjectiveQuantile, ObjectiveMean, c.ObjectiveType))
+	}
+	if c.ForgettingFactor <= 0 || c.ForgettingFactor > 1 {
+		errs = append(errs, fmt.Errorf("forgetting factor must be in (0, 1], got %f", c.ForgettingFactor))
+	}
+	if c.MinSamples < 0 {
+		errs = append(errs, fmt.Errorf("min samples must be >= 0, got %d", c.MinSamples))
+	}
+	if c.StateFile != "" && c.SaveInterval <= 0 {
+		errs = append(errs, fmt.Errorf("save interval must be > 0, got %s", c.SaveInterval))
+	}
+	return errors.Join(errs...)
+}
+
+// --- Online regression ---
+
+// runningStats tracks the exponentially weighted mean and variance of a stream
+// of values (Welford's algorithm with weights). Count is the total weight of
+// the values seen, each of which weighs forgetting times less than the next.
+type runningStats struct {
+	Count float64 `json:"count"`
+	Mean  float64 `json:"mean"`
+	M2    float64 `json:"m2"`
+}
+
+func (s *runningStats) add(v, forgetting float64) {
+	s.Count = forgetting*s.Count + 1
+	s.M2 *= forgetting
+	delta := v - s.Mean
+	s.Mean += delta / s.Count
+	s.M2 += delta * (v - s.Mean)
+}
+
+// standardize returns v in standard deviations from the mean, or zero while
+// the values seen so far are all the same.
+func (s *runningStats) standardize(v float64) float64 {
+	if s.Count <= 1 || s.M2 <= 0 {
+		return 0
+	}
+	z := (v - s.Mean) / math.Sqrt(s.M2/(s.Count-1))
+	return math.Max(-maxStandardizedFeature, math.Min(maxStandardizedFeature, z))
+}
+
+// onlineRegressor is a linear model over standardized features, fitted by
+// exponentially weighted ridge regression and updated with every sample. For
+// the quantile objective, the quantile of its residuals is added to the mean.
+// Targets are learned relative to their running mean, so that the model does
+// not depend on the latency scale.
+type onlineRegressor struct {
+	Samples int64 `json:"samples"`
+	// XTX and XTY are the weighted sums of z zᵀ and z y over the samples seen,
+	// where z holds 1 for the intercept followed by the standardized features.
+	XTX []float64 `json:"xtx"`
+	XTY []float64 `json:"xty"`
+	// Weights solves the ridge regression for the current sums.
+	Weights []float64 `json:"weights"`
+	// ResidualMean and ResidualVar are the weighted mean and variance of the
+	// residuals, and ResidualQuantile the quantile of the standardized residuals.
+	ResidualMean     float64        `json:"residual_mean"`
+	ResidualVar      float64        `json:"residual_var"`
+	ResidualQuantile float64        `json:"residual_quantile"`
+	Stats            []runningStats `json:"stats"`
+	Target           runningStats   `json:"target"`
+
+	features []int
+}
+
+func newOnlineRegressor(features []int) *onlineRegressor {
+	d := len(features) + 1
+	return &onlineRegressor{
+		XTX:      make([]float64, d*d),
+		XTY:      make([]float64, d),
+		Weights:  make([]float64, d),
+		Stats:    make([]runningStats, len(features)),
+		features: features,
+	}
+}
+
+func (r *onlineRegressor) valid() bool {
+	d := len(r.features) + 1
+	return len(r.XTX) == d*d && len(r.XTY) == d && len(r.Weights) == d && len(r.Stats) == d-1
+}
+
+func (r *onlineRegressor) standardized(x *treeFeatureVector) []float64 {
+	z := make([]float64, len(r.features)+1)
+	z[0] = 1
+	for i, f := range r.features {
+		z[i+1] = r.Stats[i].standardize(x[f])
+	}
+	return z
+}
+
+func (r *onlineRegressor) mean(z []float64) float64 {
+	y := 0.0
+	for i, w := range r.Weights {
+		y += w * z[i]
+	}
+	return y
+}
+
+func (r *onlineRegressor) train(x *treeFeatureVector, actual float64, config *OnlineConfig) {
+	// The standardization of the features and the target scale forget past
+	// samples at the same rate as the sums the weights are solved from.
+	for i, f := range r.features {
+		r.Stats[i].add(x[f], config.ForgettingFactor)
+	}
+	r.Target.add(actual, config.ForgettingFactor)
+	r.Samples++
+
+	z := r.standardized(x)
+	y := actual / r.Target.Mean
+	// Track the residuals of the model before it learns the sample, which are
+	// the errors it makes on new requests.
+	residual := y - r.mean(z)
+	alpha := math.Max(1-config.ForgettingFactor, 1/float64(r.Samples))
+	delta := residual - r.ResidualMean
+	r.ResidualMean += alpha * delta
+	r.ResidualVar = (1 - alpha) * (r.ResidualVar + alpha*delta*delta)
+	if config.ObjectiveType == ObjectiveQuantile {
+		// Start from the quantile of a normal distribution and move towards the
+		// quantile of the standardized residuals actually seen.
+		if r.Samples == 1 {
+			r.ResidualQuantile = math.Sqrt2 * math.Erfinv(2*config.Quantile-1)
+		} else if r.ResidualVar > 0 {
+			if (residual-r.ResidualMean)/math.Sqrt(r.ResidualVar) > r.ResidualQuantile {
+				r.ResidualQuantile += residualQuantileStep * config.Quantile
+			} else {
+				r.ResidualQuantile -= residualQuantileStep * (1 - config.Quantile)
+			}
+		}
+	}
+
+	d := len(z)
+	for i := range d {
+		for j := range d {
+			r.XTX[i*d+j] = config.ForgettingFactor*r.XTX[i*d+j] + z[i]*z[j]
+		}
+		r.XTY[i] = config.ForgettingFactor*r.XTY[i] + z[i]*y
+	}
+	r.solve()
+}
+
+// solve sets the weights to the solution of (XTX + λI) w = XTY, by Cholesky decomposition.
+func (r *onlineRegressor) solve() {
+	d := len(r.XTY)
+	l := make([]float64, d*d)
+	for i := range d {
+		for j := 0; j <= i; j++ {
+			sum := r.XTX[i*d+j]
+			if i == j {
+				sum += onlineRidge
+			}
+			for k := range j {
+				sum -= l[i*d+k] * l[j*d+k]
+			}
+			if i == j {
+				if sum <= 0 {
+					// Not positive definite because of rounding; keep the previous weights.
+					return
+				}
+				l[i*d+i] = math.Sqrt(sum)
+			} else {
+				l[i*d+j] = sum / l[j*d+j]
+			}
+		}
+	}
+	// Solve L v = XTY, then Lᵀ w = v.
+	w := make([]float64, d)
+	for i := range d {
+		sum := r.XTY[i]
+		for k := range i {
+			sum -= l[i*d+k] * w[k]
+		}
+		w[i] = sum / l[i*d+i]
+	}
+	for i := d - 1; i >= 0; i-- {
+		sum := w[i]
+		for k := i + 1; k < d; k++ {
+			sum -= l[k*d+i] * w[k]
+		}
+		w[i] = sum / l[i*d+i]
+	}
+	r.Weights = w
+}
+
+// value returns the latency predicted for x, in milliseconds.
+func (r *onlineRegressor) value(x *treeFeatureVector, config *OnlineConfig) float64 {
+	y := r.mean(r.standardized(x))
+	if config.ObjectiveType == ObjectiveQuantile {
+		y += r.ResidualMean + r.ResidualQuantile*math.Sqrt(r.ResidualVar)
+	}
+	return math.Max(0, y*r.Target.Mean)
+}
+
+// onlineState is the persisted state of an OnlinePredictor.
+type onlineState struct {
+	Version       int              `json:"version"`
+	ObjectiveType string           `json:"objective_type"`
+	Quantile      float64          `json:"quantile"`
+	TTFT          *onlineRegressor `json:"ttft"`
+	TPOT          *onlineRegressor `json:"tpot"`
+}
+
+// --- Predictor ---
+
+// OnlinePredictor is a PredictorInterface that learns TTFT and TPOT models
+// from the training entries it is given, in process. It needs no training or
+// prediction server, at the cost of a simpler, linear model.
+type OnlinePredictor struct {
+	config *OnlineConfig
+	logger logr.Logger
+
+	mu    sync.RWMutex
+	ttft  *onlineRegressor
+	tpot  *onlineRegressor
+	dirty bool
+
+	wg       sync.WaitGroup
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+var _ PredictorInterface = &OnlinePredictor{}
+
+// NewOnlinePredictor returns an untrained OnlinePredictor. Start loads the
+// persisted state, if any.
+func NewOnlinePredictor(config *OnlineConfig, logger logr.Logger) (*OnlinePredictor, error) {
+	if config == nil {
+		config = DefaultOnlineConfig()
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid online predictor config: %w", err)
+	}
+	return &OnlinePredictor{
+		config: config,
+		logger: logger.WithName("online-latency-predictor"),
+		ttft:   newOnlineRegressor(onlineTTFTFeatures),
+		tpot:   newOnlineRegressor(onlineTPOTFeatures),
+		done:   make(chan struct{}),
+	}, nil
+}
+
+// Start loads the persisted state and starts saving it periodically.
+func (p *OnlinePredictor) Start(ctx context.Context) error {
+	if p.config.StateFile == "" {
+		return nil
+	}
+	if err := p.load(); err != nil {
+		// A stale or corrupt state only costs the time to learn again.
+		p.logger.Error(err, "Failed to load online latency model state, starting untrained", "file", p.config.StateFile)
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(p.config.SaveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := p.save(); err != nil {
+					p.logger.Error(err, "Failed to save online latency model state", "file", p.config.StateFile)
+				}
+			case <-p.done:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops saving periodically and saves the state a last time. Calls after
+// the first one do nothing.
+func (p *OnlinePredictor) Stop(ctx context.Context) {
+	p.stopOnce.Do(func() {
+		close(p.done)
+		p.wg.Wait()
+		if p.config.StateFile == "" {
+			return
+		}
+		if err := p.save(); err != nil {
+			p.logger.Error(err, "Failed to save online latency model state", "file", p.config.StateFile)
+		}
+	})
+}
+
+// AddTrainingDataBulk trains the TTFT model on entries with an actual TTFT
+// and the TPOT model on entries with an actual TPOT.
+func (p *OnlinePredictor) AddTrainingDataBulk(entries []TrainingEntry) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, e := range entries {
+		x := newTreeFeatureVector(PredictionRequest{
+			KVCachePercentage:     e.KVCachePercentage,
+			InputTokenLength:      e.InputTokenLength,
+			NumRequestWaiting:     e.NumRequestWaiting,
+			NumRequestRunning:     e.NumRequestRunning,
+			NumTokensGenerated:    e.NumTokensGenerated,
+			PrefixCacheScore:      e.PrefixCacheScore,
+			PodType:               e.PodType,
+			PrefillTokensInFlight: e.PrefillTokensInFlight,
+			DecodeTokensInFlight:  e.DecodeTokensInFlight,
+		})
+		if e.ActualTTFT > 0 {
+			p.ttft.train(x, e.ActualTTFT, p.config)
+			p.dirty = true
+		}
+		if e.ActualTPOT > 0 {
+			p.tpot.train(x, e.ActualTPOT, p.config)
+			p.dirty = true
+		}
+	}
+	return nil
+}
+
+// Predict predicts the TTFT and TPOT of a request. It fails until both models
+// are trained on at least MinSamples samples.
+func (p *OnlinePredictor) Predict(ctx context.Context, req PredictionRequest) (*PredictionResponse, error) {
+	if err := validatePredictionRequest(req); err != nil {
+		return nil, err
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.checkTrained(); err != nil {
+		return nil, err
+	}
+	resp := p.predict(req)
+	return &resp, nil
+}
+
+// PredictBulk predicts the TTFT and TPOT of several requests. Invalid requests
+// are counted as failed predictions.
+func (p *OnlinePredictor) PredictBulk(ctx context.Context, requests []PredictionRequest) (*BulkPredictionResponse, error) {
+	if len(requests) == 0 {
+		return nil, errors.New("no prediction requests provided")
+	}
+	start := time.Now()
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if err := p.checkTrained(); err != nil {
+		return nil, err
+	}
+	resp := &BulkPredictionResponse{TotalRequests: len(requests)}
+	for _, req := range requests {
+		if validatePredictionRequest(req) != nil {
+			resp.FailedPredictions++
+			continue
+		}
+		resp.Predictions = append(resp.Predictions, p.predict(req))
+		resp.SuccessfulPredictions++
+	}
+	resp.ProcessingTimeMs = float64(time.Since(start).Microseconds()) / 1000
+	return resp, nil
+}
+
+// PredictBulkStrict predicts the TTFT and TPOT of several requests, failing if any request is invalid.
+func (p *OnlinePredictor) PredictBulkStrict(ctx context.Context, requests []PredictionRequest) (*BulkPredictionResponse, error) {
+	if len(requests) == 0 {
+		return nil, errors.New("no prediction requests provided")
+	}
+	for i, req := range requests {
+		if err := validatePredictionRequest(req); err != nil {
+			return nil, fmt.Errorf("validation failed for request %d: %w", i, err)
+		}
+	}
+	return p.PredictBulk(ctx, requests)
+}
+
+func (p *OnlinePredictor) checkTrained() error {
+	if p.ttft.Samples < int64(p.config.MinSamples) || p.tpot.Samples < int64(p.config.MinSamples) {
+		return fmt.Errorf("online latency model not trained yet: %d TTFT and %d TPOT samples, need %d",
+			p.ttft.Samples, p.tpot.Samples, p.config.MinSamples)
+	}
+	return nil
+}
+
+func (p *OnlinePredictor) predict(req PredictionRequest) PredictionResponse {
+	x := newTreeFeatureVector(req)
+	return PredictionResponse{
+		TTFT:          p.ttft.value(x, p.config),
+		TPOT:          p.tpot.value(x, p.config),
+		PredictedAt:   time.Now(),
+		ModelType:     onlineModelType,
+		ObjectiveType: p.config.ObjectiveType,
+		Quantile:      p.config.Quantile,
+	}
+}
+
+// load restores the state saved in the state file, unless it was trained for another objective.
+func (p *OnlinePredictor) load() error {
+	data, err := os.ReadFile(p.config.StateFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var state onlineState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("failed to decode state: %w", err)
+	}
+	if state.Version != onlineStateVersion {
+		return fmt.Errorf("unsupported state version %d", state.Version)
+	}
+	if state.ObjectiveType != p.config.ObjectiveType || state.Quantile != p.config.Quantile {
+		return fmt.Errorf("state was trained for objective %q with quantile %v", state.ObjectiveType, state.Quantile)
+	}
+	for _, m := range []struct {
+		r        *onlineRegressor
+		features []int
+	}{{state.TTFT, onlineTTFTFeatures}, {state.TPOT, onlineTPOTFeatures}} {
+		if m.r == nil {
+			return errors.New("state has no model")
+		}
+		m.r.features = m.features
+		if !m.r.valid() {
+			return errors.New("state does not match the model features")
+		}
+	}
+
+	p.mu.Lock()
+	p.ttft, p.tpot = state.TTFT, state.TPOT
+	p.mu.Unlock()
+	p.logger.Info("Loaded online latency model state", "file", p.config.StateFile,
+		"ttft_samples", state.TTFT.Samples, "tpot_samples", state.TPOT.Samples)
+	return nil
+}
+
+// save writes the state to the state file if it changed since the last save.
+func (p *OnlinePredictor) save() (err error) {
+	defer func() {
+		if err != nil {
+			// Try again on the next save.
+			p.mu.Lock()
+			p.dirty = true
+			p.mu.Unlock()
+		}
+	}()
+
+	p.mu.Lock()
+	if !p.dirty {
+		p.mu.Unlock()
+		return nil
+	}
+	data, err := json.Marshal(onlineState{
+		Version:       onlineStateVersion,
+		ObjectiveType: p.config.ObjectiveType,
+		Quantile:      p.config.Quantile,
+		TTFT:          p.ttft,
+		TPOT:          p.tpot,
+	})
+	p.dirty = false
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so that a crash never leaves a partial state.
+	tmp, err := os.CreateTemp(filepath.Dir(p.config.StateFile), filepath.Base(p.config.StateFile)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), p.config.StateFile); err != nil {
+		return err
+	}
+	p.logger.V(logutil.DEBUG).Info("Saved online latency model state", "file", p.config.StateFile)
+	return nil
+}
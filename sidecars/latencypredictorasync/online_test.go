@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latencypredictorasync
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+)
+
+// syntheticEntry returns a training entry whose TTFT grows with the input
+// length and queue depth, with uniform noise of up to 100ms.
+func syntheticEntry(r *rand.Rand) TrainingEntry {
+	e := TrainingEntry{
+		KVCachePercentage:  r.Float64(),
+		InputTokenLength:   r.Intn(2000),
+		NumRequestWaiting:  r.Intn(5),
+		NumRequestRunning:  r.Intn(10),
+		NumTokensGenerated: r.Intn(100),
+		PrefixCacheScore:   r.Float64(),
+	}
+	e.ActualTTFT = 50 + 0.2*float64(e.InputTokenLength) + 100*float64(e.NumRequestWaiting) + 100*r.Float64()
+	e.ActualTPOT = 10 + 2*float64(e.NumRequestRunning) + 10*r.Float64()
+	return e
+}
+
+func entryRequest(e TrainingEntry) PredictionRequest {
+	return PredictionRequest{
+		KVCachePercentage:  e.KVCachePercentage,
+		InputTokenLength:   e.InputTokenLength,
+		NumRequestWaiting:  e.NumRequestWaiting,
+		NumRequestRunning:  e.NumRequestRunning,
+		NumTokensGenerated: e.NumTokensGenerated,
+		PrefixCacheScore:   e.PrefixCacheScore,
+	}
+}
+
+func trainOnline(t *testing.T, p *OnlinePredictor, r *rand.Rand, n int) {
+	t.Helper()
+	for range n {
+		if err := p.AddTrainingDataBulk([]TrainingEntry{syntheticEntry(r)}); err != nil {
+			t.Fatalf("AddTrainingDataBulk() error = %v", err)
+		}
+	}
+}
+
+func TestOnlinePredictorLearnsQuantile(t *testing.T) {
+	p, err := NewOnlinePredictor(nil, logr.Discard())
+	if err != nil {
+		t.Fatalf("NewOnlinePredictor() error = %v", err)
+	}
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(1))
+
+	if _, err := p.Predict(ctx, PredictionRequest{}); err == nil {
+		t.Error("Predict() before training succeeded, want an error")
+	}
+
+	trainOnline(t, p, r, 20000)
+
+	const n = 2000
+	requests := make([]PredictionRequest, n)
+	entries := make([]TrainingEntry, n)
+	for i := range entries {
+		entries[i] = syntheticEntry(r)
+		requests[i] = entryRequest(entries[i])
+	}
+	resp, err := p.PredictBulkStrict(ctx, requests)
+	if err != nil {
+		t.Fatalf("PredictBulkStrict() error = %v", err)
+	}
+	var ttftCovered, tpotCovered int
+	for i, pred := range resp.Predictions {
+		if entries[i].ActualTTFT <= pred.TTFT {
+			ttftCovered++
+		}
+		if entries[i].ActualTPOT <= pred.TPOT {
+			tpotCovered++
+		}
+	}
+	// The model is linear like the data, so coverage should be close to the 0.9 quantile.
+	for name, covered := range map[string]int{"TTFT": ttftCovered, "TPOT": tpotCovered} {
+		if coverage := float64(covered) / n; coverage < 0.85 || coverage > 0.95 {
+			t.Errorf("%s coverage = %.3f, want close to 0.9", name, coverage)
+		}
+	}
+	if got := resp.Predictions[0]; got.ModelType != onlineModelType || got.ObjectiveType != ObjectiveQuantile || got.Quantile != 0.9 {
+		t.Errorf("prediction = %+v, want the online model with the 0.9 quantile objective", got)
+	}
+
+	if _, err := p.PredictBulkStrict(ctx, []PredictionRequest{{KVCachePercentage: 2}}); err == nil {
+		t.Error("PredictBulkStrict() with an invalid request succeeded, want an error")
+	}
+	bulk, err := p.PredictBulk(ctx, []PredictionRequest{{KVCachePercentage: 2}, {}})
+	if err != nil {
+		t.Fatalf("PredictBulk() error = %v", err)
+	}
+	if bulk.SuccessfulPredictions != 1 || bulk.FailedPredictions != 1 {
+		t.Errorf("PredictBulk() = %d successful and %d failed predictions, want 1 and 1", bulk.SuccessfulPredictions, bulk.FailedPredictions)
+	}
+}
+
+func TestOnlinePredictorState(t *testing.T) {
+	ctx := context.Background()
+	config := DefaultOnlineConfig()
+	config.StateFile = filepath.Join(t.TempDir(), "model.json")
+
+	trained, err := NewOnlinePredictor(config, logr.Discard())
+	if err != nil {
+		t.Fatalf("NewOnlinePredictor() error = %v", err)
+	}
+	if err := trained.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	trainOnline(t, trained, rand.New(rand.NewSource(1)), 1000)
+	trained.Stop(ctx)
+	trained.Stop(ctx) // Stopping again does nothing.
+
+	req := PredictionRequest{KVCachePercentage: 0.5, InputTokenLength: 1000, NumRequestWaiting: 2, NumRequestRunning: 4}
+	want, err := trained.Predict(ctx, req)
+	if err != nil {
+		t.Fatalf("Predict() error = %v", err)
+	}
+
+	restored, err := NewOnlinePredictor(config, logr.Discard())
+	if err != nil {
+		t.Fatalf("NewOnlinePredictor() error = %v", err)
+	}
+	if err := restored.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer restored.Stop(ctx)
+	got, err := restored.Predict(ctx, req)
+	if err != nil {
+		t.Fatalf("Predict() after restoring the state error = %v", err)
+	}
+	if got.TTFT != want.TTFT || got.TPOT != want.TPOT {
+		t.Errorf("Predict() after restoring the state = %v/%v, want %v/%v", got.TTFT, got.TPOT, want.TTFT, want.TPOT)
+	}
+
+	// A state trained for another quantile is not used.
+	other := *config
+	other.Quantile = 0.5
+	untrained, err := NewOnlinePredictor(&other, logr.Discard())
+	if err != nil {
+		t.Fatalf("NewOnlinePredictor() error = %v", err)
+	}
+	if err := untrained.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	defer untrained.Stop(ctx)
+	if _, err := untrained.Predict(ctx, req); err == nil {
+		t.Error("Predict() with a state trained for another quantile succeeded, want an error")
+	}
+}
+
+func TestRunningStatsForget(t *testing.T) {
+	var forgetting, keeping runningStats
+	for i := range 2000 {
+		v := 10.0
+		if i >= 1000 {
+			v = 100
+		}
+		v += float64(i % 2) // some variance
+		forgetting.add(v, 0.99)
+		keeping.add(v, 1)
+	}
+	if math.Abs(forgetting.Mean-100.5) > 0.1 {
+		t.Errorf("mean with forgetting = %f, want the mean of the recent values, 100.5", forgetting.Mean)
+	}
+	if math.Abs(keeping.Mean-55.5) > 0.1 {
+		t.Errorf("mean without forgetting = %f, want the mean of all the values, 55.5", keeping.Mean)
+	}
+	if z := forgetting.standardize(100.5); math.Abs(z) > 0.1 {
+		t.Errorf("standardize() of a recent value = %f, want about 0", z)
+	}
+	if math.Abs(forgetting.Count-100) > 1 {
+		t.Errorf("count with forgetting = %f, want about 1/(1-0.99) = 100", forgetting.Count)
+	}
+}
+
+func TestOnlineConfigValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*OnlineConfig)
+	}{
+		{name: "quantile out of range", modify: func(c *OnlineConfig) { c.Quantile = 1 }},
+		{name: "unknown objective", modify: func(c *OnlineConfig) { c.ObjectiveType = "median" }},
+		{name: "zero forgetting factor", modify: func(c *OnlineConfig) { c.ForgettingFactor = 0 }},
+		{name: "negative min samples", modify: func(c *OnlineConfig) { c.MinSamples = -1 }},
+		{name: "state file without save interval", modify: func(c *OnlineConfig) { c.StateFile = "model.json"; c.SaveInterval = 0 }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := DefaultOnlineConfig()
+			tc.modify(config)
+			if _, err := NewOnlinePredictor(config, logr.Discard()); err == nil {
+				t.Error("NewOnlinePredictor() succeeded, want an error")
+			}
+		})
+	}
+}
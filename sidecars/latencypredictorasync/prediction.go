@@ -29,48 +29,71 @@ import (
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 )
 
-// Predict uses cached coefficients (Bayesian Ridge) or HTTP calls (XGBoost/LightGBM) for prediction.
-func (p *Predictor) Predict(ctx context.Context, req PredictionRequest) (*PredictionResponse, error) {
-	// Get current model type from server status first, fall back to model info
+// modelState is a snapshot of the current model type and the cached data used for local predictions.
+type modelState struct {
+	modelType     string
+	quantile      float64
+	objectiveType string
+	metrics       *MetricsResponse
+	native        *nativeModels
+}
+
+// currentModel returns the current model state, taken from server status first, then model info.
+func (p *Predictor) currentModel() modelState {
 	p.metricsMu.RLock()
-	modelType := ""
-	quantile := 0.9                    // default
-	objectiveType := ObjectiveQuantile // default for backward compatibility
+	defer p.metricsMu.RUnlock()
 
+	s := modelState{
+		quantile:      0.9,               // default
+		objectiveType: ObjectiveQuantile, // default for backward compatibility
+		metrics:       p.cachedMetrics,
+	}
 	if p.serverStatus != nil {
-		modelType = p.serverStatus.ModelType
-		quantile = p.serverStatus.Quantile
+		s.modelType = p.serverStatus.ModelType
+		s.quantile = p.serverStatus.Quantile
 		if p.serverStatus.ObjectiveType != "" {
-			objectiveType = p.serverStatus.ObjectiveType
+			s.objectiveType = p.serverStatus.ObjectiveType
 		}
 	} else if p.modelInfo != nil {
-		modelType = p.modelInfo.ModelType
+		s.modelType = p.modelInfo.ModelType
 		if p.modelInfo.Quantile > 0 {
-			quantile = p.modelInfo.Quantile
+			s.quantile = p.modelInfo.Quantile
 		}
 		if p.modelInfo.ObjectiveType != "" {
-			objectiveType = p.modelInfo.ObjectiveType
+			s.objectiveType = p.modelInfo.ObjectiveType
 		}
 	}
+	// Trees fetched for another model type are stale until the next refresh.
+	if p.nativeModels != nil && p.nativeModels.modelType == s.modelType {
+		s.native = p.nativeModels
+	}
+	return s
+}
 
-	mr := p.cachedMetrics
-	p.metricsMu.RUnlock()
-
-	if modelType == "" {
+// Predict uses cached coefficients (Bayesian Ridge) or trees (XGBoost/LightGBM) for prediction,
+// falling back to HTTP calls when no trees are cached.
+func (p *Predictor) Predict(ctx context.Context, req PredictionRequest) (*PredictionResponse, error) {
+	m := p.currentModel()
+	if m.modelType == "" {
 		return nil, errors.New("model type not yet available from server")
 	}
 
-	switch modelType {
+	switch m.modelType {
 	case bayesianRidgeModelType:
-		return p.predictBayesianRidge(req, mr, quantile, objectiveType)
+		return p.predictBayesianRidge(req, m.metrics, m.quantile, m.objectiveType)
 	case xgBoostModelType, gbmModelType:
+		if m.native != nil {
+			resp := m.native.predict(req, m.objectiveType, m.quantile)
+			return &resp, nil
+		}
 		return p.predictHTTP(ctx, req)
 	default:
-		return nil, fmt.Errorf("unsupported or unknown model type: %s", modelType)
+		return nil, fmt.Errorf("unsupported or unknown model type: %s", m.modelType)
 	}
 }
 
-// PredictBulk makes bulk predictions with error handling (allows partial failures)
+// PredictBulk makes bulk predictions with error handling (allows partial failures).
+// Tree models are evaluated in process when their trees are cached.
 func (p *Predictor) PredictBulk(ctx context.Context, requests []PredictionRequest) (*BulkPredictionResponse, error) {
 	if len(requests) == 0 {
 		return nil, errors.New("no prediction requests provided")
@@ -87,6 +110,10 @@ func (p *Predictor) PredictBulk(ctx context.Context, requests []PredictionReques
 		}
 	}
 
+	if m := p.currentModel(); m.native != nil {
+		return m.native.predictBulk(requests, m.objectiveType, m.quantile), nil
+	}
+
 	payload := BulkPredictionRequest{Requests: requests}
 	data, err := json.Marshal(payload)
 	if err != nil {
@@ -136,7 +163,8 @@ func (p *Predictor) PredictBulk(ctx context.Context, requests []PredictionReques
 }
 
 // PredictBulkStrict makes bulk predictions that fail if any single prediction fails.
-// When CoalesceWindow > 0, concurrent callers are coalesced into a single HTTP call.
+// Tree models are evaluated in process when their trees are cached. Otherwise, when
+// CoalesceWindow > 0, concurrent callers are coalesced into a single HTTP call.
 func (p *Predictor) PredictBulkStrict(ctx context.Context, requests []PredictionRequest) (*BulkPredictionResponse, error) {
 	if len(requests) == 0 {
 		return nil, errors.New("no prediction requests provided")
@@ -153,6 +181,10 @@ func (p *Predictor) PredictBulkStrict(ctx context.Context, requests []Prediction
 		}
 	}
 
+	if m := p.currentModel(); m.native != nil {
+		return m.native.predictBulk(requests, m.objectiveType, m.quantile), nil
+	}
+
 	if p.config.CoalesceWindow > 0 {
 		return p.submitCoalesced(ctx, requests)
 	}
@@ -234,6 +266,10 @@ func (p *Predictor) predictHTTP(ctx context.Context, req PredictionRequest) (*Pr
 // ValidatePredictionRequest validates that a prediction request has all required fields
 // with valid values, including the new prefix_cache_score field.
 func (p *Predictor) ValidatePredictionRequest(req PredictionRequest) error {
+	return validatePredictionRequest(req)
+}
+
+func validatePredictionRequest(req PredictionRequest) error {
 	if req.KVCachePercentage < 0.0 || req.KVCachePercentage > 1.0 {
 		return fmt.Errorf("kv_cache_percentage must be between 0.0 and 1.0, got %f", req.KVCachePercentage)
 	}
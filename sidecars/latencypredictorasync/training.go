@@ -23,6 +23,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
@@ -289,7 +291,7 @@ func (p *Predictor) refreshModelInfo(ctx context.Context) error {
 	return nil
 }
 
-// refreshMetrics GETs /metrics from training server and caches parsed coefficients or fetches XGBoost trees.
+// refreshMetrics GETs /metrics from training server and caches parsed coefficients or fetches tree models.
 func (p *Predictor) refreshMetrics(ctx context.Context) {
 	// Refresh model info first
 	if err := p.refreshModelInfo(ctx); err != nil {
@@ -314,97 +316,146 @@ func (p *Predictor) refreshMetrics(ctx context.Context) {
 		if _, err := p.GetMetrics(ctx); err != nil {
 			p.logger.Error(err, "Failed to refresh Bayesian Ridge metrics")
 		}
-	case xgBoostModelType:
-		if p.config.UseNativeXGBoost {
-			// Fetch XGBoost trees for native predictions
-			trees, err := p.getXGBoostTrees(ctx)
-			if err != nil {
-				p.logger.Error(err, "Failed to fetch XGBoost trees")
-				return
-			}
-
-			p.metricsMu.Lock()
-			if p.cachedMetrics == nil {
-				p.cachedMetrics = &MetricsResponse{}
-			}
-			p.cachedMetrics.ModelType = modelType
-			p.cachedMetrics.XGBoostTrees = trees
-			p.metricsMu.Unlock()
-
-			p.logger.V(logutil.DEBUG).Info("Updated XGBoost trees for native predictions")
-		} else {
+	case xgBoostModelType, gbmModelType:
+		if !p.config.UseNativeXGBoost {
 			// Just update model type for HTTP-based predictions
 			p.metricsMu.Lock()
 			if p.cachedMetrics == nil {
 				p.cachedMetrics = &MetricsResponse{}
 			}
 			p.cachedMetrics.ModelType = modelType
+			p.nativeModels = nil
 			p.metricsMu.Unlock()
 
 			p.logger.V(logutil.DEBUG).Info("Updated model type for HTTP-based predictions", "model_type", modelType)
+			return
 		}
-	case gbmModelType:
-		// LightGBM only supports HTTP calls, no native tree caching needed
+
+		// Fetch trees for native predictions. On failure the previous models are
+		// kept; predictions fall back to HTTP if they are of another model type.
+		native, trees, err := p.getNativeModels(ctx, modelType)
+		if err != nil {
+			p.logger.Error(err, "Failed to fetch tree models for native predictions", "model_type", modelType)
+			return
+		}
+
 		p.metricsMu.Lock()
 		if p.cachedMetrics == nil {
 			p.cachedMetrics = &MetricsResponse{}
 		}
 		p.cachedMetrics.ModelType = modelType
+		p.cachedMetrics.XGBoostTrees = trees
+		p.nativeModels = native
 		p.metricsMu.Unlock()
 
-		p.logger.V(logutil.DEBUG).Info("Updated model type for HTTP-based predictions", "model_type", modelType)
+		p.logger.V(logutil.DEBUG).Info("Updated tree models for native predictions", "model_type", modelType, "gated", native.gated)
 	default:
 		p.logger.Info("Unknown model type, cannot refresh metrics", "model_type", modelType)
 	}
 }
 
-// getXGBoostTrees fetches tree JSON from the training server
-func (p *Predictor) getXGBoostTrees(ctx context.Context) (*XGBoostTrees, error) {
-	trees := &XGBoostTrees{}
-
-	// Fetch TTFT trees from training server
-	ttftURL := p.config.TrainingURL + "/model/ttft/xgb/json"
-	ttftReq, err := http.NewRequestWithContext(ctx, http.MethodGet, ttftURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create TTFT trees request: %w", err)
+// getNativeModels fetches the TTFT and TPOT tree models from the training
+// server, including the queue-gated sub-models when its ensemble is active.
+// For XGBoost, the raw trees are returned as well.
+func (p *Predictor) getNativeModels(ctx context.Context, modelType string) (*nativeModels, *XGBoostTrees, error) {
+	models := &nativeModels{modelType: modelType}
+	var trees *XGBoostTrees
+	if modelType == xgBoostModelType {
+		trees = &XGBoostTrees{}
 	}
 
-	ttftResp, err := p.httpClient.Do(ttftReq)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch TTFT trees: %w", err)
+	for _, target := range []string{"ttft", "tpot"} {
+		ensemble, header, raw, err := p.getTreeEnsemble(ctx, modelType, target, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		// Both dumps report the ensemble state; TPOT is fetched last.
+		models.gated = header.Get(ensembleActiveHeader) == "true"
+		if target == "ttft" {
+			models.single.ttft = ensemble
+		} else {
+			models.single.tpot = ensemble
+		}
+		if trees != nil {
+			dst := &trees.TTFTTrees
+			if target == "tpot" {
+				dst = &trees.TPOTTrees
+			}
+			if err := json.Unmarshal(raw, dst); err != nil {
+				return nil, nil, fmt.Errorf("failed to decode %s trees: %w", strings.ToUpper(target), err)
+			}
+		}
+	}
+	if !models.gated {
+		return models, trees, nil
 	}
-	defer ttftResp.Body.Close()
 
-	if ttftResp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(ttftResp.Body)
-		return nil, fmt.Errorf("TTFT trees request failed: %d %s, body: %s", ttftResp.StatusCode, ttftResp.Status, string(body))
+	for _, sub := range []struct {
+		regime string
+		pair   *treeModelPair
+	}{
+		{"noqueue", &models.noQueue},
+		{"queued", &models.queued},
+	} {
+		var err error
+		if sub.pair.ttft, _, _, err = p.getTreeEnsemble(ctx, modelType, "ttft", sub.regime); err != nil {
+			return nil, nil, err
+		}
+		if sub.pair.tpot, _, _, err = p.getTreeEnsemble(ctx, modelType, "tpot", sub.regime); err != nil {
+			return nil, nil, err
+		}
 	}
+	return models, trees, nil
+}
 
-	if err := json.NewDecoder(ttftResp.Body).Decode(&trees.TTFTTrees); err != nil {
-		return nil, fmt.Errorf("failed to decode TTFT trees: %w", err)
+// getTreeEnsemble fetches and parses the TTFT or TPOT tree model dump. A
+// non-empty regime selects one of the queue-gated sub-models.
+func (p *Predictor) getTreeEnsemble(ctx context.Context, modelType, target, regime string) (*treeEnsemble, http.Header, []byte, error) {
+	format := "xgb"
+	if modelType == gbmModelType {
+		format = "lgb"
+	}
+	url := p.config.TrainingURL + "/model/" + target + "/" + format + "/json"
+	if regime != "" {
+		url += "?regime=" + regime
 	}
+	name := strings.ToUpper(target)
 
-	// Fetch TPOT trees from training server
-	tpotURL := p.config.TrainingURL + "/model/tpot/xgb/json"
-	tpotReq, err := http.NewRequestWithContext(ctx, http.MethodGet, tpotURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create TPOT trees request: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create %s trees request: %w", name, err)
 	}
 
-	tpotResp, err := p.httpClient.Do(tpotReq)
+	resp, err := p.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch TPOT trees: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to fetch %s trees: %w", name, err)
 	}
-	defer tpotResp.Body.Close()
+	defer resp.Body.Close()
 
-	if tpotResp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(tpotResp.Body)
-		return nil, fmt.Errorf("TPOT trees request failed: %d %s, body: %s", tpotResp.StatusCode, tpotResp.Status, string(body))
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to read %s trees: %w", name, err)
 	}
-
-	if err := json.NewDecoder(tpotResp.Body).Decode(&trees.TPOTTrees); err != nil {
-		return nil, fmt.Errorf("failed to decode TPOT trees: %w", err)
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, nil, fmt.Errorf("%s trees request failed: %d %s, body: %s", name, resp.StatusCode, resp.Status, string(body))
 	}
 
-	return trees, nil
+	var ensemble *treeEnsemble
+	if modelType == gbmModelType {
+		ensemble, err = parseLightGBMModel(body)
+	} else {
+		baseScore := resp.Header.Get(baseScoreHeader)
+		if baseScore == "" {
+			return nil, nil, nil, fmt.Errorf("%s trees response has no %s header", name, baseScoreHeader)
+		}
+		var base float64
+		if base, err = strconv.ParseFloat(baseScore, 64); err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid %s header %q: %w", baseScoreHeader, baseScore, err)
+		}
+		ensemble, err = parseXGBoostTrees(body, base)
+	}
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to parse %s trees: %w", name, err)
+	}
+	return ensemble, resp.Header, body, nil
 }
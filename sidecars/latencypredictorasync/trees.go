@@ -0,0 +1,444 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latencypredictorasync
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Tree features, in the order of treeFeatureVector. These mirror the feature
+// engineering done by the training and prediction servers, so a dumped model
+// can be evaluated by feature name regardless of its column order.
+const (
+	featIsQueued = iota
+	featKVCachePercentage
+	featInputTokenLength
+	featNumRequestWaiting
+	featNumRequestRunning
+	featNumTokensGenerated
+	featPrefixCacheScore
+	featEffectiveInputTokens
+	featPrefillScoreBucket
+	featPodType
+	featPrefillTokensInFlight
+	featDecodeTokensInFlight
+	numTreeFeatures
+)
+
+var treeFeatureIndex = map[string]int{
+	"is_queued":                featIsQueued,
+	"kv_cache_percentage":      featKVCachePercentage,
+	"input_token_length":       featInputTokenLength,
+	"num_request_waiting":      featNumRequestWaiting,
+	"num_request_running":      featNumRequestRunning,
+	"num_tokens_generated":     featNumTokensGenerated,
+	"prefix_cache_score":       featPrefixCacheScore,
+	"effective_input_tokens":   featEffectiveInputTokens,
+	"prefill_score_bucket":     featPrefillScoreBucket,
+	"pod_type_cat":             featPodType,
+	"prefill_tokens_in_flight": featPrefillTokensInFlight,
+	"decode_tokens_in_flight":  featDecodeTokensInFlight,
+}
+
+// prefixScoreBuckets is the number of prefill_score_bucket categories used by the servers.
+const prefixScoreBuckets = 4
+
+// podTypeCodes are the category codes of the servers' pod_type_cat column.
+var podTypeCodes = map[string]float64{"": 0, "prefill": 1, "decode": 2}
+
+// lightGBMZeroThreshold is the magnitude below which LightGBM treats a value as zero.
+const lightGBMZeroThreshold = 1e-35
+
+type treeFeatureVector [numTreeFeatures]float64
+
+// newTreeFeatureVector derives the model features from a prediction request.
+func newTreeFeatureVector(req PredictionRequest) *treeFeatureVector {
+	var x treeFeatureVector
+	if req.NumRequestWaiting > 0 {
+		x[featIsQueued] = 1
+	}
+	x[featKVCachePercentage] = req.KVCachePercentage
+	x[featInputTokenLength] = float64(req.InputTokenLength)
+	x[featNumRequestWaiting] = float64(req.NumRequestWaiting)
+	x[featNumRequestRunning] = float64(req.NumRequestRunning)
+	x[featNumTokensGenerated] = float64(req.NumTokensGenerated)
+	x[featPrefixCacheScore] = req.PrefixCacheScore
+	x[featEffectiveInputTokens] = (1 - req.PrefixCacheScore) * float64(req.InputTokenLength)
+	bucket := int(math.Min(math.Max(req.PrefixCacheScore, 0), 1) * prefixScoreBuckets)
+	x[featPrefillScoreBucket] = float64(min(bucket, prefixScoreBuckets-1))
+	if code, ok := podTypeCodes[req.PodType]; ok {
+		x[featPodType] = code
+	} else {
+		// Unknown categories are missing values for the servers as well.
+		x[featPodType] = math.NaN()
+	}
+	x[featPrefillTokensInFlight] = float64(req.PrefillTokensInFlight)
+	x[featDecodeTokensInFlight] = float64(req.DecodeTokensInFlight)
+	return &x
+}
+
+type splitKind uint8
+
+const (
+	leafNode splitKind = iota
+	// lessThanSplit goes left when the value is below the threshold, compared
+	// in single precision like XGBoost does.
+	lessThanSplit
+	// lessOrEqualSplit goes left when the value is at most the threshold, like LightGBM.
+	lessOrEqualSplit
+	// categorySplit goes left when the value is one of the categories.
+	categorySplit
+)
+
+type treeNode struct {
+	kind        splitKind
+	feature     int
+	threshold   float64
+	categories  []int
+	left, right int
+	// missingLeft is the direction taken by missing values.
+	missingLeft bool
+	// zeroAsMissing treats values close to zero as missing.
+	zeroAsMissing bool
+	value         float64
+}
+
+func (n *treeNode) goesLeft(v float64) bool {
+	if math.IsNaN(v) || (n.zeroAsMissing && math.Abs(v) <= lightGBMZeroThreshold) {
+		return n.missingLeft
+	}
+	switch n.kind {
+	case lessThanSplit:
+		return float32(v) < float32(n.threshold)
+	case lessOrEqualSplit:
+		return v <= n.threshold
+	default:
+		return v >= 0 && slices.Contains(n.categories, int(v))
+	}
+}
+
+// tree is a flattened decision tree whose root is the first node.
+type tree []treeNode
+
+func (t tree) predict(x *treeFeatureVector) float64 {
+	n := &t[0]
+	for n.kind != leafNode {
+		if n.goesLeft(x[n.feature]) {
+			n = &t[n.left]
+		} else {
+			n = &t[n.right]
+		}
+	}
+	return n.value
+}
+
+// treeEnsemble is a gradient boosted tree model evaluated in process. Quantile
+// and mean objectives both use the identity link, so the prediction is the
+// base score plus the sum of the leaves reached in every tree.
+type treeEnsemble struct {
+	baseScore float64
+	trees     []tree
+	// average divides the sum of the leaves by the number of trees (random forest mode).
+	average bool
+}
+
+func (e *treeEnsemble) predict(x *treeFeatureVector) float64 {
+	sum := 0.0
+	for _, t := range e.trees {
+		sum += t.predict(x)
+	}
+	if e.average && len(e.trees) > 0 {
+		sum /= float64(len(e.trees))
+	}
+	return e.baseScore + sum
+}
+
+// --- XGBoost ---
+
+// xgbNode is a node of a tree dumped with Booster.get_dump(dump_format="json").
+type xgbNode struct {
+	NodeID int    `json:"nodeid"`
+	Split  string `json:"split"`
+	// SplitCondition is a threshold, or a list of categories for categorical splits.
+	SplitCondition json.RawMessage `json:"split_condition"`
+	Categories     []int           `json:"categories"`
+	Yes            int             `json:"yes"`
+	No             int             `json:"no"`
+	Missing        int             `json:"missing"`
+	Children       []xgbNode       `json:"children"`
+	Leaf           *float64        `json:"leaf"`
+}
+
+// parseXGBoostTrees builds an ensemble from an XGBoost JSON tree dump. The
+// base score is not part of the dump and must be supplied by the caller.
+func parseXGBoostTrees(data []byte, baseScore float64) (*treeEnsemble, error) {
+	var roots []xgbNode
+	if err := json.Unmarshal(data, &roots); err != nil {
+		return nil, fmt.Errorf("failed to decode XGBoost trees: %w", err)
+	}
+	if len(roots) == 0 {
+		return nil, errors.New("XGBoost model has no trees")
+	}
+	e := &treeEnsemble{baseScore: baseScore, trees: make([]tree, 0, len(roots))}
+	for i := range roots {
+		var t tree
+		if _, err := addXGBoostNode(&t, &roots[i]); err != nil {
+			return nil, fmt.Errorf("invalid XGBoost tree %d: %w", i, err)
+		}
+		e.trees = append(e.trees, t)
+	}
+	return e, nil
+}
+
+func addXGBoostNode(t *tree, n *xgbNode) (int, error) {
+	idx := len(*t)
+	*t = append(*t, treeNode{})
+	if n.Leaf != nil {
+		(*t)[idx] = treeNode{kind: leafNode, value: *n.Leaf}
+		return idx, nil
+	}
+
+	feature, ok := treeFeatureIndex[n.Split]
+	if !ok {
+		return 0, fmt.Errorf("node %d splits on unknown feature %q", n.NodeID, n.Split)
+	}
+	node := treeNode{feature: feature}
+	children := make(map[int]int, len(n.Children))
+	for i := range n.Children {
+		child, err := addXGBoostNode(t, &n.Children[i])
+		if err != nil {
+			return 0, err
+		}
+		children[n.Children[i].NodeID] = child
+	}
+	var yesOK, noOK bool
+	node.left, yesOK = children[n.Yes]
+	node.right, noOK = children[n.No]
+	if !yesOK || !noOK {
+		return 0, fmt.Errorf("node %d references missing children %d and %d", n.NodeID, n.Yes, n.No)
+	}
+	node.missingLeft = n.Missing == n.Yes
+
+	// Categorical splits list the categories sent to the "yes" branch, either
+	// in their own field or in place of the threshold.
+	condition := strings.TrimSpace(string(n.SplitCondition))
+	switch {
+	case len(n.Categories) > 0:
+		node.kind = categorySplit
+		node.categories = n.Categories
+	case strings.HasPrefix(condition, "["):
+		var categories []float64
+		if err := json.Unmarshal(n.SplitCondition, &categories); err != nil {
+			return 0, fmt.Errorf("node %d has invalid categories: %w", n.NodeID, err)
+		}
+		node.kind = categorySplit
+		for _, c := range categories {
+			node.categories = append(node.categories, int(c))
+		}
+	default:
+		threshold, err := strconv.ParseFloat(condition, 64)
+		if err != nil {
+			return 0, fmt.Errorf("node %d has invalid split condition %q", n.NodeID, condition)
+		}
+		node.kind = lessThanSplit
+		node.threshold = threshold
+	}
+	(*t)[idx] = node
+	return idx, nil
+}
+
+// --- LightGBM ---
+
+// lgbModel is a model dumped with Booster.dump_model().
+type lgbModel struct {
+	FeatureNames  []string `json:"feature_names"`
+	AverageOutput bool     `json:"average_output"`
+	TreeInfo      []struct {
+		TreeStructure lgbNode `json:"tree_structure"`
+	} `json:"tree_info"`
+}
+
+type lgbNode struct {
+	SplitFeature *int `json:"split_feature"`
+	// Threshold is a number, or categories joined by "||" for categorical splits.
+	Threshold    json.RawMessage `json:"threshold"`
+	DecisionType string          `json:"decision_type"`
+	DefaultLeft  bool            `json:"default_left"`
+	MissingType  string          `json:"missing_type"`
+	LeftChild    *lgbNode        `json:"left_child"`
+	RightChild   *lgbNode        `json:"right_child"`
+	LeafValue    float64         `json:"leaf_value"`
+}
+
+// parseLightGBMModel builds an ensemble from a LightGBM JSON model dump.
+func parseLightGBMModel(data []byte) (*treeEnsemble, error) {
+	var m lgbModel
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to decode LightGBM model: %w", err)
+	}
+	if len(m.TreeInfo) == 0 {
+		return nil, errors.New("LightGBM model has no trees")
+	}
+	features := make([]int, len(m.FeatureNames))
+	for i, name := range m.FeatureNames {
+		feature, ok := treeFeatureIndex[name]
+		if !ok {
+			// Only an error if a split actually uses it.
+			feature = -1
+		}
+		features[i] = feature
+	}
+	e := &treeEnsemble{average: m.AverageOutput, trees: make([]tree, 0, len(m.TreeInfo))}
+	for i := range m.TreeInfo {
+		var t tree
+		if _, err := addLightGBMNode(&t, &m.TreeInfo[i].TreeStructure, m.FeatureNames, features); err != nil {
+			return nil, fmt.Errorf("invalid LightGBM tree %d: %w", i, err)
+		}
+		e.trees = append(e.trees, t)
+	}
+	return e, nil
+}
+
+func addLightGBMNode(t *tree, n *lgbNode, names []string, features []int) (int, error) {
+	idx := len(*t)
+	*t = append(*t, treeNode{})
+	if n.SplitFeature == nil {
+		(*t)[idx] = treeNode{kind: leafNode, value: n.LeafValue}
+		return idx, nil
+	}
+
+	if *n.SplitFeature < 0 || *n.SplitFeature >= len(features) {
+		return 0, fmt.Errorf("split on feature %d out of range", *n.SplitFeature)
+	}
+	node := treeNode{feature: features[*n.SplitFeature]}
+	if node.feature < 0 {
+		return 0, fmt.Errorf("split on unknown feature %q", names[*n.SplitFeature])
+	}
+	if n.LeftChild == nil || n.RightChild == nil {
+		return 0, errors.New("split without two children")
+	}
+
+	switch n.DecisionType {
+	case "==":
+		var threshold string
+		if err := json.Unmarshal(n.Threshold, &threshold); err != nil {
+			return 0, fmt.Errorf("invalid categorical threshold %s: %w", n.Threshold, err)
+		}
+		node.kind = categorySplit
+		for _, s := range strings.Split(threshold, "||") {
+			c, err := strconv.Atoi(s)
+			if err != nil {
+				return 0, fmt.Errorf("invalid category %q: %w", s, err)
+			}
+			node.categories = append(node.categories, c)
+		}
+		// Missing categories always go right.
+	case "<=":
+		if err := json.Unmarshal(n.Threshold, &node.threshold); err != nil {
+			return 0, fmt.Errorf("invalid threshold %s: %w", n.Threshold, err)
+		}
+		node.kind = lessOrEqualSplit
+		switch n.MissingType {
+		case "Zero":
+			node.zeroAsMissing = true
+			node.missingLeft = n.DefaultLeft
+		case "NaN":
+			node.missingLeft = n.DefaultLeft
+		default:
+			// Without missing value handling, LightGBM treats NaN as zero.
+			node.missingLeft = 0 <= node.threshold
+		}
+	default:
+		return 0, fmt.Errorf("unsupported decision type %q", n.DecisionType)
+	}
+
+	var err error
+	if node.left, err = addLightGBMNode(t, n.LeftChild, names, features); err != nil {
+		return 0, err
+	}
+	if node.right, err = addLightGBMNode(t, n.RightChild, names, features); err != nil {
+		return 0, err
+	}
+	(*t)[idx] = node
+	return idx, nil
+}
+
+// --- Native models ---
+
+const (
+	// baseScoreHeader carries the base score of an XGBoost tree dump.
+	baseScoreHeader = "X-Base-Score"
+	// ensembleActiveHeader reports whether the training server's queue-gated ensemble is active.
+	ensembleActiveHeader = "X-Ensemble-Active"
+)
+
+// treeModelPair holds the TTFT and TPOT ensembles trained on the same samples.
+type treeModelPair struct {
+	ttft, tpot *treeEnsemble
+}
+
+// nativeModels are the tree models of the training server, evaluated in
+// process instead of calling a prediction server. When the server's
+// queue-gated ensemble is active, requests are routed to the no-queue or
+// queued sub-models the same way the prediction server does.
+type nativeModels struct {
+	modelType string
+	single    treeModelPair
+	gated     bool
+	noQueue   treeModelPair
+	queued    treeModelPair
+}
+
+func (m *nativeModels) predict(req PredictionRequest, objectiveType string, quantile float64) PredictionResponse {
+	models := m.single
+	if m.gated {
+		models = m.queued
+		if req.NumRequestWaiting == 0 {
+			models = m.noQueue
+		}
+	}
+	x := newTreeFeatureVector(req)
+	return PredictionResponse{
+		TTFT:          math.Max(0, models.ttft.predict(x)),
+		TPOT:          math.Max(0, models.tpot.predict(x)),
+		PredictedAt:   time.Now(),
+		ModelType:     m.modelType,
+		ObjectiveType: objectiveType,
+		Quantile:      quantile,
+	}
+}
+
+func (m *nativeModels) predictBulk(requests []PredictionRequest, objectiveType string, quantile float64) *BulkPredictionResponse {
+	start := time.Now()
+	predictions := make([]PredictionResponse, len(requests))
+	for i, req := range requests {
+		predictions[i] = m.predict(req, objectiveType, quantile)
+	}
+	return &BulkPredictionResponse{
+		Predictions:           predictions,
+		TotalRequests:         len(requests),
+		SuccessfulPredictions: len(requests),
+		ProcessingTimeMs:      float64(time.Since(start).Microseconds()) / 1000,
+	}
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latencypredictorasync
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const testXGBoostTrees = `[
+  {"nodeid": 0, "depth": 0, "split": "input_token_length", "split_condition": 100, "yes": 1, "no": 2, "missing": 1, "children": [
+    {"nodeid": 1, "leaf": 10},
+    {"nodeid": 2, "depth": 1, "split": "pod_type_cat", "split_condition": [2], "yes": 3, "no": 4, "missing": 4, "children": [
+      {"nodeid": 3, "leaf": 50},
+      {"nodeid": 4, "leaf": 30}
+    ]}
+  ]},
+  {"nodeid": 0, "depth": 0, "split": "is_queued", "split_condition": 1, "yes": 1, "no": 2, "missing": 1, "children": [
+    {"nodeid": 1, "leaf": 0},
+    {"nodeid": 2, "leaf": 100}
+  ]}
+]`
+
+const testLightGBMModel = `{
+  "feature_names": ["kv_cache_percentage", "prefill_score_bucket", "num_request_running"],
+  "average_output": false,
+  "tree_info": [
+    {"tree_structure": {"split_feature": 0, "threshold": 0.5, "decision_type": "<=", "default_left": true, "missing_type": "None",
+      "left_child": {"leaf_value": 1},
+      "right_child": {"split_feature": 1, "threshold": "0||3", "decision_type": "==", "default_left": false, "missing_type": "None",
+        "left_child": {"leaf_value": 20},
+        "right_child": {"leaf_value": 40}}}},
+    {"tree_structure": {"split_feature": 2, "threshold": 1e-35, "decision_type": "<=", "default_left": false, "missing_type": "Zero",
+      "left_child": {"leaf_value": -1},
+      "right_child": {"leaf_value": 7}}},
+    {"tree_structure": {"leaf_value": 2}}
+  ]
+}`
+
+func TestNewTreeFeatureVector(t *testing.T) {
+	x := newTreeFeatureVector(PredictionRequest{
+		KVCachePercentage:     0.4,
+		InputTokenLength:      200,
+		NumRequestWaiting:     2,
+		NumRequestRunning:     3,
+		NumTokensGenerated:    7,
+		PrefixCacheScore:      0.6,
+		PodType:               "prefill",
+		PrefillTokensInFlight: 11,
+		DecodeTokensInFlight:  13,
+	})
+	want := treeFeatureVector{
+		featIsQueued:              1,
+		featKVCachePercentage:     0.4,
+		featInputTokenLength:      200,
+		featNumRequestWaiting:     2,
+		featNumRequestRunning:     3,
+		featNumTokensGenerated:    7,
+		featPrefixCacheScore:      0.6,
+		featEffectiveInputTokens:  80,
+		featPrefillScoreBucket:    2,
+		featPodType:               1,
+		featPrefillTokensInFlight: 11,
+		featDecodeTokensInFlight:  13,
+	}
+	for i := range want {
+		if math.Abs(x[i]-want[i]) > 1e-9 {
+			t.Errorf("feature %d = %v, want %v", i, x[i], want[i])
+		}
+	}
+
+	if x := newTreeFeatureVector(PredictionRequest{PrefixCacheScore: 1}); x[featPrefillScoreBucket] != prefixScoreBuckets-1 {
+		t.Errorf("prefill_score_bucket for a full prefix hit = %v, want %d", x[featPrefillScoreBucket], prefixScoreBuckets-1)
+	}
+	if x := newTreeFeatureVector(PredictionRequest{PodType: "unknown"}); !math.IsNaN(x[featPodType]) {
+		t.Errorf("pod_type_cat for an unknown pod type = %v, want NaN", x[featPodType])
+	}
+}
+
+func TestParseXGBoostTrees(t *testing.T) {
+	e, err := parseXGBoostTrees([]byte(testXGBoostTrees), 5)
+	if err != nil {
+		t.Fatalf("parseXGBoostTrees() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  PredictionRequest
+		want float64
+	}{
+		{name: "short input", req: PredictionRequest{InputTokenLength: 50}, want: 15},
+		{name: "threshold goes right", req: PredictionRequest{InputTokenLength: 100, PodType: "decode"}, want: 55},
+		{name: "category not matched", req: PredictionRequest{InputTokenLength: 200, PodType: "prefill", NumRequestWaiting: 1}, want: 135},
+		{name: "missing category", req: PredictionRequest{InputTokenLength: 200, PodType: "unknown"}, want: 35},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := e.predict(newTreeFeatureVector(tc.req)); got != tc.want {
+				t.Errorf("predict() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseLightGBMModel(t *testing.T) {
+	e, err := parseLightGBMModel([]byte(testLightGBMModel))
+	if err != nil {
+		t.Fatalf("parseLightGBMModel() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		req  PredictionRequest
+		want float64
+	}{
+		{name: "threshold goes left, zero is missing", req: PredictionRequest{KVCachePercentage: 0.5}, want: 10},
+		{name: "category matched", req: PredictionRequest{KVCachePercentage: 0.9, PrefixCacheScore: 1, NumRequestRunning: 3}, want: 29},
+		{name: "category not matched", req: PredictionRequest{KVCachePercentage: 0.9, PrefixCacheScore: 0.3, NumRequestRunning: 2}, want: 49},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := e.predict(newTreeFeatureVector(tc.req)); got != tc.want {
+				t.Errorf("predict() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseTreesErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func() (*treeEnsemble, error)
+	}{
+		{name: "xgboost unknown feature", parse: func() (*treeEnsemble, error) {
+			return parseXGBoostTrees([]byte(`[{"nodeid": 0, "split": "f3", "split_condition": 1, "yes": 1, "no": 2, "missing": 1,
+				"children": [{"nodeid": 1, "leaf": 0}, {"nodeid": 2, "leaf": 1}]}]`), 0)
+		}},
+		{name: "xgboost missing child", parse: func() (*treeEnsemble, error) {
+			return parseXGBoostTrees([]byte(`[{"nodeid": 0, "split": "is_queued", "split_condition": 1, "yes": 1, "no": 2, "missing": 1,
+				"children": [{"nodeid": 1, "leaf": 0}]}]`), 0)
+		}},
+		{name: "xgboost no trees", parse: func() (*treeEnsemble, error) {
+			return parseXGBoostTrees([]byte(`[]`), 0)
+		}},
+		{name: "lightgbm unknown feature", parse: func() (*treeEnsemble, error) {
+			return parseLightGBMModel([]byte(`{"feature_names": ["Column_0"], "tree_info": [{"tree_structure": {"split_feature": 0,
+				"threshold": 1, "decision_type": "<=", "left_child": {"leaf_value": 0}, "right_child": {"leaf_value": 1}}}]}`))
+		}},
+		{name: "lightgbm unsupported decision type", parse: func() (*treeEnsemble, error) {
+			return parseLightGBMModel([]byte(`{"feature_names": ["is_queued"], "tree_info": [{"tree_structure": {"split_feature": 0,
+				"threshold": 1, "decision_type": ">", "left_child": {"leaf_value": 0}, "right_child": {"leaf_value": 1}}}]}`))
+		}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := tc.parse(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestNativeTreePredictions(t *testing.T) {
+	var ensembleActive atomic.Bool
+	training := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/model/download/info":
+			_, _ = w.Write([]byte(`{"model_type": "xgboost", "objective_type": "quantile", "quantile": 0.9}`))
+			return
+		case "/model/ttft/xgb/json", "/model/tpot/xgb/json":
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set(ensembleActiveHeader, "false")
+		if ensembleActive.Load() {
+			w.Header().Set(ensembleActiveHeader, "true")
+		}
+		tpot := r.URL.Path == "/model/tpot/xgb/json"
+		switch r.URL.Query().Get("regime") {
+		case "":
+			w.Header().Set(baseScoreHeader, "5")
+			if tpot {
+				_, _ = w.Write([]byte(`[{"nodeid": 0, "leaf": -20}]`))
+			} else {
+				_, _ = w.Write([]byte(testXGBoostTrees))
+			}
+		case "noqueue":
+			w.Header().Set(baseScoreHeader, "1")
+			_, _ = w.Write([]byte(`[{"nodeid": 0, "leaf": 2}]`))
+		case "queued":
+			w.Header().Set(baseScoreHeader, "1")
+			_, _ = w.Write([]byte(`[{"nodeid": 0, "leaf": 4}]`))
+		}
+	}))
+	defer training.Close()
+
+	var httpPredictions atomic.Int32
+	prediction := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		httpPredictions.Add(1)
+		_, _ = w.Write([]byte(`{"ttft_ms": 1, "tpot_ms": 2, "model_type": "xgboost"}`))
+	}))
+	defer prediction.Close()
+
+	config := DefaultConfig()
+	config.TrainingURL = training.URL
+	config.PredictionURLs = []string{prediction.URL}
+	config.FlushInterval = time.Hour
+	config.MetricsRefreshInterval = time.Hour
+	config.CoalesceWindow = 0
+	p := New(config, logr.Discard())
+	ctx := context.Background()
+	defer p.Stop(ctx)
+
+	if err := p.refreshModelInfo(ctx); err != nil {
+		t.Fatalf("refreshModelInfo() error = %v", err)
+	}
+	resp, err := p.Predict(ctx, PredictionRequest{InputTokenLength: 50})
+	if err != nil {
+		t.Fatalf("Predict() error = %v", err)
+	}
+	if resp.TTFT != 1 || httpPredictions.Load() != 1 {
+		t.Fatalf("Predict() before trees are fetched = %+v with %d HTTP calls, want the HTTP prediction", resp, httpPredictions.Load())
+	}
+
+	p.refreshMetrics(ctx)
+	if !p.IsXGBoostReady() {
+		t.Fatal("IsXGBoostReady() = false after fetching trees")
+	}
+	resp, err = p.Predict(ctx, PredictionRequest{InputTokenLength: 50})
+	if err != nil {
+		t.Fatalf("Predict() error = %v", err)
+	}
+	// The TPOT prediction is clamped at zero like the prediction server does.
+	if resp.TTFT != 15 || resp.TPOT != 0 || resp.ModelType != xgBoostModelType || resp.Quantile != 0.9 {
+		t.Errorf("Predict() = %+v, want TTFT 15, TPOT 0 from the native xgboost model", resp)
+	}
+
+	bulk, err := p.PredictBulkStrict(ctx, []PredictionRequest{
+		{InputTokenLength: 50},
+		{InputTokenLength: 200, PodType: "decode", NumRequestWaiting: 1},
+	})
+	if err != nil {
+		t.Fatalf("PredictBulkStrict() error = %v", err)
+	}
+	if len(bulk.Predictions) != 2 || bulk.Predictions[0].TTFT != 15 || bulk.Predictions[1].TTFT != 155 {
+		t.Errorf("PredictBulkStrict() = %+v, want TTFT 15 and 155", bulk.Predictions)
+	}
+	if httpPredictions.Load() != 1 {
+		t.Errorf("prediction server called %d times, want no calls once trees are cached", httpPredictions.Load()-1)
+	}
+
+	ensembleActive.Store(true)
+	p.refreshMetrics(ctx)
+	for _, tc := range []struct {
+		waiting  int
+		wantTTFT float64
+	}{
+		{waiting: 0, wantTTFT: 3},
+		{waiting: 2, wantTTFT: 5},
+	} {
+		resp, err := p.Predict(ctx, PredictionRequest{NumRequestWaiting: tc.waiting})
+		if err != nil {
+			t.Fatalf("Predict() error = %v", err)
+		}
+		if resp.TTFT != tc.wantTTFT {
+			t.Errorf("Predict() with %d waiting requests and an active ensemble = %v, want %v", tc.waiting, resp.TTFT, tc.wantTTFT)
+		}
+	}
+}
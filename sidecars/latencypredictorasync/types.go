@@ -47,8 +47,10 @@ type Config struct {
 	MaxSampleSize int
 	// FlushInterval determines how often to flush training & refresh metrics.
 	FlushInterval time.Duration
-	// UseNativeXGBoost when true, attempts to use local XGBoost models for prediction.
-	// When false, falls back to HTTP calls to the Python server for XGBoost predictions.
+	// UseNativeXGBoost when true, fetches the XGBoost or LightGBM trees from the
+	// training server and evaluates them in process, using HTTP calls to the
+	// prediction servers only until trees are available. When false, tree model
+	// predictions always go over HTTP.
 	UseNativeXGBoost bool
 	// HTTPTimeout is the timeout for HTTP requests to the Python server.
 	HTTPTimeout time.Duration
@@ -18,6 +18,7 @@ package utils
 
 import (
 	"context"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 
@@ -39,6 +40,10 @@ func (h *testHandle) PodList() []types.NamespacedName {
 	return []types.NamespacedName{}
 }
 
+func (h *testHandle) MetricsStalenessThreshold() time.Duration {
+	return plugin.DefaultMetricsStalenessThreshold
+}
+
 type testHandlePlugins struct {
 	plugins map[string]plugin.Plugin
 }